@@ -0,0 +1,15 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_application_user_environments" "automation_spn" {
+  application_id = var.application_id
+}
@@ -0,0 +1,4 @@
+output "automation_spn_environments" {
+  description = "Environments where the service principal exists as an application user, with its security roles"
+  value       = data.powerplatform_application_user_environments.automation_spn.environments
+}
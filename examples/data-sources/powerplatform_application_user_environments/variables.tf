@@ -0,0 +1,4 @@
+variable "application_id" {
+  description = "Application (client) id of the service principal to review"
+  type        = string
+}
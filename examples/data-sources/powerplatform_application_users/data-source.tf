@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_application_users" "enabled" {
+  environment_id = var.environment_id
+  filter         = "isdisabled eq false"
+}
+
+output "application_users" {
+  value = {
+    for user in data.powerplatform_application_users.enabled.application_users : user.name => [for role in user.security_roles : role.name]
+  }
+}
@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_dataverse_workflow_runs" "flow" {
+  environment_id = var.environment_id
+  workflow_id    = var.workflow_id
+  top            = 20
+}
+
+# fail the deployment when the flow keeps failing after it has been activated.
+resource "terraform_data" "flow_validation" {
+  input = data.powerplatform_dataverse_workflow_runs.flow.last_run_time
+
+  lifecycle {
+    postcondition {
+      condition     = data.powerplatform_dataverse_workflow_runs.flow.failed_count <= var.max_failed_runs
+      error_message = "${data.powerplatform_dataverse_workflow_runs.flow.failed_count} of the last ${data.powerplatform_dataverse_workflow_runs.flow.run_count} runs of the flow failed."
+    }
+  }
+}
@@ -0,0 +1,4 @@
+output "last_run_status" {
+  description = "Returns the status of the most recent run of the flow"
+  value       = data.powerplatform_dataverse_workflow_runs.flow.last_run_status
+}
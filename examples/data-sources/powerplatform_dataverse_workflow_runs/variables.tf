@@ -0,0 +1,15 @@
+variable "environment_id" {
+  description = "Id of the Dataverse environment of the flow"
+  type        = string
+}
+
+variable "workflow_id" {
+  description = "Id of the flow"
+  type        = string
+}
+
+variable "max_failed_runs" {
+  description = "Maximum number of failed runs among the recent runs of the flow"
+  type        = number
+  default     = 0
+}
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_environment_capacity" "capacity" {
+  environment_id = var.environment_id
+
+  lifecycle {
+    postcondition {
+      condition     = alltrue([for capacity in self.capacities : capacity.actual_consumption < var.max_consumption_mb if capacity.capacity_type == "Database"])
+      error_message = "The database of the environment uses more than ${var.max_consumption_mb} MB."
+    }
+  }
+}
@@ -0,0 +1,3 @@
+output "environment_capacity" {
+  value = data.powerplatform_environment_capacity.capacity.capacities
+}
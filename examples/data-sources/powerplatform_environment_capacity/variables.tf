@@ -0,0 +1,10 @@
+variable "environment_id" {
+  description = "Id of the environment"
+  type        = string
+}
+
+variable "max_consumption_mb" {
+  description = "Database consumption, in MB, above which the plan fails"
+  type        = number
+  default     = 10240
+}
@@ -11,3 +11,19 @@ provider "powerplatform" {
 }
 
 data "powerplatform_environment_powerapps" "all" {}
+
+variable "environment_id" {
+  description = "Id of the environment whose canvas apps are listed"
+  type        = string
+}
+
+variable "owner_id" {
+  description = "Entra object id of the owner of the apps"
+  type        = string
+}
+
+data "powerplatform_environment_powerapps" "owned_canvas_apps" {
+  environment_id = var.environment_id
+  owner_id       = var.owner_id
+  app_type       = "canvas"
+}
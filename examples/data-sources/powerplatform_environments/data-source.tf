@@ -11,3 +11,9 @@ provider "powerplatform" {
 }
 
 data "powerplatform_environments" "all_environments" {}
+
+data "powerplatform_environments" "europe_sandboxes" {
+  environment_type      = "Sandbox"
+  azure_region          = "westeurope"
+  display_name_contains = "test"
+}
@@ -0,0 +1,20 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+variable "environment_id" {
+  description = "Id of the environment whose cloud flows are listed"
+  type        = string
+}
+
+data "powerplatform_flows" "all" {
+  environment_id = var.environment_id
+}
@@ -0,0 +1,4 @@
+output "stopped_flows" {
+  description = "Returns the display names of the cloud flows that are stopped"
+  value       = [for flow in data.powerplatform_flows.all.flows : flow.display_name if flow.state == "Stopped"]
+}
@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+    local = {
+      source = "hashicorp/local"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_import_blocks" "environments_and_websites" {
+  resource_types = ["powerplatform_environment", "powerplatform_powerpages_website"]
+}
+
+# run `terraform plan -generate-config-out=generated.tf` in the folder of the generated file to generate the resource configuration
+resource "local_file" "imports" {
+  filename = "${path.module}/adoption/imports.tf"
+  content  = data.powerplatform_import_blocks.environments_and_websites.content
+}
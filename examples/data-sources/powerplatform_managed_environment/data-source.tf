@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_managed_environment" "production" {
+  environment_id = var.environment_id
+
+  lifecycle {
+    postcondition {
+      condition     = self.is_managed && self.is_sharing_limited
+      error_message = "The production environment must be a Managed Environment that limits the sharing of canvas apps."
+    }
+  }
+}
@@ -0,0 +1,4 @@
+variable "environment_id" {
+  description = "Id of the production environment"
+  type        = string
+}
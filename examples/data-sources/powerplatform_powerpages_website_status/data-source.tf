@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_powerpages_website_status" "website" {
+  environment_id = var.environment_id
+  website_id     = var.website_id
+}
+
+# only point the custom domain to the website once it is active and its package is installed.
+resource "terraform_data" "custom_domain" {
+  input = var.custom_domain
+
+  lifecycle {
+    precondition {
+      condition     = data.powerplatform_powerpages_website_status.website.is_healthy
+      error_message = "The website is '${data.powerplatform_powerpages_website_status.website.status}' with package install status '${data.powerplatform_powerpages_website_status.website.package_install_status}'."
+    }
+  }
+}
@@ -0,0 +1,4 @@
+output "website_status" {
+  description = "Returns the status of the Power Pages website"
+  value       = data.powerplatform_powerpages_website_status.website.status
+}
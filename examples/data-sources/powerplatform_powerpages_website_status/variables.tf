@@ -0,0 +1,14 @@
+variable "environment_id" {
+  description = "Id of the environment of the website"
+  type        = string
+}
+
+variable "website_id" {
+  description = "Id of the website"
+  type        = string
+}
+
+variable "custom_domain" {
+  description = "Custom domain of the website"
+  type        = string
+}
@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_powerpages_websites" "environment" {
+  environment_id = var.environment_id
+}
+
+data "powerplatform_powerpages_websites" "tenant" {}
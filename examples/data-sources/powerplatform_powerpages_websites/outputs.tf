@@ -0,0 +1,9 @@
+output "environment_websites" {
+  description = "Returns all Power Pages websites in the environment"
+  value       = data.powerplatform_powerpages_websites.environment.websites
+}
+
+output "public_websites" {
+  description = "Returns the urls of all public Power Pages websites in the tenant"
+  value       = [for website in data.powerplatform_powerpages_websites.tenant.websites : website.website_url if website.site_visibility == "public"]
+}
@@ -0,0 +1,4 @@
+variable "environment_id" {
+  description = "Id of the environment to list the websites of"
+  type        = string
+}
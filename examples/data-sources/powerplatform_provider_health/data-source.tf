@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_provider_health" "health" {
+  environment_id = var.environment_id
+
+  lifecycle {
+    postcondition {
+      condition     = self.healthy
+      error_message = join("\n", [for check in self.checks : "${check.name}: ${check.error}" if !check.authorized])
+    }
+  }
+}
@@ -0,0 +1,3 @@
+output "checks" {
+  value = data.powerplatform_provider_health.health.checks
+}
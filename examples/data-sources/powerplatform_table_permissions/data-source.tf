@@ -0,0 +1,26 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_table_permissions" "account" {
+  environment_id     = var.environment_id
+  table_logical_name = "account"
+}
+
+output "account_permissions" {
+  value = {
+    for permission in data.powerplatform_table_permissions.account.permissions : permission.role_name => {
+      privileges = { for privilege in permission.privileges : privilege.access_right => privilege.depth }
+      teams      = [for team in permission.teams : team.name]
+      users      = [for user in permission.users : user.full_name]
+    }
+  }
+}
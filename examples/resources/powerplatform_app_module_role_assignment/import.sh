@@ -0,0 +1,2 @@
+# App module role assignments can be imported using the environment id and the app module id, separated by a slash (replace with real ids). All the roles of the app module are imported.
+terraform import powerplatform_app_module_role_assignment.example 00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000001
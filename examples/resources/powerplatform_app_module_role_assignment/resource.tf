@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_security_roles" "all" {
+  environment_id = var.environment_id
+}
+
+data "powerplatform_data_records" "sales_hub" {
+  environment_id    = var.environment_id
+  entity_collection = "appmodules"
+  filter            = "uniquename eq '${var.app_unique_name}'"
+  select            = ["appmoduleid"]
+}
+
+resource "powerplatform_app_module_role_assignment" "sales_hub" {
+  environment_id = var.environment_id
+  app_module_id  = one(data.powerplatform_data_records.sales_hub.rows).appmoduleid
+  security_role_ids = [
+    for role in data.powerplatform_security_roles.all.security_roles : role.role_id if contains(["Salesperson", "Sales Manager"], role.name)
+  ]
+}
@@ -0,0 +1,10 @@
+variable "environment_id" {
+  description = "Id of the Dataverse environment of the app"
+  type        = string
+}
+
+variable "app_unique_name" {
+  description = "Unique name of the model-driven app"
+  type        = string
+  default     = "msdynce_SalesHub"
+}
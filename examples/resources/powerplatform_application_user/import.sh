@@ -0,0 +1,2 @@
+# Application users can be imported using the environment id and the system user id of the application user, separated by a slash (replace with real ids).
+terraform import powerplatform_application_user.example 00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000001
@@ -0,0 +1,43 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+    azuread = {
+      source = "hashicorp/azuread"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+provider "azuread" {
+  use_cli = true
+}
+
+resource "azuread_application_registration" "automation" {
+  display_name = "automation_example"
+}
+
+resource "azuread_service_principal" "automation" {
+  client_id = azuread_application_registration.automation.client_id
+}
+
+resource "powerplatform_environment" "example" {
+  display_name     = "application_user_example"
+  location         = "europe"
+  environment_type = "Sandbox"
+  dataverse = {
+    language_code     = "1033"
+    currency_code     = "USD"
+    security_group_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
+
+resource "powerplatform_application_user" "automation" {
+  environment_id         = powerplatform_environment.example.id
+  application_id         = azuread_service_principal.automation.client_id
+  security_roles_by_name = ["Basic User"]
+}
@@ -0,0 +1,2 @@
+# Custom connectors can be imported using the environment id and the connector name, separated by a slash (replace with real values)
+terraform import powerplatform_connector.contoso 00000000-0000-0000-0000-000000000000/shared_contoso-20api-5f8d1a2b3c4d5e6f7a
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_connector" "contoso" {
+  environment_id     = var.environment_id
+  display_name       = "Contoso Orders"
+  description        = "Reads the orders of the Contoso API"
+  openapi_definition = file("${path.module}/apiDefinition.swagger.json")
+  api_properties     = file("${path.module}/apiProperties.json")
+}
@@ -0,0 +1,4 @@
+variable "environment_id" {
+  description = "Id of the environment the custom connector is created in"
+  type        = string
+}
@@ -0,0 +1,2 @@
+# DLP Policy exemptions can be imported using the DLP policy id (replace with a real DLP Policy guid)
+terraform import powerplatform_data_loss_prevention_policy_exemption.example 00000000-0000-0000-0000-000000000000
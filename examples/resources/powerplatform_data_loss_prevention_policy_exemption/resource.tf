@@ -0,0 +1,45 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+variable "policy_id" {
+  description = "Id of the DLP policy that the app and the flow are exempted from"
+  type        = string
+}
+
+variable "environment_id" {
+  description = "Id of the environment of the flow"
+  type        = string
+}
+
+variable "app_name" {
+  description = "Name (guid) of the canvas app that is exempted"
+  type        = string
+}
+
+variable "flow_name" {
+  description = "Name (guid) of the cloud flow that is exempted"
+  type        = string
+}
+
+resource "powerplatform_data_loss_prevention_policy_exemption" "example" {
+  policy_id = var.policy_id
+  exempt_resources = [
+    {
+      id   = "/providers/Microsoft.PowerApps/apps/${var.app_name}"
+      type = "Microsoft.PowerApps/apps"
+    },
+    {
+      id   = "/providers/Microsoft.ProcessSimple/environments/${var.environment_id}/flows/${var.flow_name}"
+      type = "Microsoft.ProcessSimple/environments/flows"
+    }
+  ]
+}
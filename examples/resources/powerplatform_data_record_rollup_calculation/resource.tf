@@ -0,0 +1,30 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_data_records_batch" "opportunities" {
+  environment_id     = var.environment_id
+  table_logical_name = "opportunity"
+  key_columns        = ["new_externalid"]
+  records            = csvdecode(file("${path.module}/opportunities.csv"))
+}
+
+# new_opportunitycount and new_totalrevenue are rollup columns of the account table.
+resource "powerplatform_data_record_rollup_calculation" "accounts" {
+  environment_id       = var.environment_id
+  table_logical_name   = "account"
+  column_logical_names = ["new_opportunitycount", "new_totalrevenue"]
+
+  # recalculate the columns every time the seeded opportunities change.
+  triggers = {
+    opportunities = sha1(jsonencode(powerplatform_data_records_batch.opportunities.record_ids))
+  }
+}
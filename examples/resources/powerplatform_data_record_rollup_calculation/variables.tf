@@ -0,0 +1,4 @@
+variable "environment_id" {
+  description = "Id of the Dataverse environment"
+  type        = string
+}
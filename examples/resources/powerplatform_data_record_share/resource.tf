@@ -0,0 +1,35 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_data_record" "account" {
+  environment_id     = var.environment_id
+  table_logical_name = "account"
+  columns = {
+    name = "Shared configuration account"
+  }
+}
+
+data "powerplatform_data_records" "team" {
+  environment_id    = var.environment_id
+  entity_collection = "teams"
+  filter            = "name eq '${var.team_name}'"
+  select            = ["teamid"]
+}
+
+resource "powerplatform_data_record_share" "share_with_team" {
+  environment_id     = var.environment_id
+  table_logical_name = powerplatform_data_record.account.table_logical_name
+  record_id          = powerplatform_data_record.account.id
+  principal_type     = "team"
+  principal_id       = one(data.powerplatform_data_records.team.rows).teamid
+  access_rights      = ["ReadAccess", "WriteAccess"]
+}
@@ -0,0 +1,9 @@
+variable "environment_id" {
+  description = "Id of the Dataverse environment"
+  type        = string
+}
+
+variable "team_name" {
+  description = "Name of the Dataverse team the record is shared with"
+  type        = string
+}
@@ -0,0 +1,38 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+# accounts.csv has the header: accountnumber,name,telephone1
+# the account table needs an alternate key on accountnumber.
+resource "powerplatform_data_records_batch" "accounts" {
+  environment_id     = var.environment_id
+  table_logical_name = "account"
+  key_columns        = ["accountnumber"]
+  batch_size         = 200
+  records            = csvdecode(file("${path.module}/accounts.csv"))
+}
+
+resource "powerplatform_data_records_batch" "contacts" {
+  environment_id     = var.environment_id
+  table_logical_name = "contact"
+  key_columns        = ["emailaddress1"]
+  records = [
+    for contact in jsondecode(file("${path.module}/contacts.json")) : {
+      emailaddress1 = contact.email
+      firstname     = contact.first_name
+      lastname      = contact.last_name
+    }
+  ]
+}
+
+output "account_ids" {
+  value = powerplatform_data_records_batch.accounts.record_ids
+}
@@ -0,0 +1,29 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_solution" "solution" {
+  environment_id = var.environment_id
+  solution_file  = var.solution_file
+}
+
+# migrate the accounts once the solution with the custom api is imported, and again for each new migration version.
+resource "powerplatform_dataverse_action" "migrate_accounts" {
+  environment_id  = var.environment_id
+  action_name     = "contoso_MigrateAccounts"
+  idempotency_key = var.migration_version
+  parameters = jsonencode({
+    Source    = "legacy"
+    BatchSize = 500
+  })
+
+  depends_on = [powerplatform_solution.solution]
+}
@@ -0,0 +1,15 @@
+variable "environment_id" {
+  description = "Id of the Dataverse environment"
+  type        = string
+}
+
+variable "solution_file" {
+  description = "Path to the solution file that contains the custom api"
+  type        = string
+}
+
+variable "migration_version" {
+  description = "Version of the data migration, the migration runs again when it changes"
+  type        = string
+  default     = "1"
+}
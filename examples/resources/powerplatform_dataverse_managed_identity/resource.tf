@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+data "powerplatform_tenant" "tenant" {}
+
+data "powerplatform_data_records" "plugin_assembly" {
+  environment_id    = var.environment_id
+  entity_collection = "pluginassemblies"
+  filter            = "name eq '${var.plugin_assembly_name}'"
+  select            = ["pluginassemblyid"]
+}
+
+resource "powerplatform_dataverse_managed_identity" "plugin_identity" {
+  environment_id      = var.environment_id
+  name                = "Plugin managed identity"
+  application_id      = var.application_id
+  tenant_id           = data.powerplatform_tenant.tenant.tenant_id
+  plugin_assembly_ids = [one(data.powerplatform_data_records.plugin_assembly.rows).pluginassemblyid]
+}
@@ -0,0 +1,14 @@
+variable "environment_id" {
+  description = "Id of the Dataverse environment"
+  type        = string
+}
+
+variable "application_id" {
+  description = "Application (client) id of the app registration or user-assigned managed identity with a federated credential for the plugin assembly"
+  type        = string
+}
+
+variable "plugin_assembly_name" {
+  description = "Name of the plugin assembly that uses the managed identity"
+  type        = string
+}
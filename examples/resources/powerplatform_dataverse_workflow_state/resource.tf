@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_solution" "solution" {
+  environment_id = var.environment_id
+  solution_file  = var.solution_file
+}
+
+resource "powerplatform_dataverse_workflow_state" "business_rule" {
+  environment_id = var.environment_id
+  name           = "Set account number"
+  state          = "Activated"
+
+  depends_on = [powerplatform_solution.solution]
+}
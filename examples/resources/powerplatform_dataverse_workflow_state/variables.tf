@@ -0,0 +1,9 @@
+variable "environment_id" {
+  description = "Id of the Dataverse environment"
+  type        = string
+}
+
+variable "solution_file" {
+  description = "Path to the solution file that contains the process"
+  type        = string
+}
@@ -0,0 +1,2 @@
+# Environment backups can be imported using the environment id and the backup id, separated by a forward slash
+terraform import powerplatform_environment_backup.before_refresh 00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000002
@@ -0,0 +1,22 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+variable "production_environment_id" {
+  description = "Id of the production environment to back up"
+  type        = string
+}
+
+resource "powerplatform_environment_backup" "before_refresh" {
+  environment_id = var.production_environment_id
+  label          = "before sandbox refresh"
+  notes          = "Taken by terraform before the sandbox is refreshed"
+}
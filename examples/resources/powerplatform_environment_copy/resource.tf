@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+variable "production_environment_id" {
+  description = "Id of the production environment that is copied"
+  type        = string
+}
+
+variable "test_environment_id" {
+  description = "Id of the sandbox environment that is refreshed from production"
+  type        = string
+}
+
+resource "powerplatform_environment_copy" "test_refresh" {
+  source_environment_id   = var.production_environment_id
+  target_environment_id   = var.test_environment_id
+  copy_type               = "MinimalCopy"
+  target_environment_name = "Test"
+}
@@ -0,0 +1,34 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+variable "environment_id" {
+  description = "Id of the environment of the apps"
+  type        = string
+}
+
+variable "service_principal_id" {
+  description = "Entra object id of the service principal that takes over the apps"
+  type        = string
+}
+
+variable "leaver_id" {
+  description = "Entra object id of the user who left the organization"
+  type        = string
+}
+
+resource "powerplatform_environment_powerapps_co_owner" "leaver_remediation" {
+  environment_id  = var.environment_id
+  principal_id    = var.service_principal_id
+  principal_type  = "ServicePrincipal"
+  owner_id        = var.leaver_id
+  idempotency_key = "2026-10"
+}
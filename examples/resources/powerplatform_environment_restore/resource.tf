@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+variable "production_environment_id" {
+  description = "Id of the production environment whose backup is restored"
+  type        = string
+}
+
+variable "sandbox_environment_id" {
+  description = "Id of the sandbox environment that is refreshed"
+  type        = string
+}
+
+variable "sandbox_security_group_id" {
+  description = "Id of the Entra security group of the sandbox users"
+  type        = string
+}
+
+resource "powerplatform_environment_backup" "before_refresh" {
+  environment_id = var.production_environment_id
+  label          = "before sandbox refresh"
+}
+
+resource "powerplatform_environment_restore" "sandbox_refresh" {
+  source_environment_id    = var.production_environment_id
+  target_environment_id    = var.sandbox_environment_id
+  restore_point_date_time  = powerplatform_environment_backup.before_refresh.backup_point_date_time
+  target_security_group_id = var.sandbox_security_group_id
+  skip_audit_data          = true
+}
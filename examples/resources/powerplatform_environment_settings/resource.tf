@@ -68,6 +68,13 @@ resource "powerplatform_environment_settings" "settings" {
       enable_ip_based_firewall_rule               = true
       enable_ip_based_firewall_rule_in_audit_mode = true
       reverse_proxy_ip_addresses                  = toset(["10.10.1.1", "192.168.1.1"])
+      enable_ip_based_cookie_binding              = true
+      enable_session_timeout                      = true
+      session_timeout_in_minutes                  = 480
+      session_timeout_reminder_in_minutes         = 20
+      enable_inactivity_timeout                   = true
+      inactivity_timeout_in_minutes               = 60
+      inactivity_timeout_reminder_in_minutes      = 5
     }
   }
 }
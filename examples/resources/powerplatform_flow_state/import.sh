@@ -0,0 +1,2 @@
+# Flow states can be imported using the environment id and the flow name, separated by a forward slash
+terraform import powerplatform_flow_state.critical 00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000002
@@ -0,0 +1,39 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+variable "environment_id" {
+  description = "Id of the environment where the flow is deployed"
+  type        = string
+}
+
+variable "flow_name" {
+  description = "Unique name (guid) of the critical flow"
+  type        = string
+}
+
+variable "pipeline_principal_id" {
+  description = "Entra object id of the service principal of the deployment pipeline"
+  type        = string
+}
+
+resource "powerplatform_flow_state" "critical" {
+  environment_id = var.environment_id
+  flow_name      = var.flow_name
+  state          = "Started"
+
+  owners = [
+    {
+      principal_id   = var.pipeline_principal_id
+      principal_type = "ServicePrincipal"
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Power Pages websites can be imported using the environment id and the website id, separated by a forward slash
+terraform import powerplatform_powerpages_website.website 00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000002
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_powerpages_website" "website" {
+  environment_id = var.environment_id
+  name           = "Contoso customer portal"
+  subdomain      = var.subdomain
+  language_code  = 1033
+  template_name  = "DefaultPortalTemplate"
+}
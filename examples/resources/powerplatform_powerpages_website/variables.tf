@@ -0,0 +1,9 @@
+variable "environment_id" {
+  description = "Id of the Dataverse environment"
+  type        = string
+}
+
+variable "subdomain" {
+  description = "Subdomain of the website under powerappsportals.com"
+  type        = string
+}
@@ -0,0 +1,17 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_powerpages_website_visibility" "private" {
+  environment_id  = var.environment_id
+  website_id      = var.website_id
+  site_visibility = "private"
+}
@@ -0,0 +1,55 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_powerpages_website_waf" "waf" {
+  environment_id = var.environment_id
+  website_id     = var.website_id
+
+  custom_rules = [
+    {
+      name      = "AllowCorporateNetwork"
+      priority  = 1
+      rule_type = "MatchRule"
+      action    = "Allow"
+      match_conditions = [
+        {
+          match_variable = "RemoteAddr"
+          operator       = "IPMatch"
+          match_values   = ["203.0.113.0/24"]
+        }
+      ]
+    },
+    {
+      name                           = "RateLimitClients"
+      priority                       = 10
+      rule_type                      = "RateLimitRule"
+      action                         = "Block"
+      rate_limit_duration_in_minutes = 1
+      rate_limit_threshold           = 300
+      match_conditions = [
+        {
+          match_variable = "RequestUri"
+          operator       = "Contains"
+          match_values   = ["/"]
+        }
+      ]
+    }
+  ]
+
+  managed_rule_exclusions = [
+    {
+      match_variable          = "RequestCookieNames"
+      selector_match_operator = "StartsWith"
+      selector                = "contoso_"
+    }
+  ]
+}
@@ -0,0 +1,9 @@
+variable "environment_id" {
+  description = "Id of the environment where the website is located"
+  type        = string
+}
+
+variable "website_id" {
+  description = "Id of the Power Pages website"
+  type        = string
+}
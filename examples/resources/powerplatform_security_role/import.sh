@@ -0,0 +1,2 @@
+# Security roles can be imported using the environment id and the role id, separated by a slash (replace with real ids)
+terraform import powerplatform_security_role.example 00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000001
@@ -0,0 +1,48 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_environment" "example" {
+  display_name     = "security_role_example"
+  location         = "europe"
+  environment_type = "Sandbox"
+  dataverse = {
+    language_code     = "1033"
+    currency_code     = "USD"
+    security_group_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
+
+resource "powerplatform_security_role" "account_reader" {
+  environment_id = powerplatform_environment.example.id
+  name           = "Account Reader"
+  description    = "Reads all the accounts of the organization"
+  privileges = [
+    {
+      privilege_name = "prvReadAccount"
+      depth          = "Global"
+    },
+    {
+      privilege_name = "prvAppendToAccount"
+      depth          = "Basic"
+    },
+  ]
+}
+
+data "powerplatform_security_roles" "all" {
+  environment_id = powerplatform_environment.example.id
+}
+
+resource "powerplatform_security_role" "basic_user_copy" {
+  environment_id    = powerplatform_environment.example.id
+  name              = "Basic User Copy"
+  copy_from_role_id = one([for role in data.powerplatform_security_roles.all.security_roles : role.role_id if role.name == "Basic User" && role.business_unit_id == powerplatform_security_role.account_reader.business_unit_id])
+}
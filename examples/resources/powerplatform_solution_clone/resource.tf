@@ -0,0 +1,24 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+variable "environment_id" {
+  description = "Id of the development environment with the unmanaged solution"
+  type        = string
+}
+
+# Rolls the patches of the solution up into version 1.1.0.0 of the solution
+resource "powerplatform_solution_clone" "release" {
+  environment_id       = var.environment_id
+  parent_solution_name = "ContosoCore"
+  display_name         = "Contoso Core"
+  version              = "1.1.0.0"
+}
@@ -0,0 +1,2 @@
+# Solution patches can be imported using the environment id and the id of the patch, separated by a forward slash
+terraform import powerplatform_solution_patch.hotfix 00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000002
@@ -0,0 +1,23 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+variable "environment_id" {
+  description = "Id of the development environment with the unmanaged solution"
+  type        = string
+}
+
+resource "powerplatform_solution_patch" "hotfix" {
+  environment_id       = var.environment_id
+  parent_solution_name = "ContosoCore"
+  display_name         = "Contoso Core Hotfix"
+  version              = "1.0.1.0"
+}
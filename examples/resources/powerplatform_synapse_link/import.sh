@@ -0,0 +1,2 @@
+# Synapse Link profiles can be imported using the environment id and the id of the profile (msdyn_datalakefolderid), separated by a slash (replace with real ids).
+terraform import powerplatform_synapse_link.example 00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000001
@@ -0,0 +1,32 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_synapse_link" "analytics" {
+  environment_id       = var.environment_id
+  name                 = "Analytics"
+  storage_account_id   = var.storage_account_id
+  synapse_workspace_id = var.synapse_workspace_id
+
+  tables = [
+    {
+      name = "account"
+    },
+    {
+      name = "contact"
+    },
+    {
+      name               = "activitypointer"
+      partition_strategy = "Year"
+      append_only        = true
+    }
+  ]
+}
@@ -0,0 +1,15 @@
+variable "environment_id" {
+  description = "Id of the Dataverse environment"
+  type        = string
+}
+
+variable "storage_account_id" {
+  description = "Azure resource id of the Azure Data Lake Storage Gen2 account, in the same region as the environment"
+  type        = string
+}
+
+variable "synapse_workspace_id" {
+  description = "Azure resource id of the Azure Synapse Analytics workspace"
+  type        = string
+  default     = null
+}
@@ -0,0 +1,2 @@
+# Teams can be imported using the environment id and the team id, separated by a slash (replace with real ids)
+terraform import powerplatform_team.example 00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000001
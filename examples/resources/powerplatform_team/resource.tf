@@ -0,0 +1,46 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_environment" "example" {
+  display_name     = "team_example"
+  location         = "europe"
+  environment_type = "Sandbox"
+  dataverse = {
+    language_code     = "1033"
+    currency_code     = "USD"
+    security_group_id = "00000000-0000-0000-0000-000000000000"
+  }
+}
+
+data "powerplatform_security_roles" "all" {
+  environment_id = powerplatform_environment.example.id
+}
+
+resource "powerplatform_team" "sales" {
+  environment_id = powerplatform_environment.example.id
+  name           = "Sales"
+  description    = "Owner team of the sales people"
+  security_roles = [
+    one([for role in data.powerplatform_security_roles.all.security_roles : role.role_id if role.name == "Basic User"]),
+  ]
+}
+
+resource "powerplatform_team" "support" {
+  environment_id  = powerplatform_environment.example.id
+  name            = "Support"
+  team_type       = "EntraSecurityGroup"
+  entra_group_id  = "00000000-0000-0000-0000-000000000000"
+  membership_type = "Members"
+  security_roles = [
+    one([for role in data.powerplatform_security_roles.all.security_roles : role.role_id if role.name == "Basic User"]),
+  ]
+}
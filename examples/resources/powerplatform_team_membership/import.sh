@@ -0,0 +1,2 @@
+# Team memberships can be imported using the environment id and the team id, separated by a slash (replace with real ids). All the members of the team are imported.
+terraform import powerplatform_team_membership.example 00000000-0000-0000-0000-000000000000/00000000-0000-0000-0000-000000000001
@@ -0,0 +1,28 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_team" "sales" {
+  environment_id = var.environment_id
+  name           = "Sales"
+}
+
+resource "powerplatform_user" "sales_user" {
+  environment_id = var.environment_id
+  aad_id         = var.aad_user_id
+  security_roles = []
+}
+
+resource "powerplatform_team_membership" "sales" {
+  environment_id  = var.environment_id
+  team_id         = powerplatform_team.sales.id
+  system_user_ids = [powerplatform_user.sales_user.id]
+}
@@ -0,0 +1,9 @@
+variable "environment_id" {
+  description = "Id of the Dataverse environment of the team"
+  type        = string
+}
+
+variable "aad_user_id" {
+  description = "Entra object id of the user to add to the team"
+  type        = string
+}
@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_tenant_ai_settings" "ai" {
+  disable_copilot                = false
+  disable_power_automate_copilot = false
+  enable_open_ai_bot_publishing  = true
+  enable_model_data_sharing      = false
+  disable_bing_video_search      = true
+}
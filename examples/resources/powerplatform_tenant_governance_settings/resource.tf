@@ -0,0 +1,19 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_tenant_governance_settings" "governance" {
+  disable_environment_creation_by_non_admin_users           = true
+  disable_developer_environment_creation_by_non_admin_users = true
+  enable_default_environment_routing                        = true
+  environment_routing_all_makers                            = true
+  environment_routing_target_environment_group_id           = var.environment_group_id
+}
@@ -0,0 +1,4 @@
+variable "environment_group_id" {
+  description = "Id of the environment group makers are routed to"
+  type        = string
+}
@@ -0,0 +1,18 @@
+terraform {
+  required_providers {
+    powerplatform = {
+      source = "microsoft/power-platform"
+    }
+  }
+}
+
+provider "powerplatform" {
+  use_cli = true
+}
+
+resource "powerplatform_tenant_licensing_settings" "licensing" {
+  disable_capacity_allocation_by_environment_admins    = true
+  disable_billing_policy_creation_by_non_admin_users   = true
+  enable_tenant_capacity_report_for_environment_admins = true
+  storage_capacity_consumption_warning_threshold       = 85
+}
@@ -0,0 +1,87 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Services of the Power Platform API that the provider calls with a preview api version.
+const (
+	API_SERVICE_APPLICATION                = "application"
+	API_SERVICE_ENVIRONMENT_GROUP_RULE_SET = "environment_group_rule_set"
+	API_SERVICE_LICENSING                  = "licensing"
+	API_SERVICE_POWER_PAGES                = "powerpages"
+)
+
+const (
+	API_STABILITY_PREVIEW = "preview"
+	API_STABILITY_GA      = "ga"
+)
+
+// DefaultPreviewApiVersion is the preview version of the Power Platform API that most services are called with.
+const DefaultPreviewApiVersion = "2022-03-01-preview"
+
+// ApiVersion is a version of the api of a service and its stability.
+type ApiVersion struct {
+	Version   string
+	Stability string
+}
+
+// apiVersionRegistry maps the services to their known api versions. The first version is the one the provider is tested with and calls,
+// a generally available version is listed after it once the service has one.
+var apiVersionRegistry = map[string][]ApiVersion{
+	API_SERVICE_APPLICATION: {
+		{Version: "2022-03-01-preview", Stability: API_STABILITY_PREVIEW},
+	},
+	API_SERVICE_ENVIRONMENT_GROUP_RULE_SET: {
+		{Version: "2021-10-01-preview", Stability: API_STABILITY_PREVIEW},
+	},
+	API_SERVICE_LICENSING: {
+		{Version: "2022-03-01-preview", Stability: API_STABILITY_PREVIEW},
+	},
+	API_SERVICE_POWER_PAGES: {
+		{Version: "2022-03-01-preview", Stability: API_STABILITY_PREVIEW},
+	},
+}
+
+// getRegisteredApiVersion returns the version of the api of the service that the provider calls.
+func getRegisteredApiVersion(service string) (ApiVersion, error) {
+	versions, ok := apiVersionRegistry[service]
+	if !ok || len(versions) == 0 {
+		return ApiVersion{}, fmt.Errorf("no api version is registered for the service '%s'", service)
+	}
+	return versions[0], nil
+}
+
+// GetApiVersion returns the api version used in the requests to the service.
+// An unregistered service is a bug of the provider: it is logged and the service is called with the default preview version of the Power Platform API.
+func (client *Client) GetApiVersion(ctx context.Context, service string) string {
+	version, err := getRegisteredApiVersion(service)
+	if err != nil {
+		tflog.Error(ctx, err.Error())
+		return DefaultPreviewApiVersion
+	}
+	return version.Version
+}
+
+// AddPreviewApiVersionWarning adds a warning to the diagnostics the first time a resource or data source that calls the service is used,
+// when the service is called with a preview api version, since preview apis may change or be removed without notice.
+func (client *Client) AddPreviewApiVersionWarning(diagnostics *diag.Diagnostics, service string) {
+	version, err := getRegisteredApiVersion(service)
+	if err != nil || version.Stability != API_STABILITY_PREVIEW {
+		return
+	}
+	if _, warned := client.previewApiWarnings.LoadOrStore(service, struct{}{}); warned {
+		return
+	}
+	diagnostics.AddWarning(
+		"Preview API version",
+		fmt.Sprintf("The %s API is called with the preview api version %s, which may change or be removed without notice.", service, version.Version),
+	)
+}
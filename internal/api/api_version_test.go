@@ -0,0 +1,55 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/microsoft/terraform-provider-power-platform/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitClient_GetApiVersion(t *testing.T) {
+	ctx := context.Background()
+
+	client := NewApiClientBase(&config.ProviderConfig{}, nil)
+	assert.Equal(t, "2022-03-01-preview", client.GetApiVersion(ctx, API_SERVICE_POWER_PAGES))
+	assert.Equal(t, "2021-10-01-preview", client.GetApiVersion(ctx, API_SERVICE_ENVIRONMENT_GROUP_RULE_SET))
+
+	// an unregistered service falls back to the default version instead of failing the request.
+	assert.Equal(t, DefaultPreviewApiVersion, client.GetApiVersion(ctx, "unknown"))
+}
+
+func TestUnitClient_AddPreviewApiVersionWarning(t *testing.T) {
+	apiVersionRegistry["test"] = []ApiVersion{
+		{Version: "2025-01-01", Stability: API_STABILITY_GA},
+	}
+	defer delete(apiVersionRegistry, "test")
+
+	client := NewApiClientBase(&config.ProviderConfig{}, nil)
+
+	diagnostics := diag.Diagnostics{}
+	client.AddPreviewApiVersionWarning(&diagnostics, API_SERVICE_POWER_PAGES)
+	assert.Equal(t, 1, diagnostics.WarningsCount())
+	assert.Contains(t, diagnostics[0].Detail(), "2022-03-01-preview")
+
+	// the warning is only added once per service.
+	client.AddPreviewApiVersionWarning(&diagnostics, API_SERVICE_POWER_PAGES)
+	assert.Equal(t, 1, diagnostics.WarningsCount())
+
+	client.AddPreviewApiVersionWarning(&diagnostics, "test")
+	client.AddPreviewApiVersionWarning(&diagnostics, "unknown")
+	assert.Equal(t, 1, diagnostics.WarningsCount())
+}
+
+func TestUnitApiVersionRegistry_Versions(t *testing.T) {
+	for service, versions := range apiVersionRegistry {
+		assert.NotEmpty(t, versions, service)
+		for _, version := range versions {
+			assert.Contains(t, []string{API_STABILITY_PREVIEW, API_STABILITY_GA}, version.Stability, service)
+		}
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
@@ -20,6 +21,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/microsoft/terraform-provider-power-platform/internal/config"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 )
 
@@ -31,8 +33,16 @@ func (e *TokenExpiredError) Error() string {
 	return e.Message
 }
 
+// cliTokenRefreshWindow is how long before their expiry the cached Azure CLI tokens are requested again.
+const cliTokenRefreshWindow = 5 * time.Minute
+
 type Auth struct {
 	config *config.ProviderConfig
+
+	// the Azure CLI runs `az account get-access-token` for every token, so its tokens are cached per scope until they are about to expire.
+	cliMutex      sync.Mutex
+	cliCredential azcore.TokenCredential
+	cliTokens     map[string]azcore.AccessToken
 }
 
 type OidcCredential struct {
@@ -72,6 +82,7 @@ func (client *Auth) AuthenticateClientCertificate(ctx context.Context, scopes []
 		key,
 		&azidentity.ClientCertificateCredentialOptions{
 			AdditionallyAllowedTenants: client.config.AuxiliaryTenantIDs,
+			SendCertificateChain:       client.config.ClientCertificateSendChain,
 			ClientOptions: azcore.ClientOptions{
 				Cloud: client.config.Cloud,
 			},
@@ -88,19 +99,34 @@ func (client *Auth) AuthenticateClientCertificate(ctx context.Context, scopes []
 }
 
 func (client *Auth) AuthenticateUsingCli(ctx context.Context, scopes []string) (string, time.Time, error) {
-	azureCLICredentials, err := azidentity.NewAzureCLICredential(&azidentity.AzureCLICredentialOptions{
-		AdditionallyAllowedTenants: client.config.AuxiliaryTenantIDs,
-		TenantID:                   client.config.TenantId,
-	})
-	if err != nil {
-		return "", time.Time{}, err
+	client.cliMutex.Lock()
+	defer client.cliMutex.Unlock()
+
+	scope := strings.Join(scopes, " ")
+	if accessToken, ok := client.cliTokens[scope]; ok && time.Until(accessToken.ExpiresOn) > cliTokenRefreshWindow {
+		return accessToken.Token, accessToken.ExpiresOn, nil
 	}
 
-	accessToken, err := azureCLICredentials.GetToken(ctx, client.createTokenRequestOptions(ctx, scopes))
+	if client.cliCredential == nil {
+		azureCLICredentials, err := azidentity.NewAzureCLICredential(&azidentity.AzureCLICredentialOptions{
+			AdditionallyAllowedTenants: client.config.AuxiliaryTenantIDs,
+			TenantID:                   client.config.TenantId,
+		})
+		if err != nil {
+			return "", time.Time{}, err
+		}
+		client.cliCredential = azureCLICredentials
+	}
+
+	accessToken, err := client.cliCredential.GetToken(ctx, client.createTokenRequestOptions(ctx, scopes))
 	if err != nil {
-		return "", time.Time{}, err
+		return "", time.Time{}, fmt.Errorf("could not get a token for '%s' from the Azure CLI, make sure that you are logged in with 'az login': %w", scope, err)
 	}
 
+	if client.cliTokens == nil {
+		client.cliTokens = map[string]azcore.AccessToken{}
+	}
+	client.cliTokens[scope] = accessToken
 	return accessToken.Token, accessToken.ExpiresOn, nil
 }
 
@@ -134,11 +160,14 @@ func (client *Auth) NewOidcCredential(options *OidcCredentialOptions) (*OidcCred
 		tokenFilePath: options.TokenFilePath,
 	}
 
-	if c.requestToken == "" {
-		return nil, errors.New("request Token is required for OIDC credential")
-	}
-	if c.requestUrl == "" {
-		return nil, errors.New("request URL is required for OIDC credential")
+	// an ID token, or the file it is written to, is used as is. Otherwise it is requested from the OIDC provider of the pipeline.
+	if c.token == "" && c.tokenFilePath == "" {
+		if c.requestToken == "" {
+			return nil, errors.New("request Token is required for OIDC credential")
+		}
+		if c.requestUrl == "" {
+			return nil, errors.New("request URL is required for OIDC credential")
+		}
 	}
 	if options.TenantID == "" {
 		return nil, errors.New("tenant is required for OIDC credential")
@@ -199,12 +228,7 @@ func (client *Auth) AuthenticateOIDC(ctx context.Context, scopes []string) (stri
 }
 
 func (client *Auth) AuthenticateUserManagedIdentity(ctx context.Context, scopes []string) (string, time.Time, error) {
-	userManagedIdentityCredential, err := azidentity.NewManagedIdentityCredential(&azidentity.ManagedIdentityCredentialOptions{
-		ID: azidentity.ClientID(client.config.ClientId),
-		ClientOptions: azcore.ClientOptions{
-			Cloud: client.config.Cloud,
-		},
-	})
+	userManagedIdentityCredential, err := client.newManagedIdentityCredential(client.config.ClientId)
 	if err != nil {
 		return "", time.Time{}, err
 	}
@@ -218,11 +242,7 @@ func (client *Auth) AuthenticateUserManagedIdentity(ctx context.Context, scopes
 }
 
 func (client *Auth) AuthenticateSystemManagedIdentity(ctx context.Context, scopes []string) (string, time.Time, error) {
-	systemManagedIdentityCredential, err := azidentity.NewManagedIdentityCredential(&azidentity.ManagedIdentityCredentialOptions{
-		ClientOptions: azcore.ClientOptions{
-			Cloud: client.config.Cloud,
-		},
-	})
+	systemManagedIdentityCredential, err := client.newManagedIdentityCredential("")
 	if err != nil {
 		return "", time.Time{}, err
 	}
@@ -235,6 +255,31 @@ func (client *Auth) AuthenticateSystemManagedIdentity(ctx context.Context, scope
 	return accessToken.Token, accessToken.ExpiresOn, nil
 }
 
+// newManagedIdentityCredential returns the credential of the managed identity of the Azure host running the provider, or of the user-assigned identity with the client id.
+// The AKS pods using workload identity have no managed identity endpoint, they get the federated token of their identity in a file instead.
+func (client *Auth) newManagedIdentityCredential(clientId string) (azcore.TokenCredential, error) {
+	clientOptions := azcore.ClientOptions{
+		Cloud: client.config.Cloud,
+	}
+
+	if tokenFilePath := os.Getenv(constants.ENV_VAR_AZURE_FEDERATED_TOKEN_FILE); tokenFilePath != "" {
+		return azidentity.NewWorkloadIdentityCredential(&azidentity.WorkloadIdentityCredentialOptions{
+			ClientID:      clientId,
+			TenantID:      client.config.TenantId,
+			TokenFilePath: tokenFilePath,
+			ClientOptions: clientOptions,
+		})
+	}
+
+	options := &azidentity.ManagedIdentityCredentialOptions{
+		ClientOptions: clientOptions,
+	}
+	if clientId != "" {
+		options.ID = azidentity.ClientID(clientId)
+	}
+	return azidentity.NewManagedIdentityCredential(options)
+}
+
 func (client *Auth) AuthenticateAzDOWorkloadIdentityFederation(ctx context.Context, scopes []string) (string, time.Time, error) {
 	if client.config.TenantId == "" {
 		return "", time.Time{}, errors.New("tenant ID must be provided to use Azure DevOps Workload Identity Federation")
@@ -304,7 +349,7 @@ func (w *OidcCredential) getAssertion(ctx context.Context) (string, error) {
 			return "", fmt.Errorf("reading token file: %w", err)
 		}
 
-		return string(idTokenData), nil
+		return strings.TrimSpace(string(idTokenData)), nil
 	}
 
 	req, err := http.NewRequestWithContext(ctx, "GET", w.requestUrl, http.NoBody)
@@ -5,9 +5,17 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/microsoft/terraform-provider-power-platform/internal/config"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -76,3 +84,126 @@ func TestUnitCreateTokenRequestOptions(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitNewOidcCredential_IdToken(t *testing.T) {
+	ctx := context.Background()
+	authClient := NewAuthBase(&config.ProviderConfig{})
+
+	tokenFilePath := filepath.Join(t.TempDir(), "id_token")
+	err := os.WriteFile(tokenFilePath, []byte("file_id_token\n"), 0600)
+	assert.NoError(t, err)
+
+	testCases := []struct {
+		name          string
+		options       OidcCredentialOptions
+		expectedToken string
+		expectedError string
+	}{
+		{
+			name:          "ID token without request",
+			options:       OidcCredentialOptions{TenantID: "tenant", ClientID: "client", Token: "id_token"},
+			expectedToken: "id_token",
+		},
+		{
+			name:          "ID token file without request",
+			options:       OidcCredentialOptions{TenantID: "tenant", ClientID: "client", TokenFilePath: tokenFilePath},
+			expectedToken: "file_id_token",
+		},
+		{
+			name:          "No ID token and no request token",
+			options:       OidcCredentialOptions{TenantID: "tenant", ClientID: "client", RequestUrl: "https://token.actions.githubusercontent.com"},
+			expectedError: "request Token is required for OIDC credential",
+		},
+		{
+			name:          "No ID token and no request URL",
+			options:       OidcCredentialOptions{TenantID: "tenant", ClientID: "client", RequestToken: "request_token"},
+			expectedError: "request URL is required for OIDC credential",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			credential, err := authClient.NewOidcCredential(&tc.options)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+
+			assertion, err := credential.getAssertion(ctx)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectedToken, assertion)
+		})
+	}
+}
+
+func TestUnitNewManagedIdentityCredential(t *testing.T) {
+	authClient := NewAuthBase(&config.ProviderConfig{UseMsi: true})
+
+	t.Run("Managed identity endpoint", func(t *testing.T) {
+		t.Setenv(constants.ENV_VAR_AZURE_FEDERATED_TOKEN_FILE, "")
+
+		credential, err := authClient.newManagedIdentityCredential("00000000-0000-0000-0000-000000000001")
+		assert.NoError(t, err)
+		assert.IsType(t, &azidentity.ManagedIdentityCredential{}, credential)
+	})
+
+	t.Run("AKS workload identity", func(t *testing.T) {
+		tokenFilePath := filepath.Join(t.TempDir(), "azure-identity-token")
+		err := os.WriteFile(tokenFilePath, []byte("federated_token"), 0600)
+		assert.NoError(t, err)
+		t.Setenv(constants.ENV_VAR_AZURE_FEDERATED_TOKEN_FILE, tokenFilePath)
+		t.Setenv("AZURE_TENANT_ID", "00000000-0000-0000-0000-000000000002")
+
+		credential, err := authClient.newManagedIdentityCredential("00000000-0000-0000-0000-000000000001")
+		assert.NoError(t, err)
+		assert.IsType(t, &azidentity.WorkloadIdentityCredential{}, credential)
+	})
+}
+
+type fakeCliCredential struct {
+	requests  []string
+	expiresIn time.Duration
+}
+
+func (c *fakeCliCredential) GetToken(ctx context.Context, opts policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	c.requests = append(c.requests, opts.Scopes[0])
+	return azcore.AccessToken{Token: fmt.Sprintf("token_%d", len(c.requests)), ExpiresOn: time.Now().Add(c.expiresIn)}, nil
+}
+
+func TestUnitAuthenticateUsingCli_TokenCache(t *testing.T) {
+	ctx := context.Background()
+	bapiScope := []string{"https://service.powerapps.com/.default"}
+	dataverseScope := []string{"https://org.crm4.dynamics.com/.default"}
+
+	t.Run("Tokens are reused per scope", func(t *testing.T) {
+		credential := &fakeCliCredential{expiresIn: time.Hour}
+		authClient := NewAuthBase(&config.ProviderConfig{UseCli: true})
+		authClient.cliCredential = credential
+
+		token, _, err := authClient.AuthenticateUsingCli(ctx, bapiScope)
+		assert.NoError(t, err)
+		assert.Equal(t, "token_1", token)
+
+		token, _, err = authClient.AuthenticateUsingCli(ctx, dataverseScope)
+		assert.NoError(t, err)
+		assert.Equal(t, "token_2", token)
+
+		token, _, err = authClient.AuthenticateUsingCli(ctx, bapiScope)
+		assert.NoError(t, err)
+		assert.Equal(t, "token_1", token)
+		assert.Equal(t, []string{bapiScope[0], dataverseScope[0]}, credential.requests)
+	})
+
+	t.Run("Tokens about to expire are requested again", func(t *testing.T) {
+		credential := &fakeCliCredential{expiresIn: time.Minute}
+		authClient := NewAuthBase(&config.ProviderConfig{UseCli: true})
+		authClient.cliCredential = credential
+
+		_, _, err := authClient.AuthenticateUsingCli(ctx, bapiScope)
+		assert.NoError(t, err)
+		token, _, err := authClient.AuthenticateUsingCli(ctx, bapiScope)
+		assert.NoError(t, err)
+		assert.Equal(t, "token_2", token)
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// circuitBreakerThreshold is the number of consecutive failures of a host after which its requests are short-circuited.
+	circuitBreakerThreshold = 5
+	// circuitBreakerCooldown is how long the requests to a host are short-circuited before it is tried again.
+	circuitBreakerCooldown = time.Minute
+)
+
+// CircuitOpenError is returned, without sending the request, while the requests to a host are short-circuited.
+type CircuitOpenError struct {
+	Host     string
+	Failures int
+	RetryAt  time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("requests to %s are not sent until %s because its last %d requests failed with a server error or a timeout", e.Host, e.RetryAt.Format(time.RFC3339), e.Failures)
+}
+
+type hostCircuit struct {
+	failures  int
+	openUntil time.Time
+}
+
+// circuitBreaker keeps track of the consecutive failed requests of each host, counting each request once whatever its retries, so that a host that is unhealthy
+// fails the requests of every resource quickly instead of having each of them retry it until it times out.
+type circuitBreaker struct {
+	mutex     sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+	hosts     map[string]*hostCircuit
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+		hosts:     map[string]*hostCircuit{},
+	}
+}
+
+// allow returns a CircuitOpenError while the requests to the host are short-circuited.
+// Once the cooldown has elapsed a single request is let through as a probe, and the circuit stays open for the other requests for another cooldown:
+// it closes when the probe succeeds, and another probe is let through after the cooldown when it fails or its outcome is never recorded.
+func (breaker *circuitBreaker) allow(host string) error {
+	if breaker == nil {
+		return nil
+	}
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	circuit, ok := breaker.hosts[host]
+	if !ok || circuit.openUntil.IsZero() {
+		return nil
+	}
+	if now := breaker.now(); !now.Before(circuit.openUntil) {
+		circuit.openUntil = now.Add(breaker.cooldown)
+		return nil
+	}
+	return &CircuitOpenError{
+		Host:     host,
+		Failures: circuit.failures,
+		RetryAt:  circuit.openUntil,
+	}
+}
+
+// record counts the outcome of a request sent to the host. Requests canceled by their own context say nothing about the host.
+func (breaker *circuitBreaker) record(ctx context.Context, host string, resp *http.Response, err error) {
+	if breaker == nil || ctx.Err() != nil {
+		return
+	}
+	breaker.mutex.Lock()
+	defer breaker.mutex.Unlock()
+
+	var netError net.Error
+	switch {
+	case err != nil && !(errors.As(err, &netError) && netError.Timeout()):
+		// the request could not be sent, there is no outcome to count.
+		return
+	case err == nil && resp != nil && resp.StatusCode < http.StatusInternalServerError:
+		// client errors, including throttling, mean that the host is responding.
+		delete(breaker.hosts, host)
+		return
+	}
+
+	circuit, ok := breaker.hosts[host]
+	if !ok {
+		circuit = &hostCircuit{}
+		breaker.hosts[host] = circuit
+	}
+	circuit.failures++
+	if circuit.failures >= breaker.threshold {
+		circuit.openUntil = breaker.now().Add(breaker.cooldown)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitCircuitBreaker_Single_Probe(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := newCircuitBreaker(2, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	failed := &http.Response{StatusCode: http.StatusServiceUnavailable}
+	breaker.record(ctx, "host", failed, nil)
+	assert.NoError(t, breaker.allow("host"))
+	breaker.record(ctx, "host", failed, nil)
+
+	var circuitOpenError *CircuitOpenError
+	assert.ErrorAs(t, breaker.allow("host"), &circuitOpenError)
+
+	// once the cooldown has elapsed only the first request is let through.
+	now = now.Add(time.Minute)
+	assert.NoError(t, breaker.allow("host"))
+	assert.ErrorAs(t, breaker.allow("host"), &circuitOpenError)
+	assert.ErrorAs(t, breaker.allow("host"), &circuitOpenError)
+
+	// a failed probe keeps the circuit open for another cooldown.
+	breaker.record(ctx, "host", failed, nil)
+	now = now.Add(30 * time.Second)
+	assert.ErrorAs(t, breaker.allow("host"), &circuitOpenError)
+
+	// a successful probe closes the circuit.
+	now = now.Add(30 * time.Second)
+	assert.NoError(t, breaker.allow("host"))
+	breaker.record(ctx, "host", &http.Response{StatusCode: http.StatusOK}, nil)
+	assert.NoError(t, breaker.allow("host"))
+	assert.NoError(t, breaker.allow("host"))
+}
+
+func TestUnitCircuitBreaker_Probe_Without_Outcome(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	breaker := newCircuitBreaker(1, time.Minute)
+	breaker.now = func() time.Time { return now }
+
+	breaker.record(context.Background(), "host", &http.Response{StatusCode: http.StatusBadGateway}, nil)
+
+	now = now.Add(time.Minute)
+	assert.NoError(t, breaker.allow("host"))
+
+	// a probe whose outcome is never recorded, for example because its context was canceled, doesn't keep the host short-circuited forever.
+	now = now.Add(time.Minute)
+	assert.NoError(t, breaker.allow("host"))
+}
@@ -10,11 +10,14 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"math/rand"
 	"net/http"
 	neturl "net/url"
 	"reflect"
+	"slices"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -40,6 +43,12 @@ func (client *Client) GetConfig() *config.ProviderConfig {
 type Client struct {
 	Config   *config.ProviderConfig
 	BaseAuth *Auth
+	breaker  *circuitBreaker
+	limiter  *rateLimiter
+
+	environmentLocks   *environmentLocks
+	dataverseMetadata  *dataverseMetadataCache
+	previewApiWarnings sync.Map
 }
 
 // ApiHttpResponse is a wrapper around http.Response that provides additional helper methods.
@@ -47,6 +56,11 @@ func NewApiClientBase(providerConfig *config.ProviderConfig, baseAuth *Auth) *Cl
 	return &Client{
 		Config:   providerConfig,
 		BaseAuth: baseAuth,
+		breaker:  newCircuitBreaker(circuitBreakerThreshold, circuitBreakerCooldown),
+		limiter:  newRateLimiter(),
+
+		environmentLocks:  newEnvironmentLocks(),
+		dataverseMetadata: newDataverseMetadataCache(),
 	}
 }
 
@@ -107,7 +121,8 @@ func IsCaeChallengeResponse(resp *http.Response) bool {
 //   - *Response: The response from the HTTP request.
 //   - error: An error if the request fails. Possible error types include:
 //   - UrlFormatError: Returned if the URL is invalid or not absolute.
-//   - UnexpectedHttpStatusCodeError: Returned if the response status code is not acceptable.
+//   - UnexpectedHttpStatusCodeError: Returned if the response status code is not acceptable. It carries the API error code and message of the response
+//     and the url, correlation id and request id of the request.
 //
 // If no scopes are provided, the method attempts to infer the scope from the URL. The URL is validated to ensure it is absolute and properly formatted.
 // The HTTP request is then prepared and executed. The response status code is checked against the list of acceptable status codes. If the status code
@@ -127,6 +142,8 @@ func (client *Client) Execute(ctx context.Context, scopes []string, method, url
 		return nil, customerrors.NewUrlFormatError(url, e)
 	}
 
+	retries := client.newRetryPolicy()
+	circuitChecked := false
 	for {
 		token, err := client.BaseAuth.GetTokenForScopes(ctx, scopes)
 
@@ -144,17 +161,49 @@ func (client *Client) Execute(ctx context.Context, scopes []string, method, url
 			return nil, err
 		}
 
+		// the circuit is only checked before the first attempt: the retries of a request are governed by its retry policy.
+		if !circuitChecked {
+			if err := client.breaker.allow(request.URL.Host); err != nil {
+				if failoverUrl := client.bapiFailoverUrl(url); failoverUrl != url {
+					if u, _ := neturl.Parse(failoverUrl); client.breaker.allow(u.Host) == nil {
+						tflog.Warn(ctx, fmt.Sprintf("%s, failing over to %s", err.Error(), failoverUrl))
+						url = failoverUrl
+						circuitChecked = true
+						continue
+					}
+				}
+				return nil, err
+			}
+			circuitChecked = true
+		}
+
+		ratePerSecond, burst := client.requestRate()
+		if delay := client.limiter.reserve(request.URL.Host, ratePerSecond, burst); delay > 0 {
+			tflog.Debug(ctx, fmt.Sprintf("Rate limiting requests to %s, waiting %s", request.URL.Host, delay))
+			if err := client.SleepWithContext(ctx, delay); err != nil {
+				return nil, err
+			}
+		}
+
 		resp, err := client.doRequest(ctx, token, request, headers)
 		if err != nil {
+			if resp != nil {
+				client.breaker.record(ctx, request.URL.Host, resp.HttpResponse, err)
+			}
 			return resp, fmt.Errorf("Error making %s request to %s. %w", request.Method, request.RequestURI, err)
 		}
 
+		// a request counts once against the circuit of a host: a response that will be retried on the same host is only recorded if the retries are exhausted.
+		isAcceptable := len(acceptableStatusCodes) > 0 && array.Contains(acceptableStatusCodes, resp.HttpResponse.StatusCode)
+		if isAcceptable || !isRetryable(method, headers, resp.HttpResponse.StatusCode) {
+			client.breaker.record(ctx, request.URL.Host, resp.HttpResponse, nil)
+		}
+
 		err = validateNoManagementApplicationPermissionsForBapiRequest(resp)
 		if err != nil {
 			return resp, err
 		}
 
-		isAcceptable := len(acceptableStatusCodes) > 0 && array.Contains(acceptableStatusCodes, resp.HttpResponse.StatusCode)
 		if isAcceptable {
 			if responseObj != nil && len(resp.BodyAsBytes) > 0 {
 				err = resp.MarshallTo(responseObj)
@@ -166,12 +215,26 @@ func (client *Client) Execute(ctx context.Context, scopes []string, method, url
 			return resp, nil
 		}
 
-		isRetryable := array.Contains(retryableStatusCodes, resp.HttpResponse.StatusCode)
-		if !isRetryable {
-			return resp, customerrors.NewUnexpectedHttpStatusCodeError(acceptableStatusCodes, resp.HttpResponse.StatusCode, resp.HttpResponse.Status, resp.BodyAsBytes)
+		if !isRetryable(method, headers, resp.HttpResponse.StatusCode) {
+			return resp, customerrors.NewUnexpectedHttpResponseError(acceptableStatusCodes, resp.HttpResponse, helpers.ScrubSensitiveValues(resp.BodyAsBytes))
 		}
 
-		waitFor := retryAfter(ctx, resp.HttpResponse)
+		waitFor, err := retries.next(ctx, resp.HttpResponse)
+		if err != nil {
+			client.breaker.record(ctx, request.URL.Host, resp.HttpResponse, nil)
+			return resp, &RetriesExhaustedError{
+				Reason: err,
+				Err:    customerrors.NewUnexpectedHttpResponseError(acceptableStatusCodes, resp.HttpResponse, helpers.ScrubSensitiveValues(resp.BodyAsBytes)).(customerrors.UnexpectedHttpStatusCodeError),
+			}
+		}
+
+		if resp.HttpResponse.StatusCode >= http.StatusInternalServerError {
+			if failoverUrl := client.bapiFailoverUrl(url); failoverUrl != url {
+				client.breaker.record(ctx, request.URL.Host, resp.HttpResponse, nil)
+				tflog.Warn(ctx, fmt.Sprintf("Received status code %d for request %s, failing over to %s", resp.HttpResponse.StatusCode, url, failoverUrl))
+				url = failoverUrl
+			}
+		}
 
 		tflog.Debug(ctx, fmt.Sprintf("Received status code %d for request %s, retrying after %s", resp.HttpResponse.StatusCode, url, waitFor))
 
@@ -182,18 +245,97 @@ func (client *Client) Execute(ctx context.Context, scopes []string, method, url
 	}
 }
 
-func (client *Client) HandleNotFoundResponse(resp *Response) error {
-	if resp.HttpResponse.StatusCode == http.StatusNotFound {
-		return fmt.Errorf("resource not found at '%s'", resp.HttpResponse.Request.URL)
+// bapiFailoverUrl switches a BAPI request url between the BAPI endpoint of the cloud and the configured failover endpoint.
+// Urls of other endpoints, or any url when no failover endpoint is configured, are returned as they are.
+func (client *Client) bapiFailoverUrl(url string) string {
+	failoverHost := client.Config.BapiFailoverUrl
+	if failoverHost == "" {
+		return url
 	}
-	return nil
+	u, err := neturl.Parse(url)
+	if err != nil {
+		return url
+	}
+	switch {
+	case strings.EqualFold(u.Host, client.Config.Urls.BapiUrl):
+		u.Host = failoverHost
+	case strings.EqualFold(u.Host, failoverHost):
+		u.Host = client.Config.Urls.BapiUrl
+	default:
+		return url
+	}
+	return u.String()
+}
+
+// ResponseErrors maps the failure status codes of a request to the error returned for a response with that status code.
+// The mapping receives the UnexpectedHttpStatusCodeError of the response, which the error it builds should wrap to keep the status code and the identifiers of the request.
+type ResponseErrors map[int]func(httpError customerrors.UnexpectedHttpStatusCodeError) error
+
+// ForbiddenResponseErrors reports forbidden responses as access denied errors.
+var ForbiddenResponseErrors = ResponseErrors{
+	http.StatusForbidden: forbiddenResponseError,
 }
 
-func (client *Client) HandleForbiddenResponse(resp *Response) error {
-	if resp.HttpResponse.StatusCode == http.StatusForbidden {
-		return fmt.Errorf("access denied to resource at '%s'. Please validate your permissions", resp.HttpResponse.Request.URL)
+// NotFoundOrForbiddenResponseErrors reports forbidden responses as access denied errors and not found responses as resource not found errors.
+var NotFoundOrForbiddenResponseErrors = ResponseErrors{
+	http.StatusForbidden: forbiddenResponseError,
+	http.StatusNotFound:  notFoundResponseError,
+}
+
+// With returns a copy of the response errors in which responses with the status code are reported with the error built by responseError.
+func (responseErrors ResponseErrors) With(statusCode int, responseError func(httpError customerrors.UnexpectedHttpStatusCodeError) error) ResponseErrors {
+	result := maps.Clone(responseErrors)
+	if result == nil {
+		result = ResponseErrors{}
 	}
-	return nil
+	result[statusCode] = responseError
+	return result
+}
+
+// ExecuteExpecting executes an HTTP request like Execute, but only the success status codes are accepted.
+// A response with one of the status codes of failureErrors is returned with the error built by its mapping, and the response body is not unmarshaled into responseObj.
+// Any other status code is returned as an UnexpectedHttpStatusCodeError listing the success status codes, after the retries done by Execute.
+func (client *Client) ExecuteExpecting(ctx context.Context, scopes []string, method, url string, headers http.Header, body any, successStatusCodes []int, failureErrors ResponseErrors, responseObj any) (*Response, error) {
+	acceptableStatusCodes := slices.Clone(successStatusCodes)
+	for statusCode := range failureErrors {
+		if !array.Contains(acceptableStatusCodes, statusCode) {
+			acceptableStatusCodes = append(acceptableStatusCodes, statusCode)
+		}
+	}
+
+	resp, err := client.Execute(ctx, scopes, method, url, headers, body, acceptableStatusCodes, nil)
+	if err != nil {
+		var httpError customerrors.UnexpectedHttpStatusCodeError
+		if !errors.As(err, &httpError) {
+			return resp, err
+		}
+		httpError.ExpectedStatusCodes = successStatusCodes
+		var retriesExhausted *RetriesExhaustedError
+		if errors.As(err, &retriesExhausted) {
+			return resp, &RetriesExhaustedError{Reason: retriesExhausted.Reason, Err: httpError}
+		}
+		return resp, httpError
+	}
+
+	if failureError, ok := failureErrors[resp.HttpResponse.StatusCode]; ok && !array.Contains(successStatusCodes, resp.HttpResponse.StatusCode) {
+		httpError := customerrors.NewUnexpectedHttpResponseError(successStatusCodes, resp.HttpResponse, helpers.ScrubSensitiveValues(resp.BodyAsBytes)).(customerrors.UnexpectedHttpStatusCodeError)
+		return resp, failureError(httpError)
+	}
+
+	if responseObj != nil && len(resp.BodyAsBytes) > 0 {
+		if err := resp.MarshallTo(responseObj); err != nil {
+			return resp, fmt.Errorf("Error marshalling response to json. %w", err)
+		}
+	}
+	return resp, nil
+}
+
+func notFoundResponseError(httpError customerrors.UnexpectedHttpStatusCodeError) error {
+	return customerrors.WrapIntoProviderError(httpError, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("resource not found at '%s'", httpError.Url))
+}
+
+func forbiddenResponseError(httpError customerrors.UnexpectedHttpStatusCodeError) error {
+	return customerrors.WrapIntoProviderError(httpError, customerrors.ERROR_ACCESS_DENIED, fmt.Sprintf("access denied to resource at '%s'. Please validate your permissions", httpError.Url))
 }
 
 func validateNoManagementApplicationPermissionsForBapiRequest(resp *Response) error {
@@ -233,6 +375,8 @@ func tryGetScopeFromURL(url string, cloudConfig config.ProviderConfigUrls) (stri
 	case strings.LastIndex(url, cloudConfig.BapiUrl) != -1,
 		strings.LastIndex(url, cloudConfig.PowerAppsUrl) != -1:
 		return cloudConfig.PowerAppsScope, nil
+	case strings.LastIndex(url, cloudConfig.PowerAutomateUrl) != -1:
+		return cloudConfig.PowerAutomateScope, nil
 	case strings.LastIndex(url, cloudConfig.PowerPlatformUrl) != -1:
 		return cloudConfig.PowerPlatformScope, nil
 	case strings.LastIndex(url, cloudConfig.PowerAppsAdvisor) != -1:
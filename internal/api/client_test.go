@@ -5,7 +5,11 @@ package api_test
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"strings"
 	"testing"
@@ -305,3 +309,345 @@ func TestUnitIsCaeChallengeResponse(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitApiClient_Execute_Bapi_Failover(t *testing.T) {
+	primaryRequests := 0
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryRequests++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer primary.Close()
+
+	failoverRequests := 0
+	failover := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		failoverRequests++
+		assert.Equal(t, "/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments", r.URL.Path)
+		assert.Equal(t, "api-version=2023-06-01", r.URL.RawQuery)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer failover.Close()
+
+	primaryUrl, _ := url.Parse(primary.URL)
+	failoverUrl, _ := url.Parse(failover.URL)
+	cfg := config.ProviderConfig{
+		TestMode:        true,
+		Urls:            config.ProviderConfigUrls{BapiUrl: primaryUrl.Host},
+		BapiFailoverUrl: failoverUrl.Host,
+	}
+
+	client := api.NewApiClientBase(&cfg, api.NewAuthBase(&cfg))
+	_, err := client.Execute(context.Background(), []string{"test"}, "GET", primary.URL+"/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments?api-version=2023-06-01", nil, nil, []int{http.StatusOK}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, primaryRequests)
+	assert.Equal(t, 1, failoverRequests)
+}
+
+func TestUnitApiClient_Execute_No_Failover_For_Other_Hosts(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := config.ProviderConfig{
+		TestMode:        true,
+		Urls:            config.ProviderConfigUrls{BapiUrl: "api.bap.microsoft.com"},
+		BapiFailoverUrl: "failover.api.bap.microsoft.com",
+	}
+
+	client := api.NewApiClientBase(&cfg, api.NewAuthBase(&cfg))
+	_, err := client.Execute(context.Background(), []string{"test"}, "GET", server.URL+"/api/data/v9.2/roles", nil, nil, []int{http.StatusOK}, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, requests)
+}
+
+func TestUnitApiClient_ExecuteExpecting(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/data/v9.2/roles":
+			w.WriteHeader(http.StatusOK)
+			_, _ = w.Write([]byte(`{"value":[{"roleid":"00000000-0000-0000-0000-000000000001"}]}`))
+		case "/api/data/v9.2/forbidden":
+			w.Header().Set("X-Ms-Correlation-Request-Id", "00000000-0000-0000-0000-000000000001")
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"error":{"code":"0x80040220","message":"Principal user is missing prvReadRole privilege"}}`))
+		case "/api/data/v9.2/conflict":
+			w.WriteHeader(http.StatusConflict)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"0x80060888","message":"Resource not found for the segment"}}`))
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.ProviderConfig{
+		TestMode: true,
+	}
+	client := api.NewApiClientBase(&cfg, api.NewAuthBase(&cfg))
+
+	roles := struct {
+		Value []map[string]string `json:"value"`
+	}{}
+	_, err := client.ExecuteExpecting(context.Background(), []string{"test"}, "GET", server.URL+"/api/data/v9.2/roles", nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &roles)
+	assert.NoError(t, err)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", roles.Value[0]["roleid"])
+
+	notFound := map[string]any{}
+	resp, err := client.ExecuteExpecting(context.Background(), []string{"test"}, "GET", server.URL+"/api/data/v9.2/missing", nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &notFound)
+	assert.ErrorContains(t, err, "resource not found at '"+server.URL+"/api/data/v9.2/missing'")
+	assert.Equal(t, customerrors.ERROR_OBJECT_NOT_FOUND, customerrors.Code(err))
+	assert.True(t, customerrors.IsNotFound(err))
+	assert.Equal(t, "0x80060888", customerrors.ApiErrorCode(err))
+	assert.Equal(t, http.StatusNotFound, resp.HttpResponse.StatusCode)
+	assert.Empty(t, notFound, "the body of a failure response must not be unmarshaled")
+
+	_, err = client.ExecuteExpecting(context.Background(), []string{"test"}, "GET", server.URL+"/api/data/v9.2/forbidden", nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, nil)
+	assert.ErrorContains(t, err, "access denied to resource at '"+server.URL+"/api/data/v9.2/forbidden'. Please validate your permissions")
+	assert.Equal(t, customerrors.ERROR_ACCESS_DENIED, customerrors.Code(err))
+	assert.False(t, customerrors.IsNotFound(err))
+	var forbiddenError customerrors.UnexpectedHttpStatusCodeError
+	assert.ErrorAs(t, err, &forbiddenError)
+	assert.Equal(t, http.StatusForbidden, forbiddenError.StatusCode)
+	assert.Equal(t, []int{http.StatusOK}, forbiddenError.ExpectedStatusCodes)
+	assert.Equal(t, "0x80040220", forbiddenError.ApiErrorCode)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", forbiddenError.CorrelationId)
+
+	// a status code that is both a success and mapped to an error is a success.
+	_, err = client.ExecuteExpecting(context.Background(), []string{"test"}, "DELETE", server.URL+"/api/data/v9.2/missing", nil, nil, []int{http.StatusNoContent, http.StatusNotFound}, api.NotFoundOrForbiddenResponseErrors, nil)
+	assert.NoError(t, err)
+
+	_, err = client.ExecuteExpecting(context.Background(), []string{"test"}, "GET", server.URL+"/api/data/v9.2/conflict", nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, nil)
+	var httpError customerrors.UnexpectedHttpStatusCodeError
+	assert.ErrorAs(t, err, &httpError)
+	assert.Equal(t, []int{http.StatusOK}, httpError.ExpectedStatusCodes)
+	assert.Equal(t, http.StatusConflict, httpError.StatusCode)
+}
+
+func TestUnitApiClient_Execute_Unexpected_Status_Code_Error(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Ms-Correlation-Request-Id", "00000000-0000-0000-0000-000000000001")
+		w.Header().Set("X-Ms-Service-Request-Id", "00000000-0000-0000-0000-000000000002")
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"error":{"code":"0x80040220","message":"Principal user is missing prvReadRole privilege"}}`))
+	}))
+	defer server.Close()
+
+	cfg := config.ProviderConfig{
+		TestMode: true,
+	}
+	client := api.NewApiClientBase(&cfg, api.NewAuthBase(&cfg))
+
+	_, err := client.Execute(context.Background(), []string{"test"}, "GET", server.URL+"/api/data/v9.2/roles", nil, nil, []int{http.StatusOK}, nil)
+	var httpError customerrors.UnexpectedHttpStatusCodeError
+	assert.ErrorAs(t, err, &httpError)
+	assert.Equal(t, http.StatusForbidden, httpError.StatusCode)
+	assert.Equal(t, "0x80040220", httpError.ApiErrorCode)
+	assert.Equal(t, "Principal user is missing prvReadRole privilege", httpError.Message)
+	assert.Equal(t, server.URL+"/api/data/v9.2/roles", httpError.Url)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000001", httpError.CorrelationId)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000002", httpError.RequestId)
+	assert.Contains(t, err.Error(), "correlation id: 00000000-0000-0000-0000-000000000001, request id: 00000000-0000-0000-0000-000000000002")
+
+	assert.Equal(t, http.StatusForbidden, customerrors.StatusCode(fmt.Errorf("reading roles: %w", err)))
+	assert.Equal(t, "0x80040220", customerrors.ApiErrorCode(fmt.Errorf("reading roles: %w", err)))
+	assert.Equal(t, 0, customerrors.StatusCode(errors.New("connection refused")))
+}
+
+func TestUnitApiClient_Execute_Circuit_Breaker(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	serverUrl, _ := url.Parse(server.URL)
+	cfg := config.ProviderConfig{
+		TestMode:   true,
+		MaxRetries: 1,
+		Urls:       config.ProviderConfigUrls{BapiUrl: serverUrl.Host},
+	}
+	client := api.NewApiClientBase(&cfg, api.NewAuthBase(&cfg))
+
+	// each request counts once, when its retries are exhausted.
+	for range 5 {
+		_, err := client.Execute(context.Background(), []string{"test"}, "GET", server.URL+"/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments", nil, nil, []int{http.StatusOK}, nil)
+		var retriesExhausted *api.RetriesExhaustedError
+		assert.ErrorAs(t, err, &retriesExhausted)
+	}
+	assert.Equal(t, 10, requests)
+
+	// the other requests to the host fail without being sent until the cooldown has elapsed.
+	_, err := client.Execute(context.Background(), []string{"test"}, "GET", server.URL+"/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001", nil, nil, []int{http.StatusOK}, nil)
+	var circuitOpenError *api.CircuitOpenError
+	assert.ErrorAs(t, err, &circuitOpenError)
+	assert.Equal(t, serverUrl.Host, circuitOpenError.Host)
+	assert.Equal(t, 5, circuitOpenError.Failures)
+	assert.Equal(t, 10, requests)
+}
+
+func TestUnitApiClient_Execute_Circuit_Breaker_Ignores_Own_Retries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests <= 7 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	serverUrl, _ := url.Parse(server.URL)
+	cfg := config.ProviderConfig{
+		TestMode: true,
+		Urls:     config.ProviderConfigUrls{BapiUrl: serverUrl.Host},
+	}
+	client := api.NewApiClientBase(&cfg, api.NewAuthBase(&cfg))
+
+	// the retries of a single request are bounded by max_retries, not by the circuit breaker threshold.
+	_, err := client.Execute(context.Background(), []string{"test"}, "GET", server.URL+"/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments", nil, nil, []int{http.StatusOK}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 8, requests)
+}
+
+func TestUnitApiClient_Execute_Circuit_Breaker_Ignores_Client_Errors(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	serverUrl, _ := url.Parse(server.URL)
+	cfg := config.ProviderConfig{
+		TestMode: true,
+		Urls:     config.ProviderConfigUrls{BapiUrl: serverUrl.Host},
+	}
+	client := api.NewApiClientBase(&cfg, api.NewAuthBase(&cfg))
+
+	for range 10 {
+		_, err := client.Execute(context.Background(), []string{"test"}, "GET", server.URL+"/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments", nil, nil, []int{http.StatusOK}, nil)
+		assert.Error(t, err)
+	}
+	assert.Equal(t, 10, requests)
+}
+
+func TestUnitApiClient_Execute_Max_Retries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	cfg := config.ProviderConfig{
+		TestMode:   true,
+		MaxRetries: 3,
+	}
+	client := api.NewApiClientBase(&cfg, api.NewAuthBase(&cfg))
+
+	// throttled requests were not processed, so they are retried whatever their method.
+	_, err := client.Execute(context.Background(), []string{"test"}, "POST", server.URL+"/api/data/v9.2/accounts", nil, map[string]any{"name": "Contoso"}, []int{http.StatusNoContent}, nil)
+
+	var httpError customerrors.UnexpectedHttpStatusCodeError
+	assert.ErrorAs(t, err, &httpError)
+	assert.Equal(t, http.StatusTooManyRequests, httpError.StatusCode)
+	assert.ErrorContains(t, err, "giving up after 3 retries")
+	assert.Equal(t, 4, requests)
+}
+
+func TestUnitApiClient_ExecuteExpecting_Max_Retries(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	cfg := config.ProviderConfig{
+		TestMode:   true,
+		MaxRetries: 2,
+	}
+	client := api.NewApiClientBase(&cfg, api.NewAuthBase(&cfg))
+
+	_, err := client.ExecuteExpecting(context.Background(), []string{"test"}, "GET", server.URL+"/api/data/v9.2/accounts", nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, nil)
+
+	var retriesExhausted *api.RetriesExhaustedError
+	assert.ErrorAs(t, err, &retriesExhausted)
+	assert.ErrorContains(t, err, "giving up after 2 retries")
+	var httpError customerrors.UnexpectedHttpStatusCodeError
+	assert.ErrorAs(t, err, &httpError)
+	assert.Equal(t, http.StatusServiceUnavailable, httpError.StatusCode)
+	assert.Equal(t, []int{http.StatusOK}, httpError.ExpectedStatusCodes)
+}
+
+func TestUnitApiClient_Execute_Retries_Idempotent_Requests_Only(t *testing.T) {
+	requests := 0
+	fail := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if fail {
+			fail = false
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	cfg := config.ProviderConfig{
+		TestMode: true,
+	}
+	client := api.NewApiClientBase(&cfg, api.NewAuthBase(&cfg))
+
+	// a POST request could have been applied before the server failed.
+	_, err := client.Execute(context.Background(), []string{"test"}, "POST", server.URL+"/api/data/v9.2/accounts", nil, nil, []int{http.StatusOK}, nil)
+	var httpError customerrors.UnexpectedHttpStatusCodeError
+	assert.ErrorAs(t, err, &httpError)
+	assert.Equal(t, http.StatusServiceUnavailable, httpError.StatusCode)
+	assert.Equal(t, 1, requests)
+
+	fail = true
+	_, err = client.Execute(context.Background(), []string{"test"}, "POST", server.URL+"/api/data/v9.2/accounts", api.IdempotencyKeyHeaders("account", "Contoso"), nil, []int{http.StatusOK}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, requests)
+
+	fail = true
+	_, err = client.Execute(context.Background(), []string{"test"}, "DELETE", server.URL+"/api/data/v9.2/accounts(00000000-0000-0000-0000-000000000001)", nil, nil, []int{http.StatusOK}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, requests)
+}
+
+func TestUnitApiClient_Execute_Max_Retry_Elapsed_Time(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	cfg := config.ProviderConfig{
+		TestMode:            true,
+		MaxRetryElapsedTime: time.Minute,
+	}
+	client := api.NewApiClientBase(&cfg, api.NewAuthBase(&cfg))
+
+	_, err := client.Execute(context.Background(), []string{"test"}, "GET", server.URL+"/api/data/v9.2/accounts", nil, nil, []int{http.StatusOK}, nil)
+
+	assert.ErrorContains(t, err, "retrying in 1h0m0s would exceed the maximum retry time of 1m0s")
+	assert.Equal(t, 1, requests)
+}
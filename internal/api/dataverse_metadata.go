@@ -0,0 +1,157 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+)
+
+// DataverseMetadata holds the tables of an environment and their columns, as described by the $metadata document of the Dataverse Web API.
+// The names are compared case insensitively.
+type DataverseMetadata struct {
+	tables map[string]map[string]struct{}
+}
+
+// HasTable returns true when the environment has a table with the logical name.
+func (metadata *DataverseMetadata) HasTable(tableLogicalName string) bool {
+	_, ok := metadata.tables[strings.ToLower(tableLogicalName)]
+	return ok
+}
+
+// HasColumn returns true when the table has a column or a navigation property with the logical name.
+func (metadata *DataverseMetadata) HasColumn(tableLogicalName, columnLogicalName string) bool {
+	_, ok := metadata.tables[strings.ToLower(tableLogicalName)][strings.ToLower(columnLogicalName)]
+	return ok
+}
+
+type dataverseMetadataDto struct {
+	DataServices struct {
+		Schemas []struct {
+			EntityTypes []struct {
+				Name                 string                         `xml:"Name,attr"`
+				BaseType             string                         `xml:"BaseType,attr"`
+				Properties           []dataverseMetadataPropertyDto `xml:"Property"`
+				NavigationProperties []dataverseMetadataPropertyDto `xml:"NavigationProperty"`
+			} `xml:"EntityType"`
+		} `xml:"Schema"`
+	} `xml:"DataServices"`
+}
+
+type dataverseMetadataPropertyDto struct {
+	Name string `xml:"Name,attr"`
+}
+
+func parseDataverseMetadata(document []byte) (*DataverseMetadata, error) {
+	dto := dataverseMetadataDto{}
+	if err := xml.Unmarshal(document, &dto); err != nil {
+		return nil, fmt.Errorf("error parsing the $metadata document: %w", err)
+	}
+
+	// the columns of tables such as task and email are partly inherited from their base type, activitypointer.
+	columns := map[string]map[string]struct{}{}
+	baseTypes := map[string]string{}
+	for _, schema := range dto.DataServices.Schemas {
+		for _, entityType := range schema.EntityTypes {
+			name := strings.ToLower(entityType.Name)
+			columns[name] = map[string]struct{}{}
+			for _, property := range append(entityType.Properties, entityType.NavigationProperties...) {
+				columns[name][strings.ToLower(property.Name)] = struct{}{}
+			}
+			if entityType.BaseType != "" {
+				// the base type is qualified with the namespace or alias of its schema, such as mscrm.activitypointer.
+				baseType := entityType.BaseType
+				baseTypes[name] = strings.ToLower(baseType[strings.LastIndex(baseType, ".")+1:])
+			}
+		}
+	}
+
+	metadata := &DataverseMetadata{
+		tables: map[string]map[string]struct{}{},
+	}
+	for name := range columns {
+		tableColumns := map[string]struct{}{}
+		visited := map[string]bool{}
+		for typeName := name; typeName != "" && !visited[typeName]; typeName = baseTypes[typeName] {
+			visited[typeName] = true
+			for column := range columns[typeName] {
+				tableColumns[column] = struct{}{}
+			}
+		}
+		metadata.tables[name] = tableColumns
+	}
+	return metadata, nil
+}
+
+// dataverseMetadataCache keeps the $metadata document of each environment for the lifetime of the provider, since the document
+// is large and doesn't change while a plan is computed.
+type dataverseMetadataCache struct {
+	mutex   sync.Mutex
+	entries map[string]*dataverseMetadataEntry
+}
+
+type dataverseMetadataEntry struct {
+	mutex    sync.Mutex
+	metadata *DataverseMetadata
+}
+
+func newDataverseMetadataCache() *dataverseMetadataCache {
+	return &dataverseMetadataCache{
+		entries: map[string]*dataverseMetadataEntry{},
+	}
+}
+
+func (cache *dataverseMetadataCache) get(environmentHost string) *dataverseMetadataEntry {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	key := strings.ToLower(environmentHost)
+	entry, ok := cache.entries[key]
+	if !ok {
+		entry = &dataverseMetadataEntry{}
+		cache.entries[key] = entry
+	}
+	return entry
+}
+
+// GetDataverseMetadata returns the tables and columns of the Dataverse environment at the host, downloading the $metadata document
+// the first time it is requested. Concurrent requests for the same environment wait for a single download, and a failed download is retried by the next request.
+func (client *Client) GetDataverseMetadata(ctx context.Context, environmentHost string) (*DataverseMetadata, error) {
+	entry := client.dataverseMetadata.get(environmentHost)
+	entry.mutex.Lock()
+	defer entry.mutex.Unlock()
+
+	if entry.metadata != nil {
+		return entry.metadata, nil
+	}
+
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/$metadata", client.GetConfig().GetDataverseApiVersion()),
+	}
+	headers := http.Header{}
+	headers.Set("Accept", "application/xml")
+
+	tflog.Debug(ctx, fmt.Sprintf("Downloading the $metadata document of environment '%s'", environmentHost))
+	resp, err := client.Execute(ctx, nil, "GET", apiUrl.String(), headers, nil, []int{http.StatusOK}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, err := parseDataverseMetadata(resp.BodyAsBytes)
+	if err != nil {
+		return nil, err
+	}
+	entry.metadata = metadata
+	return metadata, nil
+}
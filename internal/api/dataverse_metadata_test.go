@@ -0,0 +1,73 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseDataverseMetadata(t *testing.T) {
+	metadata, err := parseDataverseMetadata([]byte(`<?xml version="1.0" encoding="utf-8"?>
+	<edmx:Edmx Version="4.0" xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx">
+		<edmx:DataServices>
+			<Schema Namespace="Microsoft.Dynamics.CRM" xmlns="http://docs.oasis-open.org/odata/ns/edm">
+				<EntityType Name="account">
+					<Property Name="name" Type="Edm.String" />
+					<NavigationProperty Name="Account_Tasks" Type="Collection(mscrm.task)" />
+				</EntityType>
+			</Schema>
+		</edmx:DataServices>
+	</edmx:Edmx>`))
+	assert.NoError(t, err)
+
+	assert.True(t, metadata.HasTable("account"))
+	assert.True(t, metadata.HasTable("Account"))
+	assert.False(t, metadata.HasTable("contact"))
+
+	assert.True(t, metadata.HasColumn("account", "name"))
+	assert.True(t, metadata.HasColumn("account", "account_tasks"))
+	assert.False(t, metadata.HasColumn("account", "revenue"))
+	assert.False(t, metadata.HasColumn("contact", "name"))
+
+	_, err = parseDataverseMetadata([]byte(`{"error":"not xml"}`))
+	assert.Error(t, err)
+}
+
+func TestUnitParseDataverseMetadata_Inherited_Columns(t *testing.T) {
+	metadata, err := parseDataverseMetadata([]byte(`<?xml version="1.0" encoding="utf-8"?>
+	<edmx:Edmx Version="4.0" xmlns:edmx="http://docs.oasis-open.org/odata/ns/edmx">
+		<edmx:DataServices>
+			<Schema Namespace="Microsoft.Dynamics.CRM" Alias="mscrm" xmlns="http://docs.oasis-open.org/odata/ns/edm">
+				<EntityType Name="crmbaseentity" Abstract="true" />
+				<EntityType Name="activitypointer" BaseType="mscrm.crmbaseentity">
+					<Key>
+						<PropertyRef Name="activityid" />
+					</Key>
+					<Property Name="activityid" Type="Edm.Guid" />
+					<Property Name="subject" Type="Edm.String" />
+					<Property Name="description" Type="Edm.String" />
+					<NavigationProperty Name="regardingobjectid_account" Type="mscrm.account" />
+				</EntityType>
+				<EntityType Name="task" BaseType="mscrm.activitypointer">
+					<Property Name="percentcomplete" Type="Edm.Int32" />
+				</EntityType>
+				<EntityType Name="email" BaseType="mscrm.activitypointer">
+					<Property Name="sender" Type="Edm.String" />
+				</EntityType>
+			</Schema>
+		</edmx:DataServices>
+	</edmx:Edmx>`))
+	assert.NoError(t, err)
+
+	assert.True(t, metadata.HasColumn("task", "percentcomplete"))
+	assert.True(t, metadata.HasColumn("task", "subject"))
+	assert.True(t, metadata.HasColumn("task", "activityid"))
+	assert.True(t, metadata.HasColumn("task", "regardingobjectid_account"))
+	assert.True(t, metadata.HasColumn("email", "description"))
+	assert.True(t, metadata.HasColumn("email", "sender"))
+	assert.False(t, metadata.HasColumn("task", "sender"))
+	assert.False(t, metadata.HasColumn("activitypointer", "percentcomplete"))
+}
@@ -0,0 +1,56 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// environmentLocks serializes the operations of the provider on each environment, since Power Platform and Dataverse
+// reject an admin operation, such as a solution import or an application install, while another one is in progress on the same environment.
+type environmentLocks struct {
+	mutex sync.Mutex
+	locks map[string]chan struct{}
+}
+
+func newEnvironmentLocks() *environmentLocks {
+	return &environmentLocks{
+		locks: map[string]chan struct{}{},
+	}
+}
+
+func (locks *environmentLocks) get(environmentId string) chan struct{} {
+	locks.mutex.Lock()
+	defer locks.mutex.Unlock()
+
+	key := strings.ToLower(environmentId)
+	lock, ok := locks.locks[key]
+	if !ok {
+		lock = make(chan struct{}, 1)
+		locks.locks[key] = lock
+	}
+	return lock
+}
+
+// LockEnvironment waits until no other operation of the provider is in progress on the environment and returns the function releasing it.
+// The wait counts against the deadline of the context, so that a queued resource still honors its timeouts.
+func (client *Client) LockEnvironment(ctx context.Context, environmentId string) (func(), error) {
+	lock := client.environmentLocks.get(environmentId)
+	select {
+	case lock <- struct{}{}:
+	default:
+		tflog.Info(ctx, fmt.Sprintf("Waiting for another operation on environment '%s' to complete", environmentId))
+		select {
+		case lock <- struct{}{}:
+		case <-ctx.Done():
+			return nil, fmt.Errorf("waiting for another operation on environment '%s' to complete: %w", environmentId, ctx.Err())
+		}
+	}
+	return func() { <-lock }, nil
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitClient_LockEnvironment(t *testing.T) {
+	ctx := context.Background()
+	client := NewApiClientBase(&config.ProviderConfig{}, nil)
+
+	unlock, err := client.LockEnvironment(ctx, "00000000-0000-0000-0000-000000000001")
+	assert.NoError(t, err)
+
+	// the other environments are not locked.
+	unlockOther, err := client.LockEnvironment(ctx, "00000000-0000-0000-0000-000000000002")
+	assert.NoError(t, err)
+	unlockOther()
+
+	// the operations on the same environment wait for the lock to be released.
+	locked := make(chan struct{})
+	go func() {
+		unlockQueued, err := client.LockEnvironment(ctx, "00000000-0000-0000-0000-000000000001")
+		assert.NoError(t, err)
+		close(locked)
+		unlockQueued()
+	}()
+
+	select {
+	case <-locked:
+		t.Fatal("expected the operation to wait for the environment to be unlocked")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unlock()
+	select {
+	case <-locked:
+	case <-time.After(time.Second):
+		t.Fatal("expected the operation to get the lock once it was released")
+	}
+}
+
+func TestUnitClient_LockEnvironment_Context_Done(t *testing.T) {
+	client := NewApiClientBase(&config.ProviderConfig{}, nil)
+
+	unlock, err := client.LockEnvironment(context.Background(), "0000000A-0000-0000-0000-000000000001")
+	assert.NoError(t, err)
+	defer unlock()
+
+	// the ids of environments are not case sensitive.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.LockEnvironment(ctx, "0000000a-0000-0000-0000-000000000001")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
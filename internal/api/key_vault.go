@@ -0,0 +1,88 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+)
+
+type keyVaultSecretDto struct {
+	Id          string `json:"id"`
+	Value       string `json:"value"`
+	ContentType string `json:"contentType"`
+}
+
+// KeyVaultScope returns the token scope of the Key Vault service hosting the given vault, e.g. `https://vault.azure.net/.default` for `https://contoso.vault.azure.net/`.
+func KeyVaultScope(vaultUri string) (string, error) {
+	vault, err := url.Parse(vaultUri)
+	if err != nil {
+		return "", fmt.Errorf("invalid key vault uri '%s': %w", vaultUri, err)
+	}
+	_, domain, found := strings.Cut(vault.Hostname(), ".")
+	if !found || domain == "" {
+		return "", fmt.Errorf("invalid key vault uri '%s': expected a host in the form '<vault name>.<key vault domain>'", vaultUri)
+	}
+	return fmt.Sprintf("https://%s/.default", domain), nil
+}
+
+// GetKeyVaultSecret reads the current version of a secret from Azure Key Vault using the given credential.
+func GetKeyVaultSecret(ctx context.Context, credential azcore.TokenCredential, vaultUri, secretName string) (string, error) {
+	scope, err := KeyVaultScope(vaultUri)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := credential.GetToken(ctx, policy.TokenRequestOptions{Scopes: []string{scope}})
+	if err != nil {
+		return "", fmt.Errorf("failed to acquire key vault token: %w", err)
+	}
+
+	secretUrl, err := url.JoinPath(vaultUri, "secrets", url.PathEscape(secretName))
+	if err != nil {
+		return "", fmt.Errorf("invalid key vault uri '%s': %w", vaultUri, err)
+	}
+	secretUrl = fmt.Sprintf("%s?%s=%s", secretUrl, constants.API_VERSION_PARAM, constants.KEY_VAULT_API_VERSION)
+
+	tflog.Debug(ctx, fmt.Sprintf("Reading secret '%s' from key vault '%s'", secretName, vaultUri))
+	req, err := http.NewRequestWithContext(ctx, "GET", secretUrl, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token.Token)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret '%s' from key vault: %w", secretName, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("failed to read secret '%s' from key vault: %w", secretName, err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to read secret '%s' from key vault: received HTTP status %d with response: %s", secretName, resp.StatusCode, body)
+	}
+
+	secret := keyVaultSecretDto{}
+	if err := json.Unmarshal(body, &secret); err != nil {
+		return "", fmt.Errorf("failed to parse secret '%s' from key vault: %w", secretName, err)
+	}
+	if secret.Value == "" {
+		return "", fmt.Errorf("secret '%s' in key vault has no value", secretName)
+	}
+	return secret.Value, nil
+}
@@ -0,0 +1,94 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type staticTokenCredential struct {
+	scopes []string
+}
+
+func (c *staticTokenCredential) GetToken(_ context.Context, options policy.TokenRequestOptions) (azcore.AccessToken, error) {
+	c.scopes = options.Scopes
+	return azcore.AccessToken{Token: "key_vault_token", ExpiresOn: time.Now().Add(time.Hour)}, nil
+}
+
+func TestUnitKeyVaultScope(t *testing.T) {
+	testCases := []struct {
+		name          string
+		vaultUri      string
+		expectedScope string
+		expectError   bool
+	}{
+		{
+			name:          "Public cloud",
+			vaultUri:      "https://contoso.vault.azure.net/",
+			expectedScope: "https://vault.azure.net/.default",
+		},
+		{
+			name:          "China cloud",
+			vaultUri:      "https://contoso.vault.azure.cn",
+			expectedScope: "https://vault.azure.cn/.default",
+		},
+		{
+			name:        "Missing domain",
+			vaultUri:    "https://contoso",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scope, err := KeyVaultScope(tc.vaultUri)
+			if tc.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedScope, scope)
+		})
+	}
+}
+
+func TestUnitGetKeyVaultSecret(t *testing.T) {
+	var authorization, path, apiVersion string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		path = r.URL.Path
+		apiVersion = r.URL.Query().Get("api-version")
+		if r.URL.Path != "/secrets/power-platform-client-secret" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"SecretNotFound"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"id":"https://contoso.vault.azure.net/secrets/power-platform-client-secret/1","value":"client_secret_value"}`))
+	}))
+	defer server.Close()
+
+	// the test server listens on 127.0.0.1, so the scope is derived from the "0.0.1" part of the host.
+	credential := &staticTokenCredential{}
+	secret, err := GetKeyVaultSecret(context.Background(), credential, server.URL, "power-platform-client-secret")
+	require.NoError(t, err)
+
+	assert.Equal(t, "client_secret_value", secret)
+	assert.Equal(t, "Bearer key_vault_token", authorization)
+	assert.Equal(t, "/secrets/power-platform-client-secret", path)
+	assert.Equal(t, "7.4", apiVersion)
+	assert.Equal(t, []string{"https://0.0.1/.default"}, credential.scopes)
+
+	_, err = GetKeyVaultSecret(context.Background(), credential, server.URL, "missing")
+	require.ErrorContains(t, err, "received HTTP status 404")
+}
@@ -63,6 +63,7 @@ func (client *Client) DoWaitForLifecycleOperationStatus(ctx context.Context, res
 	}
 
 	waitFor := retryAfter(ctx, response.HttpResponse)
+	progress := client.NewOperationProgress("Lifecycle operation")
 
 	for {
 		lifecycleResponse := LifecycleDto{}
@@ -81,6 +82,7 @@ func (client *Client) DoWaitForLifecycleOperationStatus(ctx context.Context, res
 			continue
 		}
 		tflog.Debug(ctx, "Lifecycle Operation State: '"+lifecycleResponse.State.Id+"'")
+		progress.Report(ctx, lifecycleResponse.State.Id, LifecyclePercentComplete(&lifecycleResponse))
 
 		if lifecycleResponse.State.Id == "Succeeded" || lifecycleResponse.State.Id == "Failed" {
 			return &lifecycleResponse, nil
@@ -0,0 +1,79 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+)
+
+// OperationProgress reports the status of a long running operation at INFO level at a fixed interval,
+// so that operators can tell a slow apply from a hung one.
+type OperationProgress struct {
+	Operation string
+	Interval  time.Duration
+
+	startedAt    time.Time
+	lastReportAt time.Time
+}
+
+// NewOperationProgress starts tracking the progress of the named operation,
+// reported at the interval of the provider configuration or every two minutes when it is not set.
+func (client *Client) NewOperationProgress(operation string) *OperationProgress {
+	interval := constants.DEFAULT_OPERATION_PROGRESS_INTERVAL_IN_MINUTES
+	if cfg := client.GetConfig(); cfg != nil && cfg.OperationProgressInterval > 0 {
+		interval = cfg.OperationProgressInterval
+	}
+
+	now := time.Now()
+	return &OperationProgress{
+		Operation:    operation,
+		Interval:     interval,
+		startedAt:    now,
+		lastReportAt: now,
+	}
+}
+
+// Report logs the current status of the operation if the reporting interval has elapsed since the last report.
+// percentComplete is omitted from the message when it is negative, for operations that do not expose it.
+func (p *OperationProgress) Report(ctx context.Context, status string, percentComplete int) bool {
+	now := time.Now()
+	if now.Sub(p.lastReportAt) < p.Interval {
+		return false
+	}
+	p.lastReportAt = now
+
+	elapsed := now.Sub(p.startedAt).Round(time.Second)
+	message := fmt.Sprintf("%s still in progress after %s, status: '%s'", p.Operation, elapsed, status)
+	if percentComplete >= 0 {
+		message += fmt.Sprintf(", %d%% complete", percentComplete)
+	}
+
+	tflog.Info(ctx, message, map[string]any{
+		"operation":       p.Operation,
+		"status":          status,
+		"elapsed":         elapsed.String(),
+		"percentComplete": percentComplete,
+	})
+	return true
+}
+
+// LifecyclePercentComplete returns the share of succeeded stages of a lifecycle operation, or -1 when the operation has no stages.
+func LifecyclePercentComplete(lifecycle *LifecycleDto) int {
+	if lifecycle == nil || len(lifecycle.Stages) == 0 {
+		return -1
+	}
+
+	succeeded := 0
+	for _, stage := range lifecycle.Stages {
+		if stage.State.Id == "Succeeded" {
+			succeeded++
+		}
+	}
+	return succeeded * 100 / len(lifecycle.Stages)
+}
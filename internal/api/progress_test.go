@@ -0,0 +1,72 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/config"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitOperationProgress_Report(t *testing.T) {
+	ctx := context.Background()
+
+	progress := NewApiClientBase(&config.ProviderConfig{}, nil).NewOperationProgress("Test operation")
+	assert.Equal(t, constants.DEFAULT_OPERATION_PROGRESS_INTERVAL_IN_MINUTES, progress.Interval)
+	assert.False(t, progress.Report(ctx, "Running", -1), "expected no report before the interval elapsed")
+
+	progress.Interval = 0
+	assert.True(t, progress.Report(ctx, "Running", 50), "expected a report once the interval elapsed")
+
+	progress.Interval = time.Hour
+	assert.False(t, progress.Report(ctx, "Running", 75), "expected the interval to restart after a report")
+}
+
+func TestUnitOperationProgress_Configured_Interval(t *testing.T) {
+	client := NewApiClientBase(&config.ProviderConfig{OperationProgressInterval: 30 * time.Second}, nil)
+
+	progress := client.NewOperationProgress("Test operation")
+	assert.Equal(t, 30*time.Second, progress.Interval)
+}
+
+func TestUnitLifecyclePercentComplete(t *testing.T) {
+	testCases := []struct {
+		name      string
+		lifecycle *LifecycleDto
+		expected  int
+	}{
+		{
+			name:      "Nil lifecycle",
+			lifecycle: nil,
+			expected:  -1,
+		},
+		{
+			name:      "No stages",
+			lifecycle: &LifecycleDto{},
+			expected:  -1,
+		},
+		{
+			name: "Half of the stages succeeded",
+			lifecycle: &LifecycleDto{
+				Stages: []LifecycleStageDto{
+					{State: LifecycleStateDto{Id: "Succeeded"}},
+					{State: LifecycleStateDto{Id: "Succeeded"}},
+					{State: LifecycleStateDto{Id: "Running"}},
+					{State: LifecycleStateDto{Id: "NotStarted"}},
+				},
+			},
+			expected: 50,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, LifecyclePercentComplete(tc.lifecycle))
+		})
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultMaxRequestsPerSecond keeps the requests sent to a host below the Dataverse service protection limit
+	// of 6000 requests per 5 minutes, leaving room for the other clients of the same user.
+	DefaultMaxRequestsPerSecond = 18
+	// DefaultMaxRequestBurst is the number of requests that can be sent to a host at once before the rate applies.
+	DefaultMaxRequestBurst = 50
+)
+
+type hostBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// rateLimiter is a token bucket per host, so that large plans spread their requests to an environment
+// instead of having Dataverse throttle every request once its service protection limit is reached.
+type rateLimiter struct {
+	mutex sync.Mutex
+	now   func() time.Time
+	hosts map[string]*hostBucket
+}
+
+func newRateLimiter() *rateLimiter {
+	return &rateLimiter{
+		now:   time.Now,
+		hosts: map[string]*hostBucket{},
+	}
+}
+
+// reserve takes a token from the bucket of the host and returns how long the request has to wait for it.
+// Tokens are taken even when the bucket is empty, so that the requests waiting for a host are spread at the rate instead of being sent together.
+func (limiter *rateLimiter) reserve(host string, ratePerSecond, burst int) time.Duration {
+	if limiter == nil || ratePerSecond <= 0 {
+		return 0
+	}
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+
+	now := limiter.now()
+	bucket, ok := limiter.hosts[host]
+	if !ok {
+		bucket = &hostBucket{tokens: float64(burst), last: now}
+		limiter.hosts[host] = bucket
+	}
+
+	bucket.tokens = min(bucket.tokens+now.Sub(bucket.last).Seconds()*float64(ratePerSecond), float64(burst))
+	bucket.last = now
+	bucket.tokens--
+	if bucket.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-bucket.tokens / float64(ratePerSecond) * float64(time.Second))
+}
+
+// requestRate returns the rate and burst of the requests sent to a host, from the provider configuration or their defaults.
+func (client *Client) requestRate() (int, int) {
+	ratePerSecond := client.Config.MaxRequestsPerSecond
+	if ratePerSecond <= 0 {
+		ratePerSecond = DefaultMaxRequestsPerSecond
+	}
+	burst := client.Config.MaxRequestBurst
+	if burst <= 0 {
+		burst = DefaultMaxRequestBurst
+	}
+	return ratePerSecond, burst
+}
@@ -0,0 +1,47 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitRateLimiter_Reserve(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	limiter := newRateLimiter()
+	limiter.now = func() time.Time { return now }
+
+	// the burst is sent at once, the next requests are spread at the rate.
+	for range 3 {
+		assert.Equal(t, time.Duration(0), limiter.reserve("org.crm4.dynamics.com", 10, 3))
+	}
+	assert.Equal(t, 100*time.Millisecond, limiter.reserve("org.crm4.dynamics.com", 10, 3))
+	assert.Equal(t, 200*time.Millisecond, limiter.reserve("org.crm4.dynamics.com", 10, 3))
+
+	// the other hosts have their own bucket.
+	assert.Equal(t, time.Duration(0), limiter.reserve("api.bap.microsoft.com", 10, 3))
+
+	// the bucket refills at the rate, up to the burst.
+	now = now.Add(time.Second)
+	for range 3 {
+		assert.Equal(t, time.Duration(0), limiter.reserve("org.crm4.dynamics.com", 10, 3))
+	}
+	assert.Equal(t, 100*time.Millisecond, limiter.reserve("org.crm4.dynamics.com", 10, 3))
+}
+
+func TestUnitClient_RequestRate(t *testing.T) {
+	client := NewApiClientBase(&config.ProviderConfig{}, nil)
+	ratePerSecond, burst := client.requestRate()
+	assert.Equal(t, DefaultMaxRequestsPerSecond, ratePerSecond)
+	assert.Equal(t, DefaultMaxRequestBurst, burst)
+
+	client = NewApiClientBase(&config.ProviderConfig{MaxRequestsPerSecond: 5, MaxRequestBurst: 1}, nil)
+	ratePerSecond, burst = client.requestRate()
+	assert.Equal(t, 5, ratePerSecond)
+	assert.Equal(t, 1, burst)
+}
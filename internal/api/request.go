@@ -13,6 +13,7 @@ import (
 	"net/http"
 	"runtime"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,7 +25,8 @@ import (
 
 func (client *Client) doRequest(ctx context.Context, token *string, request *http.Request, headers http.Header) (*Response, error) {
 	if headers != nil {
-		request.Header = headers
+		// the headers are cloned as they are reused when the request is retried.
+		request.Header = headers.Clone()
 	}
 
 	if token == nil || *token == "" {
@@ -47,10 +49,24 @@ func (client *Client) doRequest(ctx context.Context, token *string, request *htt
 		sessionId, requestId := client.buildCorrelationHeaders(ctx)
 		request.Header.Set("X-Correlation-Id", sessionId)
 		request.Header.Set("X-Ms-Client-Session-Id", sessionId)
-		request.Header.Set("X-Ms-Client-Request-Id", requestId)
+		if request.Header.Get(constants.HEADER_CLIENT_REQUEST_ID) == "" {
+			request.Header.Set(constants.HEADER_CLIENT_REQUEST_ID, requestId)
+		}
+	} else if suffix := strings.TrimSpace(client.GetConfig().UserAgentSuffix); suffix != "" {
+		// The suffix is user supplied, so it is still sent when telemetry is opted out.
+		request.Header.Set("User-Agent", suffix)
+	}
+
+	// Custom headers never replace the ones set for the request itself, such as the bearer token.
+	for name, value := range client.GetConfig().CustomHeaders {
+		if request.Header.Get(name) == "" {
+			request.Header.Set(name, value)
+		}
 	}
 
+	start := time.Now()
 	apiResponse, err := httpClient.Do(request)
+	client.logSlowRequest(ctx, request, apiResponse, time.Since(start))
 	resp := &Response{
 		HttpResponse: apiResponse,
 	}
@@ -84,6 +100,34 @@ func (client *Client) doRequest(ctx context.Context, token *string, request *htt
 	return resp, err
 }
 
+// logSlowRequest logs a warning when a request took longer than the slow request threshold of the provider configuration.
+// The server request id lets the service owners find the request in their own logs.
+func (client *Client) logSlowRequest(ctx context.Context, request *http.Request, response *http.Response, duration time.Duration) {
+	threshold := client.GetConfig().SlowRequestThreshold
+	if threshold <= 0 || duration <= threshold {
+		return
+	}
+
+	fields := map[string]any{
+		"method":            request.Method,
+		"url":               request.URL.String(),
+		"duration":          duration.String(),
+		"duration_ms":       duration.Milliseconds(),
+		"threshold":         threshold.String(),
+		"client_request_id": request.Header.Get(constants.HEADER_CLIENT_REQUEST_ID),
+	}
+	if response != nil {
+		fields["status_code"] = response.StatusCode
+		for _, header := range []string{constants.HEADER_SERVICE_REQUEST_ID, constants.HEADER_REQUEST_ID} {
+			if requestId := response.Header.Get(header); requestId != "" {
+				fields["server_request_id"] = requestId
+				break
+			}
+		}
+	}
+	tflog.Warn(ctx, fmt.Sprintf("Slow request: %s %s took %s", request.Method, request.URL.String(), duration), fields)
+}
+
 type Response struct {
 	HttpResponse *http.Response
 	BodyAsBytes  []byte
@@ -143,6 +187,15 @@ func (client *Client) buildCorrelationHeaders(ctx context.Context) (sessionId st
 	return sessionId, requestId
 }
 
+// IdempotencyKeyHeaders returns the headers that send a client request id derived from the identity of the created object, such as its environment and application id.
+// The id is only used for tracing, the APIs don't deduplicate requests on it. A POST with these headers is retried on transient errors,
+// so they must only be sent with requests that are idempotent on the server, such as adding a user that may already exist.
+func IdempotencyKeyHeaders(identity ...string) http.Header {
+	headers := http.Header{}
+	headers.Set(constants.HEADER_CLIENT_REQUEST_ID, uuid.NewSHA1(uuid.NameSpaceURL, []byte(strings.ToLower(strings.Join(identity, "/")))).String())
+	return headers
+}
+
 func (client *Client) buildUserAgent(ctx context.Context) string {
 	userAgent := fmt.Sprintf("terraform-provider-power-platform/%s (%s; %s) terraform/%s go/%s", common.ProviderVersion, runtime.GOOS, runtime.GOARCH, client.Config.TerraformVersion, runtime.Version())
 
@@ -151,5 +204,9 @@ func (client *Client) buildUserAgent(ctx context.Context) string {
 		userAgent += fmt.Sprintf(" %s %s", requestContext.ObjectName, requestContext.RequestType)
 	}
 
+	if suffix := strings.TrimSpace(client.Config.UserAgentSuffix); suffix != "" {
+		userAgent += " " + suffix
+	}
+
 	return userAgent
 }
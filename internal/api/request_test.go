@@ -0,0 +1,183 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/microsoft/terraform-provider-power-platform/internal/config"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitBuildUserAgent_Suffix(t *testing.T) {
+	testCases := []struct {
+		name           string
+		suffix         string
+		expectedSuffix string
+	}{
+		{
+			name:           "No suffix",
+			suffix:         "",
+			expectedSuffix: "go/",
+		},
+		{
+			name:           "Suffix appended",
+			suffix:         "contoso-automation/1.0",
+			expectedSuffix: " contoso-automation/1.0",
+		},
+		{
+			name:           "Whitespace trimmed",
+			suffix:         "  contoso-automation/1.0  ",
+			expectedSuffix: " contoso-automation/1.0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := NewApiClientBase(&config.ProviderConfig{UserAgentSuffix: tc.suffix}, nil)
+
+			userAgent := client.buildUserAgent(context.Background())
+
+			assert.True(t, strings.HasPrefix(userAgent, "terraform-provider-power-platform/"))
+			if tc.suffix == "" {
+				assert.Contains(t, userAgent, tc.expectedSuffix)
+			} else {
+				assert.True(t, strings.HasSuffix(userAgent, tc.expectedSuffix), "unexpected user agent %q", userAgent)
+			}
+		})
+	}
+}
+
+func TestUnitDoRequest_UserAgentSuffixWithTelemetryOptout(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.Header.Get("User-Agent")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewApiClientBase(&config.ProviderConfig{TelemetryOptout: true, UserAgentSuffix: "contoso-automation/1.0"}, nil)
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	token := "token"
+	_, err = client.doRequest(context.Background(), &token, request, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "contoso-automation/1.0", userAgent)
+}
+
+func TestUnitDoRequest_CustomHeaders(t *testing.T) {
+	var headers http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewApiClientBase(&config.ProviderConfig{
+		TelemetryOptout: true,
+		CustomHeaders: map[string]string{
+			"Ocp-Apim-Subscription-Key": "subscription-key",
+			"Authorization":             "Bearer gateway",
+		},
+	}, nil)
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	token := "token"
+	_, err = client.doRequest(context.Background(), &token, request, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, "subscription-key", headers.Get("Ocp-Apim-Subscription-Key"))
+	assert.Equal(t, "Bearer token", headers.Get("Authorization"))
+}
+
+func TestUnitDoRequest_IdempotencyKey(t *testing.T) {
+	requestIds := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestIds = append(requestIds, r.Header.Get(constants.HEADER_CLIENT_REQUEST_ID))
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewApiClientBase(&config.ProviderConfig{}, nil)
+	headers := IdempotencyKeyHeaders("addUser", "00000000-0000-0000-0000-000000000001", "00000000-0000-0000-0000-000000000002")
+
+	token := "token"
+	for range 2 {
+		request, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, nil)
+		require.NoError(t, err)
+		_, err = client.doRequest(context.Background(), &token, request, headers)
+		require.NoError(t, err)
+	}
+
+	request, err := http.NewRequestWithContext(context.Background(), http.MethodPost, server.URL, nil)
+	require.NoError(t, err)
+	_, err = client.doRequest(context.Background(), &token, request, nil)
+	require.NoError(t, err)
+
+	require.Len(t, requestIds, 3)
+	assert.Equal(t, headers.Get(constants.HEADER_CLIENT_REQUEST_ID), requestIds[0])
+	assert.Equal(t, requestIds[0], requestIds[1])
+	assert.NotEqual(t, requestIds[0], requestIds[2])
+	assert.Empty(t, headers.Get("Authorization"), "the headers of the caller must not be modified")
+}
+
+func TestUnitIdempotencyKeyHeaders(t *testing.T) {
+	key := IdempotencyKeyHeaders("addAppUser", "00000000-0000-0000-0000-000000000001", "AAAAAAAA-0000-0000-0000-000000000002").Get(constants.HEADER_CLIENT_REQUEST_ID)
+
+	assert.Regexp(t, `^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`, key)
+	assert.Equal(t, key, IdempotencyKeyHeaders("addAppUser", "00000000-0000-0000-0000-000000000001", "aaaaaaaa-0000-0000-0000-000000000002").Get(constants.HEADER_CLIENT_REQUEST_ID))
+	assert.NotEqual(t, key, IdempotencyKeyHeaders("addUser", "00000000-0000-0000-0000-000000000001", "aaaaaaaa-0000-0000-0000-000000000002").Get(constants.HEADER_CLIENT_REQUEST_ID))
+}
+
+func TestUnitDoRequest_SlowRequestThreshold(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			time.Sleep(50 * time.Millisecond)
+		}
+		w.Header().Set(constants.HEADER_SERVICE_REQUEST_ID, "00000000-0000-0000-0000-000000000123")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	client := NewApiClientBase(&config.ProviderConfig{SlowRequestThreshold: 20 * time.Millisecond}, nil)
+
+	token := "token"
+	for _, path := range []string{"/fast", "/slow"} {
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+		require.NoError(t, err)
+		_, err = client.doRequest(ctx, &token, request, nil)
+		require.NoError(t, err)
+	}
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	require.NoError(t, err)
+
+	warnings := []map[string]any{}
+	for _, entry := range entries {
+		if entry["@level"] == "warn" {
+			warnings = append(warnings, entry)
+		}
+	}
+	require.Len(t, warnings, 1)
+	assert.Equal(t, server.URL+"/slow", warnings[0]["url"])
+	assert.Equal(t, http.MethodGet, warnings[0]["method"])
+	assert.Equal(t, float64(http.StatusOK), warnings[0]["status_code"])
+	assert.Equal(t, "00000000-0000-0000-0000-000000000123", warnings[0]["server_request_id"])
+}
@@ -0,0 +1,100 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers/array"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a request is retried when the provider configuration does not set `max_retries`.
+	DefaultMaxRetries = 10
+	// retryMaxBackoff caps the exponential backoff between retries of requests that got no Retry-After header.
+	retryMaxBackoff = 2 * time.Minute
+)
+
+// unprocessedStatusCodes are the retryable status codes of requests that the API has rejected without processing them,
+// which makes retrying them safe whatever the method of the request.
+var unprocessedStatusCodes = []int{
+	http.StatusUnauthorized,
+	http.StatusTooManyRequests,
+}
+
+// RetriesExhaustedError is returned by Execute when a request still gets a retryable status code once its retries are exhausted.
+// It wraps the UnexpectedHttpStatusCodeError of the last response.
+type RetriesExhaustedError struct {
+	Reason error
+	Err    customerrors.UnexpectedHttpStatusCodeError
+}
+
+func (e *RetriesExhaustedError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Reason.Error(), e.Err.Error())
+}
+
+func (e *RetriesExhaustedError) Unwrap() error {
+	return e.Err
+}
+
+// retryPolicy decides whether and when a failed request is sent again, within the limits of the provider configuration.
+type retryPolicy struct {
+	maxRetries     int
+	maxElapsedTime time.Duration
+	started        time.Time
+	retries        int
+}
+
+func (client *Client) newRetryPolicy() *retryPolicy {
+	maxRetries := client.Config.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = DefaultMaxRetries
+	}
+	return &retryPolicy{
+		maxRetries:     maxRetries,
+		maxElapsedTime: client.Config.MaxRetryElapsedTime,
+		started:        time.Now(),
+	}
+}
+
+// isIdempotent returns true for the requests that can be sent again without risking to apply them twice:
+// any request that is not a POST, and the POST requests sent with an idempotency key.
+func isIdempotent(method string, headers http.Header) bool {
+	return method != http.MethodPost || headers.Get(constants.HEADER_CLIENT_REQUEST_ID) != ""
+}
+
+// isRetryable returns true when the response has a retryable status code and the request can safely be sent again.
+func isRetryable(method string, headers http.Header, statusCode int) bool {
+	if !array.Contains(retryableStatusCodes, statusCode) {
+		return false
+	}
+	return array.Contains(unprocessedStatusCodes, statusCode) || isIdempotent(method, headers)
+}
+
+// next returns how long to wait before the next retry of the response, honoring its Retry-After header
+// and backing off exponentially otherwise. It returns an error once the retries or the elapsed time are exhausted.
+func (policy *retryPolicy) next(ctx context.Context, resp *http.Response) (time.Duration, error) {
+	if policy.retries >= policy.maxRetries {
+		return 0, fmt.Errorf("giving up after %d retries", policy.retries)
+	}
+
+	waitFor := retryAfter(ctx, resp)
+	if resp.Header.Get(constants.HEADER_RETRY_AFTER) == "" {
+		waitFor = min(waitFor<<policy.retries, retryMaxBackoff)
+	}
+
+	if policy.maxElapsedTime > 0 {
+		if elapsed := time.Since(policy.started); elapsed+waitFor > policy.maxElapsedTime {
+			return 0, fmt.Errorf("giving up after %d retries, retrying in %s would exceed the maximum retry time of %s", policy.retries, waitFor, policy.maxElapsedTime)
+		}
+	}
+
+	policy.retries++
+	return waitFor, nil
+}
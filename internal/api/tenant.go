@@ -0,0 +1,75 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+type openIdConfigurationDto struct {
+	Issuer string `json:"issuer"`
+}
+
+// ResolveTenantId returns the id of the Entra tenant identified by its id or by one of its verified domain names, e.g. `contoso.onmicrosoft.com`.
+// Domain names are resolved with the OpenID configuration that the authority publishes for the tenant, which doesn't require authentication.
+func ResolveTenantId(ctx context.Context, authorityHost, tenant string) (string, error) {
+	if _, err := uuid.Parse(tenant); err == nil {
+		return tenant, nil
+	}
+
+	configurationUrl, err := url.JoinPath(authorityHost, url.PathEscape(tenant), "v2.0", ".well-known", "openid-configuration")
+	if err != nil {
+		return "", fmt.Errorf("invalid authority host '%s': %w", authorityHost, err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Resolving the id of tenant '%s'", tenant))
+	req, err := http.NewRequestWithContext(ctx, "GET", configurationUrl, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the id of tenant '%s': %w", tenant, err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the id of tenant '%s': %w", tenant, err)
+	}
+	if resp.StatusCode == http.StatusBadRequest || resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("tenant '%s' not found, expected a tenant id or a verified domain name of the tenant", tenant)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to resolve the id of tenant '%s': received HTTP status %d with response: %s", tenant, resp.StatusCode, body)
+	}
+
+	configuration := openIdConfigurationDto{}
+	if err := json.Unmarshal(body, &configuration); err != nil {
+		return "", fmt.Errorf("failed to parse the OpenID configuration of tenant '%s': %w", tenant, err)
+	}
+
+	// the issuer has the form `https://login.microsoftonline.com/<tenant id>/v2.0`.
+	issuer, err := url.Parse(configuration.Issuer)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse the issuer of tenant '%s': %w", tenant, err)
+	}
+	for segment := range strings.SplitSeq(issuer.Path, "/") {
+		if _, err := uuid.Parse(segment); err == nil {
+			return segment, nil
+		}
+	}
+	return "", fmt.Errorf("the issuer '%s' of tenant '%s' has no tenant id", configuration.Issuer, tenant)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitResolveTenantId(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/contoso.onmicrosoft.com/v2.0/.well-known/openid-configuration" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_tenant","error_description":"AADSTS90002: Tenant not found."}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write([]byte(`{"issuer":"https://login.microsoftonline.com/00000000-0000-0000-0000-000000000123/v2.0","token_endpoint":"https://login.microsoftonline.com/00000000-0000-0000-0000-000000000123/oauth2/v2.0/token"}`))
+	}))
+	defer server.Close()
+
+	t.Run("Tenant id", func(t *testing.T) {
+		tenantId, err := ResolveTenantId(context.Background(), server.URL+"/", "00000000-0000-0000-0000-000000000001")
+		require.NoError(t, err)
+		assert.Equal(t, "00000000-0000-0000-0000-000000000001", tenantId)
+		assert.Equal(t, 0, requests)
+	})
+
+	t.Run("Domain name", func(t *testing.T) {
+		tenantId, err := ResolveTenantId(context.Background(), server.URL+"/", "contoso.onmicrosoft.com")
+		require.NoError(t, err)
+		assert.Equal(t, "00000000-0000-0000-0000-000000000123", tenantId)
+	})
+
+	t.Run("Unknown domain name", func(t *testing.T) {
+		_, err := ResolveTenantId(context.Background(), server.URL+"/", "fabrikam.onmicrosoft.com")
+		require.ErrorContains(t, err, "tenant 'fabrikam.onmicrosoft.com' not found")
+	})
+}
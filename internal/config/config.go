@@ -4,8 +4,11 @@
 package config
 
 import (
+	"time"
+
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 )
 
@@ -37,8 +40,9 @@ type ProviderConfig struct {
 	ClientId           string
 	ClientSecret       string
 
-	ClientCertificatePassword string
-	ClientCertificateRaw      string
+	ClientCertificatePassword  string
+	ClientCertificateRaw       string
+	ClientCertificateSendChain bool
 
 	OidcRequestToken  string
 	OidcRequestUrl    string
@@ -51,6 +55,40 @@ type ProviderConfig struct {
 	// CAE-related configuration
 	EnableContinuousAccessEvaluation bool
 
+	// UserAgentSuffix is appended to the User-Agent header of every request.
+	UserAgentSuffix string
+
+	// CustomHeaders are static headers added to every request, for example the subscription key of an API gateway.
+	CustomHeaders map[string]string
+
+	// DataverseApiVersion is the Dataverse Web API version used in `/api/data/<version>/` request paths.
+	DataverseApiVersion string
+
+	// BapiFailoverUrl is an alternative BAPI host that requests are retried against when the BAPI endpoint of the cloud returns a server error, and vice versa.
+	BapiFailoverUrl string
+
+	// SlowRequestThreshold is the duration above which a request is logged as a warning, zero when slow requests are not logged.
+	SlowRequestThreshold time.Duration
+
+	// OperationProgressInterval is the interval at which the status of long running operations is logged, zero for the default of the api client.
+	OperationProgressInterval time.Duration
+
+	// MaxRetries is the number of times a throttled or failed request is retried, zero for the default of the api client.
+	MaxRetries int
+
+	// MaxRetryElapsedTime bounds the time spent retrying a request, zero when only the timeouts of the resource apply.
+	MaxRetryElapsedTime time.Duration
+
+	// MaxRequestsPerSecond and MaxRequestBurst limit the requests sent to each host, zero for the defaults of the api client.
+	MaxRequestsPerSecond int
+	MaxRequestBurst      int
+
+	// RoleProfiles are named sets of security role ids that application users reference by name instead of listing the role ids.
+	RoleProfiles map[string][]string
+
+	// ValidateDataverseMetadata enables the validation of the table and column logical names of data records against the $metadata document of the environment at plan time.
+	ValidateDataverseMetadata bool
+
 	// internal runtime configuration values
 	TestMode         bool
 	Urls             ProviderConfigUrls
@@ -64,6 +102,8 @@ type ProviderConfigUrls struct {
 	BapiUrl               string
 	PowerAppsUrl          string
 	PowerAppsScope        string
+	PowerAutomateUrl      string
+	PowerAutomateScope    string
 	PowerPlatformUrl      string
 	PowerPlatformScope    string
 	LicensingUrl          string
@@ -101,6 +141,14 @@ func (model *ProviderConfig) GetCurrentCloudConfiguration(key CloudTypeConfigura
 	return configuration[string(model.CloudType)][string(key)]
 }
 
+// GetDataverseApiVersion returns the configured Dataverse Web API version, falling back to the provider default.
+func (model *ProviderConfig) GetDataverseApiVersion() string {
+	if model.DataverseApiVersion == "" {
+		return constants.DATAVERSE_API_VERSION
+	}
+	return model.DataverseApiVersion
+}
+
 func (model *ProviderConfig) IsUserManagedIdentityProvided() bool {
 	return model.UseMsi && model.ClientId != ""
 }
@@ -143,9 +191,10 @@ type ProviderConfigModel struct {
 	ClientId           types.String `tfsdk:"client_id"`
 	ClientSecret       types.String `tfsdk:"client_secret"`
 
-	ClientCertificateFilePath types.String `tfsdk:"client_certificate_file_path"`
-	ClientCertificate         types.String `tfsdk:"client_certificate"`
-	ClientCertificatePassword types.String `tfsdk:"client_certificate_password"`
+	ClientCertificateFilePath  types.String `tfsdk:"client_certificate_file_path"`
+	ClientCertificate          types.String `tfsdk:"client_certificate"`
+	ClientCertificatePassword  types.String `tfsdk:"client_certificate_password"`
+	ClientCertificateSendChain types.Bool   `tfsdk:"client_certificate_send_chain"`
 
 	OidcRequestToken  types.String `tfsdk:"oidc_request_token"`
 	OidcRequestUrl    types.String `tfsdk:"oidc_request_url"`
@@ -156,4 +205,24 @@ type ProviderConfigModel struct {
 
 	// CAE-related configuration
 	EnableContinuousAccessEvaluation types.Bool `tfsdk:"enable_continuous_access_evaluation"`
+
+	UserAgentSuffix types.String `tfsdk:"user_agent_suffix"`
+	CustomHeaders   types.Map    `tfsdk:"custom_headers"`
+
+	DataverseApiVersion       types.String `tfsdk:"dataverse_api_version"`
+	BapiFailoverUrl           types.String `tfsdk:"bapi_failover_url"`
+	SlowRequestThreshold      types.String `tfsdk:"slow_request_threshold"`
+	OperationProgressInterval types.String `tfsdk:"operation_progress_interval"`
+	MaxRetries                types.Int64  `tfsdk:"max_retries"`
+	MaxRetryElapsedTime       types.String `tfsdk:"max_retry_elapsed_time"`
+	MaxRequestsPerSecond      types.Int64  `tfsdk:"max_requests_per_second"`
+	MaxRequestBurst           types.Int64  `tfsdk:"max_request_burst"`
+
+	RoleProfiles types.Map `tfsdk:"role_profiles"`
+
+	ValidateDataverseMetadata types.Bool `tfsdk:"validate_dataverse_metadata"`
+
+	KeyVaultUri                         types.String `tfsdk:"key_vault_uri"`
+	ClientSecretKeyVaultSecretName      types.String `tfsdk:"client_secret_key_vault_secret_name"`
+	ClientCertificateKeyVaultSecretName types.String `tfsdk:"client_certificate_key_vault_secret_name"`
 }
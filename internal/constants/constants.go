@@ -28,6 +28,8 @@ const (
 	PUBLIC_BAPI_DOMAIN                  = "api.bap.microsoft.com"
 	PUBLIC_POWERAPPS_API_DOMAIN         = "api.powerapps.com"
 	PUBLIC_POWERAPPS_SCOPE              = "https://service.powerapps.com/.default"
+	PUBLIC_POWERAUTOMATE_API_DOMAIN     = "api.flow.microsoft.com"
+	PUBLIC_POWERAUTOMATE_SCOPE          = "https://service.flow.microsoft.com/.default"
 	PUBLIC_POWERPLATFORM_API_DOMAIN     = "api.powerplatform.com"
 	PUBLIC_POWERPLATFORM_API_SCOPE      = "https://api.powerplatform.com/.default"
 	PUBLIC_LICENSING_API_DOMAIN         = "licensing.powerplatform.microsoft.com"
@@ -42,6 +44,8 @@ const (
 	USDOD_BAPI_DOMAIN                  = "api.bap.appsplatform.us"
 	USDOD_POWERAPPS_API_DOMAIN         = "api.apps.appsplatform.us"
 	USDOD_POWERAPPS_SCOPE              = "https://service.apps.appsplatform.us/.default"
+	USDOD_POWERAUTOMATE_API_DOMAIN     = "api.flow.appsplatform.us"
+	USDOD_POWERAUTOMATE_SCOPE          = "https://service.flow.appsplatform.us/.default"
 	USDOD_POWERPLATFORM_API_DOMAIN     = "api.appsplatform.us"
 	USDOD_POWERPLATFORM_API_SCOPE      = "https://api.appsplatform.us/.default"
 	USDOD_LICENSING_API_DOMAIN         = "licensing.appsplatform.us"
@@ -56,12 +60,14 @@ const (
 	USGOV_BAPI_DOMAIN                  = "gov.api.bap.microsoft.us"
 	USGOV_POWERAPPS_API_DOMAIN         = "gov.api.powerapps.us"
 	USGOV_POWERAPPS_SCOPE              = "https://service.powerapps.us/.default"
+	USGOV_POWERAUTOMATE_API_DOMAIN     = "gov.api.flow.microsoft.us"
+	USGOV_POWERAUTOMATE_SCOPE          = "https://gov.service.flow.microsoft.us/.default"
 	USGOV_POWERPLATFORM_API_DOMAIN     = "api.gov.powerplatform.microsoft.us"
 	USGOV_POWERPLATFORM_API_SCOPE      = "https://api.gov.powerplatform.microsoft.us/.default"
 	USGOV_LICENSING_API_DOMAIN         = "gov.licensing.powerplatform.microsoft.us"
 	USGOV_POWERAPPS_ADVISOR_API_DOMAIN = "gov.api.advisor.powerapps.us"
 	USGOV_POWERAPPS_ADVISOR_API_SCOPE  = "https://gov.advisor.powerapps.us/.default"
-	USGOV_ANALYTICS_SCOPE              = "https://gcc.adminanalytics.powerplatform.microsoft.us//.default"
+	USGOV_ANALYTICS_SCOPE              = "https://gcc.adminanalytics.powerplatform.microsoft.us/.default"
 )
 
 const (
@@ -70,6 +76,8 @@ const (
 	USGOVHIGH_BAPI_DOMAIN                  = "high.api.bap.microsoft.us"
 	USGOVHIGH_POWERAPPS_API_DOMAIN         = "high.api.powerapps.us"
 	USGOVHIGH_POWERAPPS_SCOPE              = "https://high.service.apps.appsplatform.us/.default"
+	USGOVHIGH_POWERAUTOMATE_API_DOMAIN     = "high.api.flow.microsoft.us"
+	USGOVHIGH_POWERAUTOMATE_SCOPE          = "https://high.service.flow.microsoft.us/.default"
 	USGOVHIGH_POWERPLATFORM_API_DOMAIN     = "api.appsplatform.us"
 	USGOVHIGH_POWERPLATFORM_API_SCOPE      = "https://api.appsplatform.us/.default"
 	USGOVHIGH_LICENSING_API_DOMAIN         = "high.licensing.powerplatform.microsoft.us"
@@ -84,6 +92,8 @@ const (
 	CHINA_BAPI_DOMAIN                  = "api.bap.partner.microsoftonline.cn"
 	CHINA_POWERAPPS_API_DOMAIN         = "api.powerapps.cn"
 	CHINA_POWERAPPS_SCOPE              = "https://service.powerapps.cn/.default"
+	CHINA_POWERAUTOMATE_API_DOMAIN     = "api.powerautomate.cn"
+	CHINA_POWERAUTOMATE_SCOPE          = "https://service.powerautomate.cn/.default"
 	CHINA_POWERPLATFORM_API_DOMAIN     = "api.powerplatform.partner.microsoftonline.cn"
 	CHINA_POWERPLATFORM_API_SCOPE      = "https://api.powerplatform.partner.microsoftonline.cn/.default"
 	CHINA_LICENSING_API_DOMAIN         = "licensing.partner.microsoftonline.cn"
@@ -98,6 +108,8 @@ const (
 	EX_BAPI_DOMAIN                  = "api.bap.eaglex.ic.gov"
 	EX_POWERAPPS_API_DOMAIN         = "api.powerapps.eaglex.ic.gov"
 	EX_POWERAPPS_SCOPE              = "https://service.powerapps.eaglex.ic.gov/.default"
+	EX_POWERAUTOMATE_API_DOMAIN     = "api.flow.eaglex.ic.gov"
+	EX_POWERAUTOMATE_SCOPE          = "https://service.flow.eaglex.ic.gov/.default"
 	EX_POWERPLATFORM_API_DOMAIN     = "api.powerplatform.eaglex.ic.gov"
 	EX_POWERPLATFORM_API_SCOPE      = "https://api.powerplatform.eaglex.ic.gov/.default"
 	EX_AUTHORITY_HOST               = "https://login.microsoftonline.eaglex.ic.gov/"
@@ -113,6 +125,8 @@ const (
 	RX_BAPI_DOMAIN                  = "api.bap.microsoft.scloud"
 	RX_POWERAPPS_API_DOMAIN         = "api.powerapps.microsoft.scloud"
 	RX_POWERAPPS_SCOPE              = "https://service.powerapps.microsoft.scloud/.default"
+	RX_POWERAUTOMATE_API_DOMAIN     = "api.flow.microsoft.scloud"
+	RX_POWERAUTOMATE_SCOPE          = "https://service.flow.microsoft.scloud/.default"
 	RX_POWERPLATFORM_API_DOMAIN     = "api.powerplatform.microsoft.scloud"
 	RX_POWERPLATFORM_API_SCOPE      = "https://api.powerplatform.microsoft.scloud/.default"
 	RX_AUTHORITY_HOST               = "https://login.microsoftonline.microsoft.scloud/"
@@ -133,12 +147,22 @@ const (
 	HEADER_LOCATION           = "Location"
 	HEADER_OPERATION_LOCATION = "Operation-Location"
 	HEADER_RETRY_AFTER        = "Retry-After"
+	HEADER_CLIENT_REQUEST_ID  = "X-Ms-Client-Request-Id"
+	HEADER_SERVICE_REQUEST_ID = "X-Ms-Service-Request-Id"
+	HEADER_REQUEST_ID         = "X-Ms-Request-Id"
 	HTTPS                     = "https"
 	API_VERSION_PARAM         = "api-version"
+	KEY_VAULT_API_VERSION     = "7.4"
 )
 
 const (
-	DEFAULT_RESOURCE_OPERATION_TIMEOUT_IN_MINUTES = 20 * time.Minute
+	HEADER_CORRELATION_ID         = "X-Ms-Correlation-Id"
+	HEADER_CORRELATION_REQUEST_ID = "X-Ms-Correlation-Request-Id"
+)
+
+const (
+	DEFAULT_RESOURCE_OPERATION_TIMEOUT_IN_MINUTES  = 20 * time.Minute
+	DEFAULT_OPERATION_PROGRESS_INTERVAL_IN_MINUTES = 2 * time.Minute
 )
 
 const (
@@ -156,14 +180,37 @@ const (
 	ENV_VAR_POWER_PLATFORM_TELEMETRY_OPTOUT             = "POWER_PLATFORM_TELEMETRY_OPTOUT"
 	ENV_VAR_POWER_PLATFORM_AZDO_SERVICE_CONNECTION_ID   = "POWER_PLATFORM_AZDO_SERVICE_CONNECTION_ID"
 	ENV_VAR_POWER_PLATFORM_ENABLE_CAE                   = "POWER_PLATFORM_ENABLE_CAE"
-
-	ENV_VAR_ARM_OIDC_REQUEST_URL           = "ARM_OIDC_REQUEST_URL"
-	ENV_VAR_ACTIONS_ID_TOKEN_REQUEST_URL   = "ACTIONS_ID_TOKEN_REQUEST_URL"
-	ENV_VAR_ARM_OIDC_REQUEST_TOKEN         = "ARM_OIDC_REQUEST_TOKEN"
-	ENV_VAR_ACTIONS_ID_TOKEN_REQUEST_TOKEN = "ACTIONS_ID_TOKEN_REQUEST_TOKEN"
-	ENV_VAR_ARM_OIDC_TOKEN                 = "ARM_OIDC_TOKEN"
-	ENV_VAR_ARM_OIDC_TOKEN_FILE_PATH       = "ARM_OIDC_TOKEN_FILE_PATH"
-	ENV_VAR_ARM_AUXILIARY_TENANT_IDS       = "ARM_AUXILIARY_TENANT_IDS"
+	ENV_VAR_POWER_PLATFORM_USER_AGENT_SUFFIX            = "POWER_PLATFORM_USER_AGENT_SUFFIX"
+	ENV_VAR_POWER_PLATFORM_DATAVERSE_API_VERSION        = "POWER_PLATFORM_DATAVERSE_API_VERSION"
+	ENV_VAR_POWER_PLATFORM_BAPI_FAILOVER_URL            = "POWER_PLATFORM_BAPI_FAILOVER_URL"
+	ENV_VAR_POWER_PLATFORM_SLOW_REQUEST_THRESHOLD       = "POWER_PLATFORM_SLOW_REQUEST_THRESHOLD"
+	ENV_VAR_POWER_PLATFORM_OPERATION_PROGRESS_INTERVAL  = "POWER_PLATFORM_OPERATION_PROGRESS_INTERVAL"
+	ENV_VAR_POWER_PLATFORM_MAX_RETRIES                  = "POWER_PLATFORM_MAX_RETRIES"
+	ENV_VAR_POWER_PLATFORM_MAX_RETRY_ELAPSED_TIME       = "POWER_PLATFORM_MAX_RETRY_ELAPSED_TIME"
+	ENV_VAR_POWER_PLATFORM_MAX_REQUESTS_PER_SECOND      = "POWER_PLATFORM_MAX_REQUESTS_PER_SECOND"
+	ENV_VAR_POWER_PLATFORM_MAX_REQUEST_BURST            = "POWER_PLATFORM_MAX_REQUEST_BURST"
+	ENV_VAR_POWER_PLATFORM_VALIDATE_DATAVERSE_METADATA  = "POWER_PLATFORM_VALIDATE_DATAVERSE_METADATA"
+	ENV_VAR_POWER_PLATFORM_KEY_VAULT_URI                = "POWER_PLATFORM_KEY_VAULT_URI"
+	ENV_VAR_POWER_PLATFORM_CLIENT_SECRET_KEY_VAULT_NAME = "POWER_PLATFORM_CLIENT_SECRET_KEY_VAULT_SECRET_NAME"
+	ENV_VAR_POWER_PLATFORM_CLIENT_CERT_KEY_VAULT_NAME   = "POWER_PLATFORM_CLIENT_CERTIFICATE_KEY_VAULT_SECRET_NAME"
+
+	ENV_VAR_POWER_PLATFORM_OIDC_REQUEST_URI = "POWER_PLATFORM_OIDC_REQUEST_URI"
+	ENV_VAR_POWER_PLATFORM_OIDC_TOKEN       = "POWER_PLATFORM_OIDC_TOKEN"
+
+	ENV_VAR_POWER_PLATFORM_CLIENT_CERTIFICATE_SEND_CHAIN = "POWER_PLATFORM_CLIENT_CERTIFICATE_SEND_CHAIN"
+
+	ENV_VAR_ARM_OIDC_REQUEST_URL                   = "ARM_OIDC_REQUEST_URL"
+	ENV_VAR_ACTIONS_ID_TOKEN_REQUEST_URL           = "ACTIONS_ID_TOKEN_REQUEST_URL"
+	ENV_VAR_SYSTEM_OIDC_REQUEST_URI                = "SYSTEM_OIDCREQUESTURI"
+	ENV_VAR_ARM_OIDC_REQUEST_TOKEN                 = "ARM_OIDC_REQUEST_TOKEN"
+	ENV_VAR_ACTIONS_ID_TOKEN_REQUEST_TOKEN         = "ACTIONS_ID_TOKEN_REQUEST_TOKEN"
+	ENV_VAR_SYSTEM_ACCESS_TOKEN                    = "SYSTEM_ACCESSTOKEN"
+	ENV_VAR_ARM_OIDC_TOKEN                         = "ARM_OIDC_TOKEN"
+	ENV_VAR_ARM_OIDC_TOKEN_FILE_PATH               = "ARM_OIDC_TOKEN_FILE_PATH"
+	ENV_VAR_ARM_OIDC_AZURE_SERVICE_CONNECTION_ID   = "ARM_OIDC_AZURE_SERVICE_CONNECTION_ID"
+	ENV_VAR_ARM_ADO_PIPELINE_SERVICE_CONNECTION_ID = "ARM_ADO_PIPELINE_SERVICE_CONNECTION_ID"
+	ENV_VAR_ARM_AUXILIARY_TENANT_IDS               = "ARM_AUXILIARY_TENANT_IDS"
+	ENV_VAR_AZURE_FEDERATED_TOKEN_FILE             = "AZURE_FEDERATED_TOKEN_FILE"
 )
 
 const (
@@ -6,12 +6,14 @@ package customerrors
 import (
 	"errors"
 	"fmt"
+	"net/http"
 )
 
 type ErrorCode string
 
 const (
 	ERROR_OBJECT_NOT_FOUND             ErrorCode = "OBJECT_NOT_FOUND"
+	ERROR_ACCESS_DENIED                ErrorCode = "ACCESS_DENIED"
 	ERROR_ENVIRONMENT_URL_NOT_FOUND    ErrorCode = "ENVIRONMENT_URL_NOT_FOUND"
 	ERROR_ENVIRONMENTS_IN_ENV_GROUP    ErrorCode = "ENVIRONMENTS_IN_ENV_GROUP"
 	ERROR_POLICY_ASSIGNED_TO_ENV_GROUP ErrorCode = "POLICY_ASSIGNED_TO_ENV_GROUP"
@@ -34,6 +36,11 @@ func (e ProviderError) Error() string {
 	return fmt.Sprintf("%s: %s", e.ErrorCode, e.Err.Error())
 }
 
+// Unwrap returns the error of the provider error, so that errors.Is and errors.As reach the errors it wraps, such as the UnexpectedHttpStatusCodeError of a response.
+func (e ProviderError) Unwrap() error {
+	return e.Err
+}
+
 func Unwrap(err error) error {
 	if e, ok := err.(ProviderError); ok {
 		return errors.Unwrap(e.Err)
@@ -54,6 +61,15 @@ func Code(err error) ErrorCode {
 	return ""
 }
 
+// IsNotFound reports whether err, or an error it wraps, is a not found provider error or an API response with the 404 status code.
+func IsNotFound(err error) bool {
+	var providerError ProviderError
+	if errors.As(err, &providerError) && providerError.ErrorCode == ERROR_OBJECT_NOT_FOUND {
+		return true
+	}
+	return StatusCode(err) == http.StatusNotFound
+}
+
 func NewProviderError(errorCode ErrorCode, format string, args ...any) error {
 	return ProviderError{
 		Err:       fmt.Errorf(format, args...),
@@ -4,27 +4,121 @@
 package customerrors
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
 )
 
 var _ error = UnexpectedHttpStatusCodeError{}
 
+// UnexpectedHttpStatusCodeError is returned for a response of the Power Platform or Dataverse APIs with a status code that the request doesn't accept.
+// Besides the status code, it carries the error code and message of the API, and the identifiers the support of the service needs to find the request.
 type UnexpectedHttpStatusCodeError struct {
 	ExpectedStatusCodes []int
 	StatusCode          int
 	StatusText          string
 	Body                []byte
+	// ApiErrorCode is the code of the error in the response body, such as `0x80060888` for Dataverse or `EnvironmentNotFound` for BAPI.
+	ApiErrorCode string
+	// Message is the message of the error in the response body.
+	Message       string
+	Url           string
+	CorrelationId string
+	RequestId     string
 }
 
 func (e UnexpectedHttpStatusCodeError) Error() string {
-	return fmt.Sprintf("Unexpected HTTP status code. Expected: %v, received: [%d] %s | %s", e.ExpectedStatusCodes, e.StatusCode, e.StatusText, e.Body)
+	msg := fmt.Sprintf("Unexpected HTTP status code. Expected: %v, received: [%d] %s | %s", e.ExpectedStatusCodes, e.StatusCode, e.StatusText, e.Body)
+
+	details := []string{}
+	if e.Url != "" {
+		details = append(details, fmt.Sprintf("url: %s", e.Url))
+	}
+	if e.CorrelationId != "" {
+		details = append(details, fmt.Sprintf("correlation id: %s", e.CorrelationId))
+	}
+	if e.RequestId != "" {
+		details = append(details, fmt.Sprintf("request id: %s", e.RequestId))
+	}
+	if len(details) > 0 {
+		msg = fmt.Sprintf("%s | %s", msg, strings.Join(details, ", "))
+	}
+	return msg
 }
 
 func NewUnexpectedHttpStatusCodeError(expectedStatusCodes []int, statusCode int, statusText string, body []byte) error {
+	apiErrorCode, message := parseApiError(body)
 	return UnexpectedHttpStatusCodeError{
 		ExpectedStatusCodes: expectedStatusCodes,
 		StatusCode:          statusCode,
 		StatusText:          statusText,
 		Body:                body,
+		ApiErrorCode:        apiErrorCode,
+		Message:             message,
+	}
+}
+
+// NewUnexpectedHttpResponseError returns the UnexpectedHttpStatusCodeError of a response, identified by the url of its request and its correlation and request ids.
+func NewUnexpectedHttpResponseError(expectedStatusCodes []int, response *http.Response, body []byte) error {
+	err := NewUnexpectedHttpStatusCodeError(expectedStatusCodes, response.StatusCode, response.Status, body).(UnexpectedHttpStatusCodeError)
+	if response.Request != nil && response.Request.URL != nil {
+		err.Url = response.Request.URL.String()
+	}
+	err.CorrelationId = firstHeader(response.Header, constants.HEADER_CORRELATION_REQUEST_ID, constants.HEADER_CORRELATION_ID)
+	err.RequestId = firstHeader(response.Header, constants.HEADER_SERVICE_REQUEST_ID, constants.HEADER_REQUEST_ID)
+	if err.RequestId == "" && response.Request != nil {
+		err.RequestId = response.Request.Header.Get(constants.HEADER_CLIENT_REQUEST_ID)
+	}
+	return err
+}
+
+// StatusCode returns the HTTP status code of an UnexpectedHttpStatusCodeError in the chain of err, or 0 when there is none.
+func StatusCode(err error) int {
+	var httpError UnexpectedHttpStatusCodeError
+	if errors.As(err, &httpError) {
+		return httpError.StatusCode
+	}
+	return 0
+}
+
+// ApiErrorCode returns the API error code of an UnexpectedHttpStatusCodeError in the chain of err, or an empty string when there is none.
+func ApiErrorCode(err error) string {
+	var httpError UnexpectedHttpStatusCodeError
+	if errors.As(err, &httpError) {
+		return httpError.ApiErrorCode
+	}
+	return ""
+}
+
+// parseApiError reads the code and message of an error response body.
+// The APIs either nest them in an `error` object, as OData does, or return them at the root of the body.
+func parseApiError(body []byte) (string, string) {
+	type apiError struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	response := struct {
+		apiError
+		Error *apiError `json:"error"`
+	}{}
+	if len(body) == 0 || json.Unmarshal(body, &response) != nil {
+		return "", ""
+	}
+	if response.Error != nil {
+		return response.Error.Code, response.Error.Message
+	}
+	return response.Code, response.Message
+}
+
+func firstHeader(header http.Header, names ...string) string {
+	for _, name := range names {
+		if value := header.Get(name); value != "" {
+			return value
+		}
 	}
+	return ""
 }
@@ -0,0 +1,26 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package customtypes
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func NewJSONNull() JSON {
+	return JSON{
+		StringValue: basetypes.NewStringNull(),
+	}
+}
+
+func NewJSONUnknown() JSON {
+	return JSON{
+		StringValue: basetypes.NewStringUnknown(),
+	}
+}
+
+func NewJSONValue(value string) JSON {
+	return JSON{
+		StringValue: basetypes.NewStringValue(value),
+	}
+}
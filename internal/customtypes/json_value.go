@@ -0,0 +1,113 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package customtypes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+const (
+	JSONTypeErrorInvalidStringHeader  = "Invalid JSON String Value"
+	JSONTypeErrorInvalidStringDetails = `A string value was provided that is not valid JSON.\n\nGiven Value: %s\n`
+)
+
+var (
+	_ basetypes.StringValuable                   = (*JSONValue)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*JSONValue)(nil)
+	_ xattr.ValidateableAttribute                = (*JSONValue)(nil)
+)
+
+// JSON is a string holding a JSON document. Two documents are semantically equal when they only differ by
+// whitespace or by the order of the object properties, so that reformatting a document doesn't show as a change.
+type JSON = JSONValue
+
+type JSONValue struct {
+	basetypes.StringValue
+}
+
+func (v JSONValue) Type(_ context.Context) attr.Type {
+	return JSONType{}
+}
+
+func (v JSONValue) Equal(o attr.Value) bool {
+	other, ok := o.(JSONValue)
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v JSONValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			"An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\n"+
+				"Expected Value Type: "+fmt.Sprintf("%T", v)+"\n"+
+				"Got Value Type: "+fmt.Sprintf("%T", newValuable),
+		)
+
+		return false, diags
+	}
+
+	var oldJSON, newJSON any
+	if err := json.Unmarshal([]byte(v.ValueString()), &oldJSON); err != nil {
+		diags.AddError("expected old value to be valid JSON", err.Error())
+	}
+
+	if err := json.Unmarshal([]byte(newValue.ValueString()), &newJSON); err != nil {
+		diags.AddError("expected new value to be valid JSON", err.Error())
+	}
+
+	if diags.HasError() {
+		return false, diags
+	}
+
+	return reflect.DeepEqual(oldJSON, newJSON), diags
+}
+
+func (v JSONValue) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsUnknown() || v.IsNull() {
+		return
+	}
+
+	if !json.Valid([]byte(v.ValueString())) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			JSONTypeErrorInvalidStringHeader,
+			fmt.Sprintf(JSONTypeErrorInvalidStringDetails, v.ValueString()),
+		)
+
+		return
+	}
+}
+
+// Unmarshal decodes the document into target.
+func (v JSONValue) Unmarshal(target any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		diags.Append(diag.NewErrorDiagnostic(JSONTypeErrorInvalidStringHeader, "JSON string value is null or unknown"))
+
+		return diags
+	}
+
+	if err := json.Unmarshal([]byte(v.ValueString()), target); err != nil {
+		diags.Append(diag.NewErrorDiagnostic(JSONTypeErrorInvalidStringHeader, err.Error()))
+	}
+
+	return diags
+}
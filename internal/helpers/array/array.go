@@ -57,6 +57,21 @@ func Except[T comparable](a, b []T) []T {
 	return diff
 }
 
+// Union returns the elements of 'a' followed by the elements of 'b' that are not in 'a', without duplicates.
+func Union[T comparable](a, b []T) []T {
+	seen := make(map[T]struct{}, len(a)+len(b))
+	union := make([]T, 0, len(a)+len(b))
+	for _, values := range [][]T{a, b} {
+		for _, value := range values {
+			if _, found := seen[value]; !found {
+				seen[value] = struct{}{}
+				union = append(union, value)
+			}
+		}
+	}
+	return union
+}
+
 // Find returns the first element in the array that satisfies the predicate.
 func Find[T comparable](arr []T, predicate func(T) bool) T {
 	for _, v := range arr {
@@ -4,6 +4,7 @@
 package helpers
 
 import (
+	"bytes"
 	"crypto"
 	"crypto/x509"
 	"encoding/base64"
@@ -12,11 +13,18 @@ import (
 	"os"
 	"strings"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	pkcs12 "software.sslmate.com/src/go-pkcs12"
 )
 
+// pemBeginMarker starts the blocks of PEM encoded certificates and keys, which are accepted as is instead of a Base64 encoded PKCS#12 bundle.
+const pemBeginMarker = "-----BEGIN"
+
 func GetCertificateRawFromCertOrFilePath(certificate, certificateFilePath string) (string, error) {
 	if certificate != "" {
+		if strings.Contains(certificate, pemBeginMarker) {
+			return base64.StdEncoding.EncodeToString([]byte(strings.TrimSpace(certificate))), nil
+		}
 		return strings.TrimSpace(certificate), nil
 	}
 	if certificateFilePath != "" {
@@ -58,9 +66,15 @@ func convertBase64ToByte(b64 string) ([]byte, error) {
 }
 
 func convertByteToCert(certData []byte, password string) ([]*x509.Certificate, crypto.PrivateKey, error) {
-	var key crypto.PrivateKey
+	if bytes.Contains(certData, []byte(pemBeginMarker)) {
+		certs, key, err := azidentity.ParseCertificates(certData, []byte(password))
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not parse PEM certificate: %w", err)
+		}
+		return certs, key, nil
+	}
 
-	key, cert, _, err := pkcs12.DecodeChain(certData, password)
+	key, cert, caCerts, err := pkcs12.DecodeChain(certData, password)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -69,7 +83,8 @@ func convertByteToCert(certData []byte, password string) ([]*x509.Certificate, c
 		return nil, nil, errors.New("found no certificate")
 	}
 
-	certs := []*x509.Certificate{cert}
+	// the intermediate certificates of the bundle are kept, they are sent with the certificate for subject name and issuer authentication.
+	certs := append([]*x509.Certificate{cert}, caCerts...)
 
 	return certs, key, nil
 }
@@ -0,0 +1,112 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package helpers_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	pkcs12 "software.sslmate.com/src/go-pkcs12"
+)
+
+func newTestCertificate(t *testing.T, commonName string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func TestUnitConvertBase64ToCert_Pem(t *testing.T) {
+	cert, key := newTestCertificate(t, "pem", nil, nil)
+	keyDer, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPem := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})) + string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDer}))
+
+	certFilePath := filepath.Join(t.TempDir(), "cert.pem")
+	if err := os.WriteFile(certFilePath, []byte(certPem), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, args := range map[string][2]string{
+		"inline":    {certPem, ""},
+		"file path": {"", certFilePath},
+	} {
+		t.Run(name, func(t *testing.T) {
+			raw, err := helpers.GetCertificateRawFromCertOrFilePath(args[0], args[1])
+			if err != nil {
+				t.Fatal(err)
+			}
+			certs, privateKey, err := helpers.ConvertBase64ToCert(raw, "")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(certs) != 1 || certs[0].Subject.CommonName != "pem" {
+				t.Errorf("expected the pem certificate, got %v", certs)
+			}
+			if privateKey == nil {
+				t.Error("expected the private key of the certificate")
+			}
+		})
+	}
+}
+
+func TestUnitConvertBase64ToCert_Pkcs12_Chain(t *testing.T) {
+	ca, caKey := newTestCertificate(t, "ca", nil, nil)
+	cert, key := newTestCertificate(t, "client", ca, caKey)
+
+	pfx, err := pkcs12.Modern.Encode(key, cert, []*x509.Certificate{ca}, "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certs, privateKey, err := helpers.ConvertBase64ToCert(base64.StdEncoding.EncodeToString(pfx), "password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(certs) != 2 || certs[0].Subject.CommonName != "client" || certs[1].Subject.CommonName != "ca" {
+		t.Errorf("expected the client certificate followed by its chain, got %v", certs)
+	}
+	if privateKey == nil {
+		t.Error("expected the private key of the certificate")
+	}
+
+	_, _, err = helpers.ConvertBase64ToCert(base64.StdEncoding.EncodeToString(pfx), "wrong")
+	if err == nil {
+		t.Error("expected an error for a wrong password")
+	}
+}
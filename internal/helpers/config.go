@@ -80,6 +80,20 @@ func GetConfigBool(ctx context.Context, configValue basetypes.BoolValue, environ
 	return defaultValue
 }
 
+func GetConfigInt64(ctx context.Context, configValue basetypes.Int64Value, environmentVariableName string, defaultValue int64) int64 {
+	if !configValue.IsNull() {
+		return configValue.ValueInt64()
+	} else if value, ok := os.LookupEnv(environmentVariableName); ok && value != "" {
+		envValue, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			tflog.Warn(ctx, "Failed to parse environment variable value as an integer. Using default value instead.", map[string]any{environmentVariableName: value})
+			return defaultValue
+		}
+		return envValue
+	}
+	return defaultValue
+}
+
 func StringPtr(s string) *string {
 	return &s
 }
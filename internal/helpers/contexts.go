@@ -65,6 +65,9 @@ func EnterRequestContext[T AllowedRequestTypes](ctx context.Context, typ TypeInf
 	reqType := reflect.TypeOf(req).String()
 	name := typ.FullTypeName()
 
+	// Secrets sent to or returned by the APIs are masked in all the logs of the request.
+	ctx = MaskSensitiveValues(ctx)
+
 	tflog.Debug(ctx, fmt.Sprintf("%s START: %s", reqType, name), map[string]any{
 		"requestId":       reqId,
 		"providerVersion": common.ProviderVersion,
@@ -0,0 +1,23 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package helpers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+)
+
+// RemoveResourceIfNotFound removes the resource from the state when err reports that it was deleted outside of Terraform, so that the plan recreates it instead of failing.
+// It returns true when the resource was removed.
+func RemoveResourceIfNotFound(ctx context.Context, err error, state *tfsdk.State) bool {
+	if !customerrors.IsNotFound(err) {
+		return false
+	}
+	tflog.Warn(ctx, "Resource not found, removing it from the state", map[string]any{"error": err.Error()})
+	state.RemoveResource(ctx)
+	return true
+}
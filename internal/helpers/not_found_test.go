@@ -0,0 +1,101 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package helpers_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/config"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+func newTestState() tfsdk.State {
+	return tfsdk.State{
+		Schema: schema.Schema{
+			Attributes: map[string]schema.Attribute{
+				"id": schema.StringAttribute{Computed: true},
+			},
+		},
+		Raw: tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "00000000-0000-0000-0000-000000000001"),
+		}),
+	}
+}
+
+func TestUnitRemoveResourceIfNotFound(t *testing.T) {
+	t.Parallel()
+
+	notFound := customerrors.NewUnexpectedHttpStatusCodeError([]int{http.StatusOK}, http.StatusNotFound, "404 Not Found", nil)
+	forbidden := customerrors.NewUnexpectedHttpStatusCodeError([]int{http.StatusOK}, http.StatusForbidden, "403 Forbidden", nil)
+
+	for _, testCase := range []struct {
+		name    string
+		err     error
+		removed bool
+	}{
+		{name: "not found provider error", err: customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, "website not found"), removed: true},
+		{name: "wrapped not found provider error", err: fmt.Errorf("reading website: %w", customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, "website not found")), removed: true},
+		{name: "not found response", err: notFound, removed: true},
+		{name: "wrapped not found response", err: fmt.Errorf("reading website: %w", notFound), removed: true},
+		{name: "forbidden response", err: forbidden, removed: false},
+		{name: "other provider error", err: customerrors.WrapIntoProviderError(nil, customerrors.ERROR_ENVIRONMENT_URL_NOT_FOUND, "environment url not found"), removed: false},
+		{name: "other error", err: errors.New("connection refused"), removed: false},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := newTestState()
+
+			removed := helpers.RemoveResourceIfNotFound(context.Background(), testCase.err, &state)
+			if removed != testCase.removed {
+				t.Errorf("expected removed to be %t, got %t", testCase.removed, removed)
+			}
+			if state.Raw.IsNull() != testCase.removed {
+				t.Errorf("expected the state to be null: %t, got %t", testCase.removed, state.Raw.IsNull())
+			}
+		})
+	}
+}
+
+func TestUnitRemoveResourceIfNotFound_Response_Errors(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/data/v9.2/accounts(00000000-0000-0000-0000-000000000001)":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"code":"0x80040217","message":"Entity 'account' With Id = 00000000-0000-0000-0000-000000000001 Does Not Exist"}}`))
+		default:
+			w.WriteHeader(http.StatusForbidden)
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.ProviderConfig{
+		TestMode: true,
+	}
+	client := api.NewApiClientBase(&cfg, api.NewAuthBase(&cfg))
+
+	_, err := client.ExecuteExpecting(context.Background(), []string{"test"}, "GET", server.URL+"/api/data/v9.2/accounts(00000000-0000-0000-0000-000000000001)", nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, nil)
+	state := newTestState()
+	if !helpers.RemoveResourceIfNotFound(context.Background(), err, &state) || !state.Raw.IsNull() {
+		t.Errorf("expected a not found response to remove the resource from the state, error: %v", err)
+	}
+
+	_, err = client.ExecuteExpecting(context.Background(), []string{"test"}, "GET", server.URL+"/api/data/v9.2/accounts(00000000-0000-0000-0000-000000000002)", nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, nil)
+	state = newTestState()
+	if helpers.RemoveResourceIfNotFound(context.Background(), err, &state) || state.Raw.IsNull() {
+		t.Errorf("expected a forbidden response to keep the resource in the state, error: %v", err)
+	}
+}
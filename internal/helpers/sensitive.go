@@ -0,0 +1,41 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package helpers
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SENSITIVE_VALUE_MASK replaces the values of secrets in logs and error messages.
+const SENSITIVE_VALUE_MASK = "***"
+
+// sensitiveNamePattern matches the names of attributes and JSON properties that hold secrets, such as `client_secret`, `clientSecret`,
+// `password` or `connection_parameters`, in snake case as well as in camel case.
+const sensitiveNamePattern = `(?i:secret|password|passwd|(?:access|refresh|bearer)_?token|api_?key|access_?key|shared_?key|connection_?string|connection_?parameters)`
+
+var sensitiveNameRegex = regexp.MustCompile(sensitiveNamePattern)
+
+// sensitiveJsonValueRegex matches the JSON string properties with a sensitive name, capturing the name and the separator before the value.
+var sensitiveJsonValueRegex = regexp.MustCompile(`("[^"]*` + sensitiveNamePattern + `[^"]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+// IsSensitiveName returns true when the name of an attribute or a property suggests that its value is a secret.
+// The schema of an attribute with such a name must mark it as Sensitive, which is verified by the unit tests of the provider for all resources and data sources.
+func IsSensitiveName(name string) bool {
+	return sensitiveNameRegex.MatchString(name)
+}
+
+// ScrubSensitiveValues replaces the values of the JSON string properties with a sensitive name in a request or response body, so that the body can be logged or returned in an error.
+func ScrubSensitiveValues(body []byte) []byte {
+	return sensitiveJsonValueRegex.ReplaceAll(body, []byte(`$1"`+SENSITIVE_VALUE_MASK+`"`))
+}
+
+// MaskSensitiveValues masks the secrets in the log messages and fields of the context, for the logs of the clients and of the API client.
+func MaskSensitiveValues(ctx context.Context) context.Context {
+	ctx = tflog.MaskMessageRegexes(ctx, sensitiveJsonValueRegex)
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, sensitiveJsonValueRegex)
+	return ctx
+}
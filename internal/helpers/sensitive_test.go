@@ -0,0 +1,44 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package helpers_test
+
+import (
+	"testing"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+func TestUnitIsSensitiveName(t *testing.T) {
+	t.Parallel()
+
+	for name, expected := range map[string]bool{
+		"client_secret":                          true,
+		"clientSecret":                           true,
+		"password":                               true,
+		"connection_parameters":                  true,
+		"connectionParametersSet":                true,
+		"application_insights_connection_string": true,
+		"api_key":                                true,
+		"accessToken":                            true,
+		"token:clientId":                         false,
+		"display_name":                           false,
+		"environment_id":                         false,
+		"idempotency_key":                        false,
+	} {
+		if helpers.IsSensitiveName(name) != expected {
+			t.Errorf("IsSensitiveName(%q) should be %t", name, expected)
+		}
+	}
+}
+
+func TestUnitScrubSensitiveValues(t *testing.T) {
+	t.Parallel()
+
+	body := `{"name":"shared_sql","properties":{"connectionParameters":{"token:clientSecret":"s3cr\"et","token:clientId":"00000000-0000-0000-0000-000000000001"},"password" : "p@ss","displayName":"SQL"}}`
+	expected := `{"name":"shared_sql","properties":{"connectionParameters":{"token:clientSecret":"***","token:clientId":"00000000-0000-0000-0000-000000000001"},"password" : "***","displayName":"SQL"}}`
+
+	if scrubbed := string(helpers.ScrubSensitiveValues([]byte(body))); scrubbed != expected {
+		t.Errorf("unexpected scrubbed body %s", scrubbed)
+	}
+}
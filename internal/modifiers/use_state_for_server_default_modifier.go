@@ -0,0 +1,83 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package modifiers
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UseStateForServerDefaultString keeps the value of an attribute that the server assigns when it is not configured.
+// Unlike stringplanmodifier.UseStateForUnknown, an empty (null) server value is kept as well, so that it doesn't show as known after apply on every update.
+func UseStateForServerDefaultString() planmodifier.String {
+	return &useStateForServerDefaultModifier{}
+}
+
+func UseStateForServerDefaultBool() planmodifier.Bool {
+	return &useStateForServerDefaultModifier{}
+}
+
+func UseStateForServerDefaultInt64() planmodifier.Int64 {
+	return &useStateForServerDefaultModifier{}
+}
+
+func UseStateForServerDefaultSet() planmodifier.Set {
+	return &useStateForServerDefaultModifier{}
+}
+
+type useStateForServerDefaultModifier struct {
+}
+
+func (d *useStateForServerDefaultModifier) Description(ctx context.Context) string {
+	return "Once set by the server, the value of this attribute in state will not change unless it is configured."
+}
+
+func (d *useStateForServerDefaultModifier) MarkdownDescription(ctx context.Context) string {
+	return d.Description(ctx)
+}
+
+// useState tells whether the planned value can be replaced by the value in state:
+// the resource is updated (not created nor destroyed), the configuration doesn't set the value and the plan doesn't know it.
+// For a nested attribute the parent object must exist in state, otherwise its null value in state is not a server default.
+func (d *useStateForServerDefaultModifier) useState(ctx context.Context, state tfsdk.State, attributePath path.Path, planRawIsNull, configIsNull, planIsUnknown bool) bool {
+	if state.Raw.IsNull() || planRawIsNull || !configIsNull || !planIsUnknown {
+		return false
+	}
+
+	parentPath := attributePath.ParentPath()
+	if len(parentPath.Steps()) == 0 {
+		return true
+	}
+	var parent types.Object
+	diags := state.GetAttribute(ctx, parentPath, &parent)
+	return !diags.HasError() && !parent.IsNull() && !parent.IsUnknown()
+}
+
+func (d *useStateForServerDefaultModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if d.useState(ctx, req.State, req.Path, req.Plan.Raw.IsNull(), req.ConfigValue.IsNull(), req.PlanValue.IsUnknown()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (d *useStateForServerDefaultModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	if d.useState(ctx, req.State, req.Path, req.Plan.Raw.IsNull(), req.ConfigValue.IsNull(), req.PlanValue.IsUnknown()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (d *useStateForServerDefaultModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if d.useState(ctx, req.State, req.Path, req.Plan.Raw.IsNull(), req.ConfigValue.IsNull(), req.PlanValue.IsUnknown()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+func (d *useStateForServerDefaultModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if d.useState(ctx, req.State, req.Path, req.Plan.Raw.IsNull(), req.ConfigValue.IsNull(), req.PlanValue.IsUnknown()) {
+		resp.PlanValue = req.StateValue
+	}
+}
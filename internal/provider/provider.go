@@ -7,8 +7,12 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
@@ -32,22 +36,31 @@ import (
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/copilot_studio_application_insights"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/currencies"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/data_record"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/dataverse_action"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/dataverse_managed_identity"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/dataverse_workflow"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/dlp_policy"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/enterprise_policy"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment_backup"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment_group_rule_set"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment_groups"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment_settings"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment_templates"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment_wave"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/import_blocks"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/languages"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/licensing"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/locations"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/managed_environment"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/powerapps"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/powerautomate"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/powerpages"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/provider_health"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/rest"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/solution"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/solution_checker_rules"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/synapse_link"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/tenant"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/tenant_isolation_policy"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/tenant_settings"
@@ -55,6 +68,14 @@ import (
 
 var _ provider.Provider = &PowerPlatformProvider{}
 
+var dataverseApiVersionRegex = regexp.MustCompile(`^v\d+\.\d+$`)
+
+// hostNameRegex matches a DNS host name, without scheme, port or path.
+var hostNameRegex = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?(\.[A-Za-z0-9]([A-Za-z0-9-]*[A-Za-z0-9])?)+$`)
+
+// headerNameRegex matches the token characters allowed in an HTTP header name.
+var headerNameRegex = regexp.MustCompile("^[!#$%&'*+\\-.^_`|~0-9A-Za-z]+$")
+
 type PowerPlatformProvider struct {
 	Config *config.ProviderConfig
 	Api    *api.Client
@@ -107,7 +128,7 @@ func (p *PowerPlatformProvider) Schema(ctx context.Context, req provider.SchemaR
 				Optional:            true,
 			},
 			"tenant_id": schema.StringAttribute{
-				MarkdownDescription: "The id of the AAD tenant that Power Platform API uses to authenticate with",
+				MarkdownDescription: "The id of the AAD tenant that Power Platform API uses to authenticate with. A verified domain name of the tenant, such as `contoso.onmicrosoft.com`, can be used instead and is resolved to the tenant id",
 				Optional:            true,
 			},
 			"auxiliary_tenant_ids": schema.ListAttribute{
@@ -125,12 +146,12 @@ func (p *PowerPlatformProvider) Schema(ctx context.Context, req provider.SchemaR
 				Sensitive:           true,
 			},
 			"client_certificate": schema.StringAttribute{
-				MarkdownDescription: "Base64 encoded PKCS#12 certificate bundle. For use when authenticating as a Service Principal using a Client Certificate.",
+				MarkdownDescription: "Base64 encoded PKCS#12 certificate bundle, or PEM encoded certificate and private key. For use when authenticating as a Service Principal using a Client Certificate.",
 				Optional:            true,
 				Sensitive:           true,
 			},
 			"client_certificate_file_path": schema.StringAttribute{
-				MarkdownDescription: "The path to the Client Certificate associated with the Service Principal for use when authenticating as a Service Principal using a Client Certificate.",
+				MarkdownDescription: "The path to the Client Certificate associated with the Service Principal for use when authenticating as a Service Principal using a Client Certificate. Either a PKCS#12 (`.pfx`) bundle or a PEM file holding the certificate and its private key.",
 				Optional:            true,
 			},
 			"client_certificate_password": schema.StringAttribute{
@@ -138,6 +159,10 @@ func (p *PowerPlatformProvider) Schema(ctx context.Context, req provider.SchemaR
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"client_certificate_send_chain": schema.BoolAttribute{
+				MarkdownDescription: "Send the certificate chain with the token requests, for the app registrations using subject name and issuer (SNI) certificate authentication. Defaults to `false`.",
+				Optional:            true,
+			},
 			"use_oidc": schema.BoolAttribute{
 				MarkdownDescription: "Allow OpenID Connect to be used for authentication",
 				Optional:            true,
@@ -178,6 +203,69 @@ func (p *PowerPlatformProvider) Schema(ctx context.Context, req provider.SchemaR
 				MarkdownDescription: "Enables Continuous Access Evaluation (CAE) for authentication tokens. CAE allows for near real-time security policy enforcement such as user termination, password changes, and location policy changes. [Learn more about CAE](https://learn.microsoft.com/en-us/entra/identity/conditional-access/concept-continuous-access-evaluation).",
 				Optional:            true,
 			},
+			"user_agent_suffix": schema.StringAttribute{
+				MarkdownDescription: "A custom string appended to the `User-Agent` header of every request made by the provider, for example a company or automation identifier used to track traffic through an API gateway.",
+				Optional:            true,
+			},
+			"key_vault_uri": schema.StringAttribute{
+				MarkdownDescription: "The URI of the Azure Key Vault to read the client secret or client certificate from, for example `https://contoso.vault.azure.net/`. The vault is accessed using the ambient Azure credential (environment variables, workload identity, managed identity or Azure CLI).",
+				Optional:            true,
+			},
+			"client_secret_key_vault_secret_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the secret in `key_vault_uri` that holds the secret of the Power Platform API app registration. Use instead of `client_secret`.",
+				Optional:            true,
+			},
+			"client_certificate_key_vault_secret_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the secret in `key_vault_uri` that holds the Base64 encoded PKCS#12 certificate bundle of the Service Principal. For a Key Vault certificate this is the name of the certificate. Use instead of `client_certificate`.",
+				Optional:            true,
+			},
+			"custom_headers": schema.MapAttribute{
+				MarkdownDescription: "Static headers added to every request made to the Power Platform and Dataverse APIs, for example the subscription key or client assertion required by a corporate API gateway. Headers set by the provider itself, such as `Authorization`, take precedence.",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"dataverse_api_version": schema.StringAttribute{
+				MarkdownDescription: "The Dataverse Web API version used in `/api/data/<version>/` requests, for example `v9.1`. Default is `" + constants.DATAVERSE_API_VERSION + "`",
+				Optional:            true,
+			},
+			"bapi_failover_url": schema.StringAttribute{
+				MarkdownDescription: "The host name of an alternative BAPI endpoint, for example a regional endpoint, that requests are retried against when the BAPI endpoint of the cloud returns a server error, and vice versa.",
+				Optional:            true,
+			},
+			"slow_request_threshold": schema.StringAttribute{
+				MarkdownDescription: "A duration, such as `10s`, above which a request is logged as a warning with its url, duration and server request id. Slow requests are not logged by default.",
+				Optional:            true,
+			},
+			"operation_progress_interval": schema.StringAttribute{
+				MarkdownDescription: "A duration, such as `30s` or `5m`, at which the status of long running operations, such as solution imports or environment provisioning, is logged at INFO level. Defaults to `2m`.",
+				Optional:            true,
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of times a request that is throttled (429) or fails with a transient error (408, 499, 5xx) is retried, with exponential backoff unless the response has a `Retry-After` header. Requests that may not be idempotent, `POST` requests without an idempotency key, are only retried when they are throttled. Defaults to `%d`.", api.DefaultMaxRetries),
+				Optional:            true,
+			},
+			"max_retry_elapsed_time": schema.StringAttribute{
+				MarkdownDescription: "A duration, such as `5m`, after which a request is no longer retried. By default the retries are only bounded by `max_retries` and the timeouts of the resource.",
+				Optional:            true,
+			},
+			"max_requests_per_second": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of requests per second sent to each host, such as the Dataverse endpoint of an environment, to stay below the Dataverse service protection limits. Defaults to `%d`.", api.DefaultMaxRequestsPerSecond),
+				Optional:            true,
+			},
+			"max_request_burst": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("The number of requests that can be sent to a host at once before `max_requests_per_second` applies. Defaults to `%d`.", api.DefaultMaxRequestBurst),
+				Optional:            true,
+			},
+			"role_profiles": schema.MapAttribute{
+				MarkdownDescription: "Named sets of security role ids, such as `{ integration = [\"00000000-0000-0000-0000-000000000001\", \"00000000-0000-0000-0000-000000000002\"] }`. The `role_profile` of `powerplatform_application_user` resources references a profile by its name, so that modules pass a single name instead of long lists of role ids.",
+				ElementType:         types.SetType{ElemType: types.StringType},
+				Optional:            true,
+			},
+			"validate_dataverse_metadata": schema.BoolAttribute{
+				MarkdownDescription: "Flag to validate the `table_logical_name` and `columns` of `powerplatform_data_record` resources against the `$metadata` document of the environment when planning, so that misspelled tables and columns are reported by the plan instead of failing the apply. The document is downloaded once per environment. Default is `false`",
+				Optional:            true,
+			},
 		},
 	}
 }
@@ -204,12 +292,13 @@ func (p *PowerPlatformProvider) Configure(ctx context.Context, req provider.Conf
 	clientCertificate := helpers.GetConfigString(ctx, configValue.ClientCertificate, constants.ENV_VAR_POWER_PLATFORM_CLIENT_CERTIFICATE, "")
 	clientCertificateFilePath := helpers.GetConfigString(ctx, configValue.ClientCertificateFilePath, constants.ENV_VAR_POWER_PLATFORM_CLIENT_CERTIFICATE_FILE_PATH, "")
 	clientCertificatePassword := helpers.GetConfigString(ctx, configValue.ClientCertificatePassword, constants.ENV_VAR_POWER_PLATFORM_CLIENT_CERTIFICATE_PASSWORD, "")
+	clientCertificateSendChain := helpers.GetConfigBool(ctx, configValue.ClientCertificateSendChain, constants.ENV_VAR_POWER_PLATFORM_CLIENT_CERTIFICATE_SEND_CHAIN, false)
 	useMsi := helpers.GetConfigBool(ctx, configValue.UseMsi, constants.ENV_VAR_POWER_PLATFORM_USE_MSI, false)
-	azdoServiceConnectionId := helpers.GetConfigString(ctx, configValue.AzDOServiceConnectionID, constants.ENV_VAR_POWER_PLATFORM_AZDO_SERVICE_CONNECTION_ID, "")
+	azdoServiceConnectionId := helpers.GetConfigMultiString(ctx, configValue.AzDOServiceConnectionID, []string{constants.ENV_VAR_POWER_PLATFORM_AZDO_SERVICE_CONNECTION_ID, constants.ENV_VAR_ARM_OIDC_AZURE_SERVICE_CONNECTION_ID, constants.ENV_VAR_ARM_ADO_PIPELINE_SERVICE_CONNECTION_ID}, "")
 
 	// Check for AzDO and GitHub environment variables
-	oidcRequestUrl := helpers.GetConfigMultiString(ctx, configValue.OidcRequestUrl, []string{constants.ENV_VAR_ARM_OIDC_REQUEST_URL, constants.ENV_VAR_ACTIONS_ID_TOKEN_REQUEST_URL}, "")
-	oidcRequestToken := helpers.GetConfigMultiString(ctx, configValue.OidcRequestToken, []string{constants.ENV_VAR_ARM_OIDC_REQUEST_TOKEN, constants.ENV_VAR_ACTIONS_ID_TOKEN_REQUEST_TOKEN}, "")
+	oidcRequestUrl := helpers.GetConfigMultiString(ctx, configValue.OidcRequestUrl, []string{constants.ENV_VAR_ARM_OIDC_REQUEST_URL, constants.ENV_VAR_ACTIONS_ID_TOKEN_REQUEST_URL, constants.ENV_VAR_POWER_PLATFORM_OIDC_REQUEST_URI, constants.ENV_VAR_SYSTEM_OIDC_REQUEST_URI}, "")
+	oidcRequestToken := helpers.GetConfigMultiString(ctx, configValue.OidcRequestToken, []string{constants.ENV_VAR_ARM_OIDC_REQUEST_TOKEN, constants.ENV_VAR_ACTIONS_ID_TOKEN_REQUEST_TOKEN, constants.ENV_VAR_POWER_PLATFORM_OIDC_TOKEN, constants.ENV_VAR_SYSTEM_ACCESS_TOKEN}, "")
 	oidcToken := helpers.GetConfigString(ctx, configValue.OidcToken, constants.ENV_VAR_ARM_OIDC_TOKEN, "")
 	oidcTokenFilePath := helpers.GetConfigString(ctx, configValue.OidcTokenFilePath, constants.ENV_VAR_ARM_OIDC_TOKEN_FILE_PATH, "")
 
@@ -218,17 +307,157 @@ func (p *PowerPlatformProvider) Configure(ctx context.Context, req provider.Conf
 
 	// Get CAE configuration
 	enableCae := helpers.GetConfigBool(ctx, configValue.EnableContinuousAccessEvaluation, constants.ENV_VAR_POWER_PLATFORM_ENABLE_CAE, false)
+	validateDataverseMetadata := helpers.GetConfigBool(ctx, configValue.ValidateDataverseMetadata, constants.ENV_VAR_POWER_PLATFORM_VALIDATE_DATAVERSE_METADATA, false)
+
+	userAgentSuffix := helpers.GetConfigString(ctx, configValue.UserAgentSuffix, constants.ENV_VAR_POWER_PLATFORM_USER_AGENT_SUFFIX, "")
+
+	dataverseApiVersion := helpers.GetConfigString(ctx, configValue.DataverseApiVersion, constants.ENV_VAR_POWER_PLATFORM_DATAVERSE_API_VERSION, constants.DATAVERSE_API_VERSION)
+	if !dataverseApiVersionRegex.MatchString(dataverseApiVersion) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("dataverse_api_version"),
+			"Invalid Dataverse API version",
+			fmt.Sprintf("The value '%s' is not a valid Dataverse Web API version. Expected a value in the form `v9.2`. Either set the value in the provider configuration or use the '%s' environment variable.", dataverseApiVersion, constants.ENV_VAR_POWER_PLATFORM_DATAVERSE_API_VERSION),
+		)
+		return
+	}
+
+	bapiFailoverUrl := helpers.GetConfigString(ctx, configValue.BapiFailoverUrl, constants.ENV_VAR_POWER_PLATFORM_BAPI_FAILOVER_URL, "")
+	if bapiFailoverUrl != "" && !hostNameRegex.MatchString(bapiFailoverUrl) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bapi_failover_url"),
+			"Invalid BAPI failover url",
+			fmt.Sprintf("The value '%s' is not a valid host name. Expected a host name without scheme or path, such as `api.bap.microsoft.com`. Either set the value in the provider configuration or use the '%s' environment variable.", bapiFailoverUrl, constants.ENV_VAR_POWER_PLATFORM_BAPI_FAILOVER_URL),
+		)
+		return
+	}
+
+	slowRequestThreshold := time.Duration(0)
+	if value := helpers.GetConfigString(ctx, configValue.SlowRequestThreshold, constants.ENV_VAR_POWER_PLATFORM_SLOW_REQUEST_THRESHOLD, ""); value != "" {
+		threshold, err := time.ParseDuration(value)
+		if err != nil || threshold <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("slow_request_threshold"),
+				"Invalid slow request threshold",
+				fmt.Sprintf("The value '%s' is not a valid positive duration. Expected a duration such as `10s` or `1m30s`. Either set the value in the provider configuration or use the '%s' environment variable.", value, constants.ENV_VAR_POWER_PLATFORM_SLOW_REQUEST_THRESHOLD),
+			)
+			return
+		}
+		slowRequestThreshold = threshold
+	}
+
+	operationProgressInterval := time.Duration(0)
+	if value := helpers.GetConfigString(ctx, configValue.OperationProgressInterval, constants.ENV_VAR_POWER_PLATFORM_OPERATION_PROGRESS_INTERVAL, ""); value != "" {
+		interval, err := time.ParseDuration(value)
+		if err != nil || interval <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("operation_progress_interval"),
+				"Invalid operation progress interval",
+				fmt.Sprintf("The value '%s' is not a valid positive duration. Expected a duration such as `30s` or `5m`. Either set the value in the provider configuration or use the '%s' environment variable.", value, constants.ENV_VAR_POWER_PLATFORM_OPERATION_PROGRESS_INTERVAL),
+			)
+			return
+		}
+		operationProgressInterval = interval
+	}
+
+	maxRetries := helpers.GetConfigInt64(ctx, configValue.MaxRetries, constants.ENV_VAR_POWER_PLATFORM_MAX_RETRIES, api.DefaultMaxRetries)
+	if maxRetries < 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("max_retries"),
+			"Invalid max retries",
+			fmt.Sprintf("The value %d is not a valid number of retries. Expected a number of at least 1. Either set the value in the provider configuration or use the '%s' environment variable.", maxRetries, constants.ENV_VAR_POWER_PLATFORM_MAX_RETRIES),
+		)
+		return
+	}
+
+	maxRequestsPerSecond := helpers.GetConfigInt64(ctx, configValue.MaxRequestsPerSecond, constants.ENV_VAR_POWER_PLATFORM_MAX_REQUESTS_PER_SECOND, api.DefaultMaxRequestsPerSecond)
+	maxRequestBurst := helpers.GetConfigInt64(ctx, configValue.MaxRequestBurst, constants.ENV_VAR_POWER_PLATFORM_MAX_REQUEST_BURST, api.DefaultMaxRequestBurst)
+	for attribute, value := range map[string]int64{"max_requests_per_second": maxRequestsPerSecond, "max_request_burst": maxRequestBurst} {
+		if value < 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Invalid request rate limit",
+				fmt.Sprintf("The value %d of '%s' is not valid. Expected a number of at least 1.", value, attribute),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	maxRetryElapsedTime := time.Duration(0)
+	if value := helpers.GetConfigString(ctx, configValue.MaxRetryElapsedTime, constants.ENV_VAR_POWER_PLATFORM_MAX_RETRY_ELAPSED_TIME, ""); value != "" {
+		elapsedTime, err := time.ParseDuration(value)
+		if err != nil || elapsedTime <= 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("max_retry_elapsed_time"),
+				"Invalid max retry elapsed time",
+				fmt.Sprintf("The value '%s' is not a valid positive duration. Expected a duration such as `5m` or `1h`. Either set the value in the provider configuration or use the '%s' environment variable.", value, constants.ENV_VAR_POWER_PLATFORM_MAX_RETRY_ELAPSED_TIME),
+			)
+			return
+		}
+		maxRetryElapsedTime = elapsedTime
+	}
+
+	customHeaders := map[string]string{}
+	if !configValue.CustomHeaders.IsNull() && !configValue.CustomHeaders.IsUnknown() {
+		resp.Diagnostics.Append(configValue.CustomHeaders.ElementsAs(ctx, &customHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	for name := range customHeaders {
+		if !headerNameRegex.MatchString(name) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("custom_headers"),
+				"Invalid custom header",
+				fmt.Sprintf("The value '%s' is not a valid HTTP header name.", name),
+			)
+			return
+		}
+	}
+
+	roleProfiles := map[string][]string{}
+	if !configValue.RoleProfiles.IsNull() && !configValue.RoleProfiles.IsUnknown() {
+		resp.Diagnostics.Append(configValue.RoleProfiles.ElementsAs(ctx, &roleProfiles, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	guidRegex := regexp.MustCompile(helpers.GuidRegex)
+	for name, roleIds := range roleProfiles {
+		for _, roleId := range roleIds {
+			if !guidRegex.MatchString(roleId) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("role_profiles"),
+					"Invalid role profile",
+					fmt.Sprintf("The role profile '%s' contains the value '%s', which is not a valid security role id.", name, roleId),
+				)
+				return
+			}
+		}
+	}
+
+	keyVaultUri := helpers.GetConfigString(ctx, configValue.KeyVaultUri, constants.ENV_VAR_POWER_PLATFORM_KEY_VAULT_URI, "")
+	clientSecretKeyVaultSecretName := helpers.GetConfigString(ctx, configValue.ClientSecretKeyVaultSecretName, constants.ENV_VAR_POWER_PLATFORM_CLIENT_SECRET_KEY_VAULT_NAME, "")
+	clientCertificateKeyVaultSecretName := helpers.GetConfigString(ctx, configValue.ClientCertificateKeyVaultSecretName, constants.ENV_VAR_POWER_PLATFORM_CLIENT_CERT_KEY_VAULT_NAME, "")
+	if keyVaultUri != "" || clientSecretKeyVaultSecretName != "" || clientCertificateKeyVaultSecretName != "" {
+		configureKeyVaultSecrets(ctx, p, tenantId, keyVaultUri, clientSecretKeyVaultSecretName, clientCertificateKeyVaultSecretName, &clientSecret, &clientCertificate, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	if p.Config.TestMode {
 		configureTestMode(ctx)
 	} else if useCli {
-		configureUseCli(ctx, p)
+		configureUseCli(ctx, p, tenantId, auxiliaryTenantIDs)
 	} else if useOidc {
 		configureUseOidc(ctx, p, tenantId, clientId, oidcRequestToken, azdoServiceConnectionId, oidcRequestUrl, oidcToken, oidcTokenFilePath, resp)
 	} else if useMsi {
 		configureUseMsi(ctx, p, clientId, auxiliaryTenantIDs)
-	} else if clientCertificatePassword != "" && (clientCertificate != "" || clientCertificateFilePath != "") {
-		configureClientCertificate(ctx, p, tenantId, clientId, clientCertificate, clientCertificateFilePath, clientCertificatePassword, resp)
+	} else if (clientCertificatePassword != "" || clientCertificateKeyVaultSecretName != "" || clientSecret == "") && (clientCertificate != "" || clientCertificateFilePath != "") {
+		// certificates without a password, such as PEM files with an unencrypted key, are used when no client secret is set.
+		configureClientCertificate(ctx, p, tenantId, clientId, clientCertificate, clientCertificateFilePath, clientCertificatePassword, clientCertificateSendChain, resp)
 	} else {
 		configureClientSecret(ctx, p, tenantId, clientId, clientSecret, resp)
 	}
@@ -255,14 +484,36 @@ func (p *PowerPlatformProvider) Configure(ctx context.Context, req provider.Conf
 		resp.Diagnostics.AddAttributeError(
 			path.Root("cloud"),
 			"Unknown cloud",
-			fmt.Sprintf("The provider cannot create the API client as there is an unknown configuration value for `cloud`. Either set the value in the provider configuration or use the '%s' environment variable.", constants.ENV_VAR_POWER_PLATFORM_CLOUD),
+			fmt.Sprintf("The provider cannot create the API client as there is an unknown configuration value for `cloud`: %q. Valid values are `public`, `gcc`, `gcchigh`, `china`, `dod`, `ex` and `rx`. Either set the value in the provider configuration or use the '%s' environment variable.", cloudType, constants.ENV_VAR_POWER_PLATFORM_CLOUD),
 		)
+		return
 	}
 
 	p.Config.Urls = *providerConfigUrls
 	p.Config.Cloud = *cloudConfiguration
+
+	if p.Config.TenantId != "" && !p.Config.TestMode {
+		resolvedTenantId, err := api.ResolveTenantId(ctx, p.Config.Cloud.ActiveDirectoryAuthorityHost, p.Config.TenantId)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("tenant_id"), "Error resolving tenant id", err.Error())
+			return
+		}
+		p.Config.TenantId = resolvedTenantId
+	}
 	p.Config.TelemetryOptout = telemetryOptOut
 	p.Config.EnableContinuousAccessEvaluation = enableCae
+	p.Config.UserAgentSuffix = userAgentSuffix
+	p.Config.CustomHeaders = customHeaders
+	p.Config.DataverseApiVersion = dataverseApiVersion
+	p.Config.BapiFailoverUrl = bapiFailoverUrl
+	p.Config.SlowRequestThreshold = slowRequestThreshold
+	p.Config.OperationProgressInterval = operationProgressInterval
+	p.Config.MaxRetries = int(maxRetries)
+	p.Config.MaxRetryElapsedTime = maxRetryElapsedTime
+	p.Config.MaxRequestsPerSecond = int(maxRequestsPerSecond)
+	p.Config.MaxRequestBurst = int(maxRequestBurst)
+	p.Config.RoleProfiles = roleProfiles
+	p.Config.ValidateDataverseMetadata = validateDataverseMetadata
 	p.Config.TerraformVersion = req.TerraformVersion
 
 	providerClient := api.ProviderClient{
@@ -277,8 +528,17 @@ func configureTestMode(ctx context.Context) {
 	tflog.Info(ctx, "Test mode enabled. Authentication requests will not be sent to the backend APIs.")
 }
 
-func configureUseCli(ctx context.Context, p *PowerPlatformProvider) {
+func configureUseCli(ctx context.Context, p *PowerPlatformProvider, tenantId string, auxiliaryTenantIDs types.List) {
 	tflog.Info(ctx, "Using CLI for authentication")
+	// the tenant is optional, the tenant of the account logged in with the Azure CLI is used when it is not set.
+	p.Config.TenantId = tenantId
+	auxiliaryTenantIDsList := make([]string, 0, len(auxiliaryTenantIDs.Elements()))
+	for _, v := range auxiliaryTenantIDs.Elements() {
+		if id, ok := v.(types.String); ok {
+			auxiliaryTenantIDsList = append(auxiliaryTenantIDsList, id.ValueString())
+		}
+	}
+	p.Config.AuxiliaryTenantIDs = auxiliaryTenantIDsList
 	p.Config.UseCli = true
 }
 
@@ -316,7 +576,7 @@ func configureUseMsi(ctx context.Context, p *PowerPlatformProvider, clientId str
 	p.Config.UseMsi = true
 }
 
-func configureClientCertificate(ctx context.Context, p *PowerPlatformProvider, tenantId, clientId, clientCertificate, clientCertificateFilePath, clientCertificatePassword string, resp *provider.ConfigureResponse) {
+func configureClientCertificate(ctx context.Context, p *PowerPlatformProvider, tenantId, clientId, clientCertificate, clientCertificateFilePath, clientCertificatePassword string, clientCertificateSendChain bool, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Using client certificate for authentication")
 	validateProviderAttribute(resp, path.Root("tenant_id"), "tenant id", tenantId, constants.ENV_VAR_POWER_PLATFORM_TENANT_ID)
 	validateProviderAttribute(resp, path.Root("client_id"), "client id", clientId, constants.ENV_VAR_POWER_PLATFORM_CLIENT_ID)
@@ -327,10 +587,62 @@ func configureClientCertificate(ctx context.Context, p *PowerPlatformProvider, t
 	}
 	p.Config.ClientCertificateRaw = cert
 	p.Config.ClientCertificatePassword = clientCertificatePassword
+	p.Config.ClientCertificateSendChain = clientCertificateSendChain
 	p.Config.TenantId = tenantId
 	p.Config.ClientId = clientId
 }
 
+// configureKeyVaultSecrets reads the client secret and client certificate from Azure Key Vault using the ambient Azure credential.
+func configureKeyVaultSecrets(ctx context.Context, p *PowerPlatformProvider, tenantId, keyVaultUri, clientSecretSecretName, clientCertificateSecretName string, clientSecret, clientCertificate *string, resp *provider.ConfigureResponse) {
+	validateProviderAttribute(resp, path.Root("key_vault_uri"), "key vault uri", keyVaultUri, constants.ENV_VAR_POWER_PLATFORM_KEY_VAULT_URI)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if _, err := api.KeyVaultScope(keyVaultUri); err != nil || !strings.HasPrefix(keyVaultUri, "https://") {
+		resp.Diagnostics.AddAttributeError(path.Root("key_vault_uri"), "Invalid Key Vault URI", fmt.Sprintf("The value '%s' is not a valid Key Vault URI. Expected a value in the form `https://<vault name>.vault.azure.net/`.", keyVaultUri))
+		return
+	}
+	if clientSecretSecretName == "" && clientCertificateSecretName == "" {
+		resp.Diagnostics.AddAttributeError(path.Root("key_vault_uri"), "Missing Key Vault secret name", "When `key_vault_uri` is set, either `client_secret_key_vault_secret_name` or `client_certificate_key_vault_secret_name` must be set as well.")
+		return
+	}
+	if clientSecretSecretName != "" && *clientSecret != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("client_secret_key_vault_secret_name"), "Conflicting client secret configuration", "Only one of `client_secret` and `client_secret_key_vault_secret_name` can be set.")
+	}
+	if clientCertificateSecretName != "" && *clientCertificate != "" {
+		resp.Diagnostics.AddAttributeError(path.Root("client_certificate_key_vault_secret_name"), "Conflicting client certificate configuration", "Only one of `client_certificate` and `client_certificate_key_vault_secret_name` can be set.")
+	}
+	if resp.Diagnostics.HasError() || p.Config.TestMode {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Reading credentials from key vault '%s'", keyVaultUri))
+	credential, err := azidentity.NewDefaultAzureCredential(&azidentity.DefaultAzureCredentialOptions{
+		TenantID: tenantId,
+	})
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("key_vault_uri"), "Error creating Azure credential for Key Vault", err.Error())
+		return
+	}
+
+	if clientSecretSecretName != "" {
+		value, err := api.GetKeyVaultSecret(ctx, credential, keyVaultUri, clientSecretSecretName)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("client_secret_key_vault_secret_name"), "Error reading client secret from Key Vault", err.Error())
+			return
+		}
+		*clientSecret = value
+	}
+	if clientCertificateSecretName != "" {
+		value, err := api.GetKeyVaultSecret(ctx, credential, keyVaultUri, clientCertificateSecretName)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("client_certificate_key_vault_secret_name"), "Error reading client certificate from Key Vault", err.Error())
+			return
+		}
+		*clientCertificate = value
+	}
+}
+
 func configureClientSecret(ctx context.Context, p *PowerPlatformProvider, tenantId, clientId, clientSecret string, resp *provider.ConfigureResponse) {
 	tflog.Info(ctx, "Using client id and secret for authentication")
 	if tenantId != "" && clientId != "" && clientSecret != "" {
@@ -347,20 +659,35 @@ func configureClientSecret(ctx context.Context, p *PowerPlatformProvider, tenant
 func (p *PowerPlatformProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		func() resource.Resource { return environment.NewEnvironmentResource() },
+		func() resource.Resource { return environment_backup.NewEnvironmentBackupResource() },
+		func() resource.Resource { return environment_backup.NewEnvironmentRestoreResource() },
+		func() resource.Resource { return environment_backup.NewEnvironmentCopyResource() },
 		func() resource.Resource { return application.NewEnvironmentApplicationPackageInstallResource() },
 		func() resource.Resource { return dlp_policy.NewDataLossPreventionPolicyResource() },
+		func() resource.Resource { return dlp_policy.NewDataLossPreventionPolicyExemptionResource() },
 		func() resource.Resource { return solution.NewSolutionResource() },
+		func() resource.Resource { return solution.NewSolutionPatchResource() },
+		func() resource.Resource { return solution.NewSolutionCloneResource() },
 		func() resource.Resource { return tenant_settings.NewTenantSettingsResource() },
+		func() resource.Resource { return tenant_settings.NewTenantAiSettingsResource() },
+		func() resource.Resource { return tenant_settings.NewTenantGovernanceSettingsResource() },
+		func() resource.Resource { return tenant_settings.NewTenantLicensingSettingsResource() },
 		func() resource.Resource { return managed_environment.NewManagedEnvironmentResource() },
 		func() resource.Resource { return licensing.NewBillingPolicyEnvironmentResource() },
 		func() resource.Resource { return licensing.NewBillingPolicyResource() },
 		func() resource.Resource { return authorization.NewUserResource() },
+		func() resource.Resource { return authorization.NewApplicationUserResource() },
+		func() resource.Resource { return authorization.NewSecurityRoleResource() },
+		func() resource.Resource { return authorization.NewTeamResource() },
+		func() resource.Resource { return authorization.NewTeamMembershipResource() },
+		func() resource.Resource { return authorization.NewAppModuleRoleAssignmentResource() },
 		func() resource.Resource { return data_record.NewDataRecordResource() },
 		func() resource.Resource { return environment_settings.NewEnvironmentSettingsResource() },
 		func() resource.Resource { return connection.NewConnectionResource() },
 		func() resource.Resource { return rest.NewDataverseWebApiResource() },
 		func() resource.Resource { return environment_wave.NewEnvironmentWaveResource() },
 		func() resource.Resource { return connection.NewConnectionShareResource() },
+		func() resource.Resource { return connectors.NewConnectorResource() },
 		func() resource.Resource { return environment_groups.NewEnvironmentGroupResource() },
 		func() resource.Resource { return admin_management_application.NewAdminManagementApplicationResource() },
 		func() resource.Resource { return environment_group_rule_set.NewEnvironmentGroupRuleSetResource() },
@@ -369,6 +696,18 @@ func (p *PowerPlatformProvider) Resources(ctx context.Context) []func() resource
 			return copilot_studio_application_insights.NewCopilotStudioApplicationInsightsResource()
 		},
 		func() resource.Resource { return tenant_isolation_policy.NewTenantIsolationPolicyResource() },
+		func() resource.Resource { return data_record.NewDataRecordShareResource() },
+		func() resource.Resource { return data_record.NewDataRecordsBatchResource() },
+		func() resource.Resource { return data_record.NewDataRecordRollupCalculationResource() },
+		func() resource.Resource { return powerpages.NewWebsiteResource() },
+		func() resource.Resource { return powerpages.NewWebsiteVisibilityResource() },
+		func() resource.Resource { return powerpages.NewWebsiteWafResource() },
+		func() resource.Resource { return dataverse_managed_identity.NewManagedIdentityResource() },
+		func() resource.Resource { return synapse_link.NewSynapseLinkResource() },
+		func() resource.Resource { return dataverse_workflow.NewWorkflowStateResource() },
+		func() resource.Resource { return powerautomate.NewFlowStateResource() },
+		func() resource.Resource { return powerapps.NewEnvironmentPowerAppsCoOwnerResource() },
+		func() resource.Resource { return dataverse_action.NewDataverseActionResource() },
 	}
 }
 
@@ -378,6 +717,7 @@ func (p *PowerPlatformProvider) DataSources(ctx context.Context) []func() dataso
 		func() datasource.DataSource { return connectors.NewConnectorsDataSource() },
 		func() datasource.DataSource { return application.NewEnvironmentApplicationPackagesDataSource() },
 		func() datasource.DataSource { return powerapps.NewEnvironmentPowerAppsDataSource() },
+		func() datasource.DataSource { return powerautomate.NewFlowsDataSource() },
 		func() datasource.DataSource { return environment.NewEnvironmentsDataSource() },
 		func() datasource.DataSource { return environment_templates.NewEnvironmentTemplatesDataSource() },
 		func() datasource.DataSource { return solution.NewSolutionsDataSource() },
@@ -390,14 +730,24 @@ func (p *PowerPlatformProvider) DataSources(ctx context.Context) []func() dataso
 		func() datasource.DataSource { return languages.NewLanguagesDataSource() },
 		func() datasource.DataSource { return currencies.NewCurrenciesDataSource() },
 		func() datasource.DataSource { return authorization.NewSecurityRolesDataSource() },
+		func() datasource.DataSource { return authorization.NewTablePermissionsDataSource() },
+		func() datasource.DataSource { return authorization.NewApplicationUserEnvironmentsDataSource() },
+		func() datasource.DataSource { return authorization.NewApplicationUsersDataSource() },
+		func() datasource.DataSource { return import_blocks.NewImportBlocksDataSource() },
+		func() datasource.DataSource { return managed_environment.NewManagedEnvironmentDataSource() },
 		func() datasource.DataSource { return application.NewTenantApplicationPackagesDataSource() },
 		func() datasource.DataSource { return data_record.NewDataRecordDataSource() },
 		func() datasource.DataSource { return rest.NewDataverseWebApiDatasource() },
 		func() datasource.DataSource { return connection.NewConnectionsDataSource() },
 		func() datasource.DataSource { return connection.NewConnectionSharesDataSource() },
 		func() datasource.DataSource { return capacity.NewTenantCapcityDataSource() },
+		func() datasource.DataSource { return capacity.NewEnvironmentCapacityDataSource() },
 		func() datasource.DataSource { return tenant.NewTenantDataSource() },
+		func() datasource.DataSource { return provider_health.NewProviderHealthDataSource() },
 		func() datasource.DataSource { return solution_checker_rules.NewSolutionCheckerRulesDataSource() },
+		func() datasource.DataSource { return powerpages.NewWebsitesDataSource() },
+		func() datasource.DataSource { return powerpages.NewWebsiteStatusDataSource() },
+		func() datasource.DataSource { return dataverse_workflow.NewWorkflowRunsDataSource() },
 	}
 }
 
@@ -434,6 +784,8 @@ func getCloudPublicUrls() (*config.ProviderConfigUrls, *cloud.Configuration) {
 		BapiUrl:               constants.PUBLIC_BAPI_DOMAIN,
 		PowerAppsUrl:          constants.PUBLIC_POWERAPPS_API_DOMAIN,
 		PowerAppsScope:        constants.PUBLIC_POWERAPPS_SCOPE,
+		PowerAutomateUrl:      constants.PUBLIC_POWERAUTOMATE_API_DOMAIN,
+		PowerAutomateScope:    constants.PUBLIC_POWERAUTOMATE_SCOPE,
 		PowerPlatformUrl:      constants.PUBLIC_POWERPLATFORM_API_DOMAIN,
 		PowerPlatformScope:    constants.PUBLIC_POWERPLATFORM_API_SCOPE,
 		LicensingUrl:          constants.PUBLIC_LICENSING_API_DOMAIN,
@@ -449,6 +801,8 @@ func getGccUrls() (*config.ProviderConfigUrls, *cloud.Configuration) {
 		BapiUrl:               constants.USGOV_BAPI_DOMAIN,
 		PowerAppsUrl:          constants.USGOV_POWERAPPS_API_DOMAIN,
 		PowerAppsScope:        constants.USGOV_POWERAPPS_SCOPE,
+		PowerAutomateUrl:      constants.USGOV_POWERAUTOMATE_API_DOMAIN,
+		PowerAutomateScope:    constants.USGOV_POWERAUTOMATE_SCOPE,
 		PowerPlatformUrl:      constants.USGOV_POWERPLATFORM_API_DOMAIN,
 		PowerPlatformScope:    constants.USGOV_POWERPLATFORM_API_SCOPE,
 		LicensingUrl:          constants.USGOV_LICENSING_API_DOMAIN,
@@ -464,6 +818,8 @@ func getGccHighUrls() (*config.ProviderConfigUrls, *cloud.Configuration) {
 		BapiUrl:               constants.USGOVHIGH_BAPI_DOMAIN,
 		PowerAppsUrl:          constants.USGOVHIGH_POWERAPPS_API_DOMAIN,
 		PowerAppsScope:        constants.USGOVHIGH_POWERAPPS_SCOPE,
+		PowerAutomateUrl:      constants.USGOVHIGH_POWERAUTOMATE_API_DOMAIN,
+		PowerAutomateScope:    constants.USGOVHIGH_POWERAUTOMATE_SCOPE,
 		PowerPlatformUrl:      constants.USGOVHIGH_POWERPLATFORM_API_DOMAIN,
 		PowerPlatformScope:    constants.USGOVHIGH_POWERPLATFORM_API_SCOPE,
 		LicensingUrl:          constants.USGOVHIGH_LICENSING_API_DOMAIN,
@@ -479,6 +835,8 @@ func getDodUrls() (*config.ProviderConfigUrls, *cloud.Configuration) {
 		BapiUrl:               constants.USDOD_BAPI_DOMAIN,
 		PowerAppsUrl:          constants.USDOD_POWERAPPS_API_DOMAIN,
 		PowerAppsScope:        constants.USDOD_POWERAPPS_SCOPE,
+		PowerAutomateUrl:      constants.USDOD_POWERAUTOMATE_API_DOMAIN,
+		PowerAutomateScope:    constants.USDOD_POWERAUTOMATE_SCOPE,
 		PowerPlatformUrl:      constants.USDOD_POWERPLATFORM_API_DOMAIN,
 		PowerPlatformScope:    constants.USDOD_POWERPLATFORM_API_SCOPE,
 		LicensingUrl:          constants.USDOD_LICENSING_API_DOMAIN,
@@ -494,6 +852,8 @@ func getChinaUrls() (*config.ProviderConfigUrls, *cloud.Configuration) {
 		BapiUrl:               constants.CHINA_BAPI_DOMAIN,
 		PowerAppsUrl:          constants.CHINA_POWERAPPS_API_DOMAIN,
 		PowerAppsScope:        constants.CHINA_POWERAPPS_SCOPE,
+		PowerAutomateUrl:      constants.CHINA_POWERAUTOMATE_API_DOMAIN,
+		PowerAutomateScope:    constants.CHINA_POWERAUTOMATE_SCOPE,
 		PowerPlatformUrl:      constants.CHINA_POWERPLATFORM_API_DOMAIN,
 		PowerPlatformScope:    constants.CHINA_POWERPLATFORM_API_SCOPE,
 		LicensingUrl:          constants.CHINA_LICENSING_API_DOMAIN,
@@ -509,6 +869,8 @@ func getExUrls() (*config.ProviderConfigUrls, *cloud.Configuration) {
 			BapiUrl:               constants.EX_BAPI_DOMAIN,
 			PowerAppsUrl:          constants.EX_POWERAPPS_API_DOMAIN,
 			PowerAppsScope:        constants.EX_POWERAPPS_SCOPE,
+			PowerAutomateUrl:      constants.EX_POWERAUTOMATE_API_DOMAIN,
+			PowerAutomateScope:    constants.EX_POWERAUTOMATE_SCOPE,
 			PowerPlatformUrl:      constants.EX_POWERPLATFORM_API_DOMAIN,
 			PowerPlatformScope:    constants.EX_POWERPLATFORM_API_SCOPE,
 			LicensingUrl:          constants.EX_LICENSING_API_DOMAIN,
@@ -527,6 +889,8 @@ func getRxUrls() (*config.ProviderConfigUrls, *cloud.Configuration) {
 			BapiUrl:               constants.RX_BAPI_DOMAIN,
 			PowerAppsUrl:          constants.RX_POWERAPPS_API_DOMAIN,
 			PowerAppsScope:        constants.RX_POWERAPPS_SCOPE,
+			PowerAutomateUrl:      constants.RX_POWERAUTOMATE_API_DOMAIN,
+			PowerAutomateScope:    constants.RX_POWERAUTOMATE_SCOPE,
 			PowerPlatformUrl:      constants.RX_POWERPLATFORM_API_DOMAIN,
 			PowerPlatformScope:    constants.RX_POWERPLATFORM_API_SCOPE,
 			LicensingUrl:          constants.RX_LICENSING_API_DOMAIN,
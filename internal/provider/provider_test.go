@@ -10,9 +10,12 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	test "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
 	"github.com/microsoft/terraform-provider-power-platform/internal/provider"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/admin_management_application"
@@ -25,22 +28,31 @@ import (
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/copilot_studio_application_insights"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/currencies"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/data_record"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/dataverse_action"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/dataverse_managed_identity"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/dataverse_workflow"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/dlp_policy"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/enterprise_policy"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment_backup"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment_group_rule_set"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment_groups"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment_settings"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment_templates"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment_wave"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/import_blocks"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/languages"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/licensing"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/locations"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/managed_environment"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/powerapps"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/powerautomate"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/powerpages"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/provider_health"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/rest"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/solution"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/solution_checker_rules"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/synapse_link"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/tenant"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/tenant_isolation_policy"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/tenant_settings"
@@ -51,6 +63,7 @@ func TestUnitPowerPlatformProviderHasChildDataSources_Basic(t *testing.T) {
 	expectedDataSources := []datasource.DataSource{
 		analytics_data_export.NewAnalyticsExportDataSource(),
 		powerapps.NewEnvironmentPowerAppsDataSource(),
+		powerautomate.NewFlowsDataSource(),
 		environment.NewEnvironmentsDataSource(),
 		environment_templates.NewEnvironmentTemplatesDataSource(),
 		application.NewEnvironmentApplicationPackagesDataSource(),
@@ -64,6 +77,11 @@ func TestUnitPowerPlatformProviderHasChildDataSources_Basic(t *testing.T) {
 		languages.NewLanguagesDataSource(),
 		currencies.NewCurrenciesDataSource(),
 		authorization.NewSecurityRolesDataSource(),
+		authorization.NewTablePermissionsDataSource(),
+		authorization.NewApplicationUserEnvironmentsDataSource(),
+		authorization.NewApplicationUsersDataSource(),
+		import_blocks.NewImportBlocksDataSource(),
+		managed_environment.NewManagedEnvironmentDataSource(),
 		environment_settings.NewEnvironmentSettingsDataSource(),
 		application.NewTenantApplicationPackagesDataSource(),
 		connection.NewConnectionsDataSource(),
@@ -71,8 +89,13 @@ func TestUnitPowerPlatformProviderHasChildDataSources_Basic(t *testing.T) {
 		data_record.NewDataRecordDataSource(),
 		rest.NewDataverseWebApiDatasource(),
 		capacity.NewTenantCapcityDataSource(),
+		capacity.NewEnvironmentCapacityDataSource(),
 		tenant.NewTenantDataSource(),
+		provider_health.NewProviderHealthDataSource(),
 		solution_checker_rules.NewSolutionCheckerRulesDataSource(),
+		powerpages.NewWebsitesDataSource(),
+		powerpages.NewWebsiteStatusDataSource(),
+		dataverse_workflow.NewWorkflowRunsDataSource(),
 	}
 	datasources := provider.NewPowerPlatformProvider(context.Background())().(*provider.PowerPlatformProvider).DataSources(context.Background())
 
@@ -85,26 +108,53 @@ func TestUnitPowerPlatformProviderHasChildDataSources_Basic(t *testing.T) {
 func TestUnitPowerPlatformProviderHasChildResources_Basic(t *testing.T) {
 	expectedResources := []resource.Resource{
 		environment.NewEnvironmentResource(),
+		environment_backup.NewEnvironmentBackupResource(),
+		environment_backup.NewEnvironmentRestoreResource(),
+		environment_backup.NewEnvironmentCopyResource(),
 		environment_groups.NewEnvironmentGroupResource(),
 		application.NewEnvironmentApplicationPackageInstallResource(),
 		dlp_policy.NewDataLossPreventionPolicyResource(),
+		dlp_policy.NewDataLossPreventionPolicyExemptionResource(),
 		solution.NewSolutionResource(),
+		solution.NewSolutionPatchResource(),
+		solution.NewSolutionCloneResource(),
 		tenant_settings.NewTenantSettingsResource(),
+		tenant_settings.NewTenantAiSettingsResource(),
+		tenant_settings.NewTenantGovernanceSettingsResource(),
+		tenant_settings.NewTenantLicensingSettingsResource(),
 		managed_environment.NewManagedEnvironmentResource(),
 		licensing.NewBillingPolicyResource(),
 		licensing.NewBillingPolicyEnvironmentResource(),
 		authorization.NewUserResource(),
+		authorization.NewApplicationUserResource(),
+		authorization.NewSecurityRoleResource(),
+		authorization.NewTeamResource(),
+		authorization.NewTeamMembershipResource(),
+		authorization.NewAppModuleRoleAssignmentResource(),
 		environment_settings.NewEnvironmentSettingsResource(),
 		data_record.NewDataRecordResource(),
 		rest.NewDataverseWebApiResource(),
 		connection.NewConnectionResource(),
 		connection.NewConnectionShareResource(),
+		connectors.NewConnectorResource(),
 		admin_management_application.NewAdminManagementApplicationResource(),
 		environment_group_rule_set.NewEnvironmentGroupRuleSetResource(),
 		enterprise_policy.NewEnterpisePolicyResource(),
 		copilot_studio_application_insights.NewCopilotStudioApplicationInsightsResource(),
 		tenant_isolation_policy.NewTenantIsolationPolicyResource(),
 		environment_wave.NewEnvironmentWaveResource(),
+		data_record.NewDataRecordShareResource(),
+		data_record.NewDataRecordsBatchResource(),
+		data_record.NewDataRecordRollupCalculationResource(),
+		powerpages.NewWebsiteResource(),
+		powerpages.NewWebsiteVisibilityResource(),
+		powerpages.NewWebsiteWafResource(),
+		dataverse_managed_identity.NewManagedIdentityResource(),
+		synapse_link.NewSynapseLinkResource(),
+		dataverse_workflow.NewWorkflowStateResource(),
+		powerautomate.NewFlowStateResource(),
+		powerapps.NewEnvironmentPowerAppsCoOwnerResource(),
+		dataverse_action.NewDataverseActionResource(),
 	}
 	resources := provider.NewPowerPlatformProvider(context.Background())().(*provider.PowerPlatformProvider).Resources(context.Background())
 
@@ -114,6 +164,73 @@ func TestUnitPowerPlatformProviderHasChildResources_Basic(t *testing.T) {
 	}
 }
 
+func TestUnitPowerPlatformProviderSensitiveAttributes_Are_Marked(t *testing.T) {
+	ctx := context.Background()
+	p := provider.NewPowerPlatformProvider(ctx)().(*provider.PowerPlatformProvider)
+
+	for _, r := range p.Resources(ctx) {
+		metadataResponse := resource.MetadataResponse{}
+		r().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "powerplatform"}, &metadataResponse)
+		schemaResponse := resource.SchemaResponse{}
+		r().Schema(ctx, resource.SchemaRequest{}, &schemaResponse)
+		for name, attribute := range schemaResponse.Schema.Attributes {
+			requireSensitiveResourceAttributes(t, metadataResponse.TypeName+"."+name, name, attribute)
+		}
+	}
+
+	for _, d := range p.DataSources(ctx) {
+		metadataResponse := datasource.MetadataResponse{}
+		d().Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "powerplatform"}, &metadataResponse)
+		schemaResponse := datasource.SchemaResponse{}
+		d().Schema(ctx, datasource.SchemaRequest{}, &schemaResponse)
+		for name, attribute := range schemaResponse.Schema.Attributes {
+			requireSensitiveDataSourceAttributes(t, metadataResponse.TypeName+"."+name, name, attribute)
+		}
+	}
+}
+
+func requireSensitiveResourceAttributes(t *testing.T, attributePath, name string, attribute rschema.Attribute) {
+	if helpers.IsSensitiveName(name) && !attribute.IsSensitive() {
+		t.Errorf("The attribute %s holds a secret and must be marked as Sensitive", attributePath)
+	}
+
+	nested := map[string]rschema.Attribute{}
+	switch attribute := attribute.(type) {
+	case rschema.SingleNestedAttribute:
+		nested = attribute.Attributes
+	case rschema.ListNestedAttribute:
+		nested = attribute.NestedObject.Attributes
+	case rschema.SetNestedAttribute:
+		nested = attribute.NestedObject.Attributes
+	case rschema.MapNestedAttribute:
+		nested = attribute.NestedObject.Attributes
+	}
+	for nestedName, nestedAttribute := range nested {
+		requireSensitiveResourceAttributes(t, attributePath+"."+nestedName, nestedName, nestedAttribute)
+	}
+}
+
+func requireSensitiveDataSourceAttributes(t *testing.T, attributePath, name string, attribute dschema.Attribute) {
+	if helpers.IsSensitiveName(name) && !attribute.IsSensitive() {
+		t.Errorf("The attribute %s holds a secret and must be marked as Sensitive", attributePath)
+	}
+
+	nested := map[string]dschema.Attribute{}
+	switch attribute := attribute.(type) {
+	case dschema.SingleNestedAttribute:
+		nested = attribute.Attributes
+	case dschema.ListNestedAttribute:
+		nested = attribute.NestedObject.Attributes
+	case dschema.SetNestedAttribute:
+		nested = attribute.NestedObject.Attributes
+	case dschema.MapNestedAttribute:
+		nested = attribute.NestedObject.Attributes
+	}
+	for nestedName, nestedAttribute := range nested {
+		requireSensitiveDataSourceAttributes(t, attributePath+"."+nestedName, nestedName, nestedAttribute)
+	}
+}
+
 func TestUnitPowerPlatformProvider_Validate_Telementry_Optout_Is_False(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -175,3 +292,370 @@ func TestUnitPowerPlatformProvider_Validate_Telementry_Optout_Is_True(t *testing
 		},
 	})
 }
+
+func TestUnitPowerPlatformProvider_Validate_Dataverse_Api_Version(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("../services/authorization/tests/datasource/security_roles/Validate_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.1/roles",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("../services/authorization/tests/datasource/security_roles/Validate_Read/get_security_roles.json").String()), nil
+		})
+
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli = true
+					dataverse_api_version = "v9.1"
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				Check: test.ComposeAggregateTestCheckFunc(
+					test.TestCheckResourceAttr("data.powerplatform_security_roles.all", "security_roles.#", "72"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Dataverse_Api_Version_Invalid(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli = true
+					dataverse_api_version = "9.2"
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Dataverse API version"),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Cloud_Gcc(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://gov.api.bap.microsoft.us/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("../services/authorization/tests/datasource/security_roles/Validate_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("../services/authorization/tests/datasource/security_roles/Validate_Read/get_security_roles.json").String()), nil
+		})
+
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli = true
+					cloud   = "gcc"
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				Check: test.ComposeAggregateTestCheckFunc(
+					test.TestCheckResourceAttr("data.powerplatform_security_roles.all", "security_roles.#", "72"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Cloud_Invalid(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli = true
+					cloud   = "usgovernment"
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Unknown cloud"),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Custom_Headers(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Ocp-Apim-Subscription-Key") != "00000000000000000000000000000001" {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, ""), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("../services/authorization/tests/datasource/security_roles/Validate_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles",
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Ocp-Apim-Subscription-Key") != "00000000000000000000000000000001" {
+				return httpmock.NewStringResponse(http.StatusUnauthorized, ""), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("../services/authorization/tests/datasource/security_roles/Validate_Read/get_security_roles.json").String()), nil
+		})
+
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli = true
+					custom_headers = {
+						"Ocp-Apim-Subscription-Key" = "00000000000000000000000000000001"
+					}
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				Check: test.ComposeAggregateTestCheckFunc(
+					test.TestCheckResourceAttr("data.powerplatform_security_roles.all", "security_roles.#", "72"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Custom_Headers_Invalid(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli = true
+					custom_headers = {
+						"Subscription Key" = "00000000000000000000000000000001"
+					}
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid custom header"),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Role_Profiles_Invalid(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli = true
+					role_profiles = {
+						integration = ["System Administrator"]
+					}
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid role profile"),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Bapi_Failover_Url_Invalid(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli           = true
+					bapi_failover_url = "https://api.bap.microsoft.com/"
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid BAPI failover url"),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Slow_Request_Threshold_Invalid(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli                = true
+					slow_request_threshold = "10"
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid slow request threshold"),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Operation_Progress_Interval_Invalid(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli                     = true
+					operation_progress_interval = "-1m"
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid operation progress interval"),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Max_Retries_Invalid(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli     = true
+					max_retries = 0
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid max retries"),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Max_Retry_Elapsed_Time_Invalid(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli                = true
+					max_retry_elapsed_time = "five minutes"
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid max retry elapsed time"),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Max_Requests_Per_Second_Invalid(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					use_cli                 = true
+					max_requests_per_second = 0
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid request rate limit"),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Key_Vault_Uri_Missing(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					tenant_id                           = "00000000-0000-0000-0000-000000000001"
+					client_id                           = "00000000-0000-0000-0000-000000000002"
+					client_secret_key_vault_secret_name = "power-platform-client-secret"
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Unknown key vault uri"),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Key_Vault_Uri_Invalid(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					tenant_id                           = "00000000-0000-0000-0000-000000000001"
+					client_id                           = "00000000-0000-0000-0000-000000000002"
+					key_vault_uri                       = "http://contoso.vault.azure.net/"
+					client_secret_key_vault_secret_name = "power-platform-client-secret"
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Invalid Key Vault URI"),
+			},
+		},
+	})
+}
+
+func TestUnitPowerPlatformProvider_Validate_Key_Vault_Conflicting_Client_Secret(t *testing.T) {
+	test.Test(t, test.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []test.TestStep{
+			{
+				Config: `provider "powerplatform" {
+					tenant_id                           = "00000000-0000-0000-0000-000000000001"
+					client_id                           = "00000000-0000-0000-0000-000000000002"
+					client_secret                       = "secret"
+					key_vault_uri                       = "https://contoso.vault.azure.net/"
+					client_secret_key_vault_secret_name = "power-platform-client-secret"
+				}
+				data "powerplatform_security_roles" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile("Conflicting client secret configuration"),
+			},
+		},
+	})
+}
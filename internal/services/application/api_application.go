@@ -60,7 +60,7 @@ func (client *client) GetTenantApplications(ctx context.Context) ([]tenantApplic
 		Path:   "/appmanagement/applicationPackages",
 	}
 	values := url.Values{
-		"api-version": []string{"2022-03-01-preview"},
+		"api-version": []string{client.Api.GetApiVersion(ctx, api.API_SERVICE_APPLICATION)},
 	}
 	apiUrl.RawQuery = values.Encode()
 
@@ -81,7 +81,7 @@ func (client *client) GetApplicationsByEnvironmentId(ctx context.Context, enviro
 		Path:   fmt.Sprintf("/appmanagement/environments/%s/applicationPackages", environmentId),
 	}
 	values := url.Values{
-		"api-version": []string{"2022-03-01-preview"},
+		"api-version": []string{client.Api.GetApiVersion(ctx, api.API_SERVICE_APPLICATION)},
 	}
 	apiUrl.RawQuery = values.Encode()
 
@@ -102,7 +102,7 @@ func (client *client) InstallApplicationInEnvironment(ctx context.Context, envir
 		Path:   fmt.Sprintf("/appmanagement/environments/%s/applicationPackages/%s/install", environmentId, uniqueName),
 	}
 	values := url.Values{
-		"api-version": []string{"2022-03-01-preview"},
+		"api-version": []string{client.Api.GetApiVersion(ctx, api.API_SERVICE_APPLICATION)},
 	}
 	apiUrl.RawQuery = values.Encode()
 
@@ -121,6 +121,7 @@ func (client *client) InstallApplicationInEnvironment(ctx context.Context, envir
 			tflog.Error(ctx, "Error parsing location header: "+err.Error())
 		}
 
+		progress := client.Api.NewOperationProgress(fmt.Sprintf("Installation of application '%s'", uniqueName))
 		for {
 			lifecycleResponse := environmentApplicationLifecycleDto{}
 			response, err := client.Api.Execute(ctx, nil, "GET", operationLocationHeader, nil, nil, []int{http.StatusOK, http.StatusConflict}, &lifecycleResponse)
@@ -133,6 +134,8 @@ func (client *client) InstallApplicationInEnvironment(ctx context.Context, envir
 				continue
 			}
 
+			progress.Report(ctx, lifecycleResponse.Status, -1)
+
 			if lifecycleResponse.Status == "Succeeded" {
 				parts := strings.Split(lifecycleResponse.CreatedDateTime, "/")
 				if len(parts) == 0 {
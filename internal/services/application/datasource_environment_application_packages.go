@@ -163,6 +163,7 @@ func (d *EnvironmentApplicationPackagesDataSource) Configure(ctx context.Context
 		return
 	}
 	d.ApplicationClient = newApplicationClient(client.Api)
+	client.Api.AddPreviewApiVersionWarning(&resp.Diagnostics, api.API_SERVICE_APPLICATION)
 }
 
 func (d *EnvironmentApplicationPackagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
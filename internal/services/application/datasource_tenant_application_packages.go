@@ -164,6 +164,7 @@ func (d *TenantApplicationPackagesDataSource) Configure(ctx context.Context, req
 		return
 	}
 	d.ApplicationClient = newApplicationClient(client.Api)
+	client.Api.AddPreviewApiVersionWarning(&resp.Diagnostics, api.API_SERVICE_APPLICATION)
 }
 
 func (d *TenantApplicationPackagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -91,6 +91,7 @@ func (r *EnvironmentApplicationPackageInstallResource) Configure(ctx context.Con
 		return
 	}
 	r.ApplicationClient = newApplicationClient(client.Api)
+	client.Api.AddPreviewApiVersionWarning(&resp.Diagnostics, api.API_SERVICE_APPLICATION)
 }
 
 func (r *EnvironmentApplicationPackageInstallResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -119,6 +120,13 @@ func (r *EnvironmentApplicationPackageInstallResource) Create(ctx context.Contex
 		return
 	}
 
+	unlock, err := r.ApplicationClient.Api.LockEnvironment(ctx, state.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+	defer unlock()
+
 	applicationId, err := r.ApplicationClient.InstallApplicationInEnvironment(ctx, state.EnvironmentId.ValueString(), state.UniqueName.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
@@ -0,0 +1,86 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+)
+
+// GetAppModuleRoleIds returns the ids of the security roles associated with a model-driven app module.
+func (client *client) GetAppModuleRoleIds(ctx context.Context, environmentId, appModuleId string) ([]string, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "roleid")
+
+	roles := securityRoleArrayDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, fmt.Sprintf("appmodules(%s)/appmoduleroles_association", appModuleId), values), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &roles)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("app module '%s' not found", appModuleId))
+	}
+
+	roleIds := make([]string, 0, len(roles.Value))
+	for _, role := range roles.Value {
+		roleIds = append(roleIds, role.RoleId)
+	}
+	return roleIds, nil
+}
+
+// AddAppModuleRoles associates security roles with a model-driven app module, so that the users having them can see the app.
+func (client *client) AddAppModuleRoles(ctx context.Context, environmentId, appModuleId string, securityRoleIds []string) error {
+	if len(securityRoleIds) == 0 {
+		return nil
+	}
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	for _, roleId := range securityRoleIds {
+		roleToAssociate := map[string]any{
+			"@odata.id": fmt.Sprintf("https://%s/api/data/%s/roles(%s)", environmentHost, client.Api.GetConfig().GetDataverseApiVersion(), roleId),
+		}
+		_, err := client.Api.Execute(ctx, nil, "POST", client.buildDataverseUrl(environmentHost, fmt.Sprintf("appmodules(%s)/appmoduleroles_association/$ref", appModuleId), nil), nil, roleToAssociate, []int{http.StatusNoContent}, nil)
+		if err != nil {
+			if customerrors.ApiErrorCode(err) == "0x80060888" && strings.Contains(err.Error(), roleId) {
+				return fmt.Errorf("role with id '%s' is not valid", roleId)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+// RemoveAppModuleRoles removes the association of security roles with a model-driven app module.
+func (client *client) RemoveAppModuleRoles(ctx context.Context, environmentId, appModuleId string, securityRoleIds []string) error {
+	if len(securityRoleIds) == 0 {
+		return nil
+	}
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	for _, roleId := range securityRoleIds {
+		values := url.Values{}
+		values.Add("$id", fmt.Sprintf("https://%s/api/data/%s/roles(%s)", environmentHost, client.Api.GetConfig().GetDataverseApiVersion(), roleId))
+		_, err := client.Api.Execute(ctx, nil, "DELETE", client.buildDataverseUrl(environmentHost, fmt.Sprintf("appmodules(%s)/appmoduleroles_association/$ref", appModuleId), values), nil, nil, []int{http.StatusNoContent, http.StatusNotFound}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+)
+
+func (client *client) buildDataverseUrl(environmentHost, path string, values url.Values) string {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/%s", client.Api.GetConfig().GetDataverseApiVersion(), path),
+	}
+	if values != nil {
+		apiUrl.RawQuery = values.Encode()
+	}
+	return apiUrl.String()
+}
+
+// GetRootBusinessUnitId returns the id of the business unit of the environment that has no parent business unit.
+func (client *client) GetRootBusinessUnitId(ctx context.Context, environmentId string) (string, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return "", err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "businessunitid")
+	values.Add("$filter", "_parentbusinessunitid_value eq null")
+
+	businessUnits := businessUnitArrayDto{}
+	_, err = client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, "businessunits", values), nil, nil, []int{http.StatusOK}, &businessUnits)
+	if err != nil {
+		return "", err
+	}
+	if len(businessUnits.Value) == 0 {
+		return "", errors.New("root business unit not found")
+	}
+	return businessUnits.Value[0].Id, nil
+}
+
+func (client *client) GetSecurityRole(ctx context.Context, environmentId, roleId string) (*securityRoleDto, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "roleid,name,description,ismanaged,_businessunitid_value")
+
+	role := securityRoleDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, fmt.Sprintf("roles(%s)", roleId), values), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &role)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("security role '%s' not found", roleId))
+	}
+	return &role, nil
+}
+
+// CreateSecurityRole creates a custom security role without privileges in the given business unit and returns its id.
+func (client *client) CreateSecurityRole(ctx context.Context, environmentId string, role securityRoleCreateDto) (string, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := client.Api.Execute(ctx, nil, "POST", client.buildDataverseUrl(environmentHost, "roles", nil), nil, role, []int{http.StatusNoContent}, nil)
+	if err != nil {
+		return "", err
+	}
+
+	// the environment host can be a guid as well, so the role id is the last guid of the entity url.
+	matches := regexp.MustCompile("[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}").FindAllString(resp.HttpResponse.Header.Get(constants.HEADER_ODATA_ENTITY_ID), -1)
+	if len(matches) == 0 {
+		return "", errors.New("no security role id returned from the odata-entityid header")
+	}
+	return matches[len(matches)-1], nil
+}
+
+func (client *client) UpdateSecurityRole(ctx context.Context, environmentId, roleId string, role securityRoleUpdateDto) (*securityRoleDto, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.Api.Execute(ctx, nil, "PATCH", client.buildDataverseUrl(environmentHost, fmt.Sprintf("roles(%s)", roleId), nil), nil, role, []int{http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetSecurityRole(ctx, environmentId, roleId)
+}
+
+func (client *client) DeleteSecurityRole(ctx context.Context, environmentId, roleId string) error {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Api.Execute(ctx, nil, "DELETE", client.buildDataverseUrl(environmentHost, fmt.Sprintf("roles(%s)", roleId), nil), nil, nil, []int{http.StatusNoContent, http.StatusNotFound}, nil)
+	return err
+}
+
+// GetSecurityRolePrivileges returns the privileges granted by a security role along with their depth.
+func (client *client) GetSecurityRolePrivileges(ctx context.Context, environmentId, roleId string) ([]rolePrivilegeDto, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	rolePrivileges := rolePrivilegeArrayDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, fmt.Sprintf("roles(%s)/Microsoft.Dynamics.CRM.RetrieveRolePrivilegesRole()", roleId), nil), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &rolePrivileges)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("security role '%s' not found", roleId))
+	}
+	return rolePrivileges.RolePrivileges, nil
+}
+
+// ReplaceSecurityRolePrivileges replaces all the privileges of a security role with the given ones.
+// Privileges are given by name and resolved to their id, failing when a name is not a privilege of the environment.
+func (client *client) ReplaceSecurityRolePrivileges(ctx context.Context, environmentId, roleId, businessUnitId string, privilegeDepths map[string]string) error {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	privilegeIds, err := client.getPrivilegeIdsByName(ctx, environmentHost, privilegeDepths)
+	if err != nil {
+		return err
+	}
+
+	privileges := make([]rolePrivilegeDto, 0, len(privilegeIds))
+	for name, depth := range privilegeDepths {
+		privileges = append(privileges, rolePrivilegeDto{
+			Depth:          depth,
+			PrivilegeId:    privilegeIds[strings.ToLower(name)],
+			BusinessUnitId: businessUnitId,
+		})
+	}
+	slices.SortFunc(privileges, func(a, b rolePrivilegeDto) int {
+		return strings.Compare(a.PrivilegeId, b.PrivilegeId)
+	})
+
+	body := map[string]any{
+		"Privileges": privileges,
+	}
+	_, err = client.Api.Execute(ctx, nil, "POST", client.buildDataverseUrl(environmentHost, fmt.Sprintf("roles(%s)/Microsoft.Dynamics.CRM.ReplacePrivilegesRole", roleId), nil), nil, body, []int{http.StatusNoContent}, nil)
+	return err
+}
+
+// getPrivilegeIdsByName maps the lower case privilege names to their id.
+func (client *client) getPrivilegeIdsByName(ctx context.Context, environmentHost string, privilegeDepths map[string]string) (map[string]string, error) {
+	privilegeIds := map[string]string{}
+	if len(privilegeDepths) == 0 {
+		return privilegeIds, nil
+	}
+
+	names := make([]string, 0, len(privilegeDepths))
+	for name := range privilegeDepths {
+		names = append(names, fmt.Sprintf("'%s'", strings.ReplaceAll(name, "'", "''")))
+	}
+	slices.Sort(names)
+
+	values := url.Values{}
+	values.Add("$select", "privilegeid,name")
+	values.Add("$filter", fmt.Sprintf("Microsoft.Dynamics.CRM.In(PropertyName='name',PropertyValues=[%s])", strings.Join(names, ",")))
+
+	privileges := privilegeArrayDto{}
+	_, err := client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, "privileges", values), nil, nil, []int{http.StatusOK}, &privileges)
+	if err != nil {
+		return nil, err
+	}
+	for _, privilege := range privileges.Value {
+		privilegeIds[strings.ToLower(privilege.Name)] = privilege.Id
+	}
+
+	missing := []string{}
+	for name := range privilegeDepths {
+		if _, ok := privilegeIds[strings.ToLower(name)]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		return nil, fmt.Errorf("privileges not found: %s", strings.Join(missing, ", "))
+	}
+	return privilegeIds, nil
+}
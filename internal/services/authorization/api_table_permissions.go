@@ -0,0 +1,91 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+)
+
+// GetTablePrivileges returns the privileges that control the access to a Dataverse table, such as prvReadAccount for the account table.
+func (client *client) GetTablePrivileges(ctx context.Context, environmentId, tableLogicalName string) ([]tablePrivilegeDto, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "LogicalName,Privileges")
+
+	table := tableDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, fmt.Sprintf("EntityDefinitions(LogicalName='%s')", tableLogicalName), values), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &table)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("table '%s' not found", tableLogicalName))
+	}
+	return table.Privileges, nil
+}
+
+// GetRolePrivilegeGrants returns which security roles grant the given privileges, with their depth.
+func (client *client) GetRolePrivilegeGrants(ctx context.Context, environmentId string, privilegeIds []string) ([]rolePrivilegeGrantDto, error) {
+	if len(privilegeIds) == 0 {
+		return []rolePrivilegeGrantDto{}, nil
+	}
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "roleid,privilegeid,privilegedepthmask")
+	values.Add("$filter", fmt.Sprintf("Microsoft.Dynamics.CRM.In(PropertyName='privilegeid',PropertyValues=[%s])", quotedIds(privilegeIds)))
+
+	grants := rolePrivilegeGrantArrayDto{}
+	_, err = client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, "roleprivilegescollection", values), nil, nil, []int{http.StatusOK}, &grants)
+	if err != nil {
+		return nil, err
+	}
+	return grants.Value, nil
+}
+
+// GetSecurityRoleAssignees returns the security roles with the ids given, along with the users and the teams they are assigned to.
+func (client *client) GetSecurityRoleAssignees(ctx context.Context, environmentId string, roleIds []string) ([]securityRoleAssigneesDto, error) {
+	if len(roleIds) == 0 {
+		return []securityRoleAssigneesDto{}, nil
+	}
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "roleid,name,_businessunitid_value")
+	values.Add("$filter", fmt.Sprintf("Microsoft.Dynamics.CRM.In(PropertyName='roleid',PropertyValues=[%s])", quotedIds(roleIds)))
+	values.Add("$expand", "systemuserroles_association($select=systemuserid,fullname),teamroles_association($select=teamid,name)")
+
+	roles := securityRoleAssigneesArrayDto{}
+	_, err = client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, "roles", values), nil, nil, []int{http.StatusOK}, &roles)
+	if err != nil {
+		return nil, err
+	}
+	return roles.Value, nil
+}
+
+// quotedIds returns the ids as a sorted list of OData string literals, so that requests are the same for the same ids.
+func quotedIds(ids []string) string {
+	quoted := make([]string, 0, len(ids))
+	for _, id := range ids {
+		quoted = append(quoted, fmt.Sprintf("'%s'", id))
+	}
+	slices.Sort(quoted)
+	return strings.Join(slices.Compact(quoted), ",")
+}
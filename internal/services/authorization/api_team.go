@@ -0,0 +1,178 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+)
+
+func (client *client) GetTeam(ctx context.Context, environmentId, teamId string) (*teamDto, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "teamid,name,description,teamtype,membershiptype,azureactivedirectoryobjectid,_businessunitid_value")
+	values.Add("$expand", "teamroles_association($select=roleid,name,ismanaged,_businessunitid_value)")
+
+	team := teamDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, fmt.Sprintf("teams(%s)", teamId), values), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &team)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("team '%s' not found", teamId))
+	}
+	return &team, nil
+}
+
+// CreateTeam creates a Dataverse team and returns it, without security roles.
+func (client *client) CreateTeam(ctx context.Context, environmentId string, team teamCreateDto) (*teamDto, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Api.Execute(ctx, nil, "POST", client.buildDataverseUrl(environmentHost, "teams", nil), nil, team, []int{http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the environment host can be a guid as well, so the team id is the last guid of the entity url.
+	matches := regexp.MustCompile("[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}").FindAllString(resp.HttpResponse.Header.Get(constants.HEADER_ODATA_ENTITY_ID), -1)
+	if len(matches) == 0 {
+		return nil, errors.New("no team id returned from the odata-entityid header")
+	}
+	return client.GetTeam(ctx, environmentId, matches[len(matches)-1])
+}
+
+func (client *client) UpdateTeam(ctx context.Context, environmentId, teamId string, team teamUpdateDto) (*teamDto, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.Api.Execute(ctx, nil, "PATCH", client.buildDataverseUrl(environmentHost, fmt.Sprintf("teams(%s)", teamId), nil), nil, team, []int{http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetTeam(ctx, environmentId, teamId)
+}
+
+func (client *client) DeleteTeam(ctx context.Context, environmentId, teamId string) error {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Api.Execute(ctx, nil, "DELETE", client.buildDataverseUrl(environmentHost, fmt.Sprintf("teams(%s)", teamId), nil), nil, nil, []int{http.StatusNoContent, http.StatusNotFound}, nil)
+	return err
+}
+
+func (client *client) AddTeamSecurityRoles(ctx context.Context, environmentId, teamId string, securityRoleIds []string) error {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	for _, roleId := range securityRoleIds {
+		roleToAssociate := map[string]any{
+			"@odata.id": fmt.Sprintf("https://%s/api/data/%s/roles(%s)", environmentHost, client.Api.GetConfig().GetDataverseApiVersion(), roleId),
+		}
+		_, err := client.Api.Execute(ctx, nil, "POST", client.buildDataverseUrl(environmentHost, fmt.Sprintf("teams(%s)/teamroles_association/$ref", teamId), nil), nil, roleToAssociate, []int{http.StatusNoContent}, nil)
+		if err != nil {
+			if customerrors.ApiErrorCode(err) == "0x80060888" && strings.Contains(err.Error(), roleId) {
+				return fmt.Errorf("role with id '%s' is not valid", roleId)
+			}
+			return err
+		}
+	}
+	return nil
+}
+
+func (client *client) RemoveTeamSecurityRoles(ctx context.Context, environmentId, teamId string, securityRoleIds []string) error {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	for _, roleId := range securityRoleIds {
+		values := url.Values{}
+		values.Add("$id", fmt.Sprintf("https://%s/api/data/%s/roles(%s)", environmentHost, client.Api.GetConfig().GetDataverseApiVersion(), roleId))
+		_, err := client.Api.Execute(ctx, nil, "DELETE", client.buildDataverseUrl(environmentHost, fmt.Sprintf("teams(%s)/teamroles_association/$ref", teamId), values), nil, nil, []int{http.StatusNoContent, http.StatusNotFound}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetTeamMemberIds returns the ids of the systemusers that are members of a team.
+func (client *client) GetTeamMemberIds(ctx context.Context, environmentId, teamId string) ([]string, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "systemuserid")
+
+	members := userArrayDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, fmt.Sprintf("teams(%s)/teammembership_association", teamId), values), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &members)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("team '%s' not found", teamId))
+	}
+
+	memberIds := make([]string, 0, len(members.Value))
+	for _, member := range members.Value {
+		memberIds = append(memberIds, member.Id)
+	}
+	return memberIds, nil
+}
+
+// AddTeamMembers adds systemusers to an owner or access team.
+func (client *client) AddTeamMembers(ctx context.Context, environmentId, teamId string, systemUserIds []string) error {
+	return client.changeTeamMembers(ctx, environmentId, teamId, "AddMembersTeam", systemUserIds)
+}
+
+// RemoveTeamMembers removes systemusers from an owner or access team.
+func (client *client) RemoveTeamMembers(ctx context.Context, environmentId, teamId string, systemUserIds []string) error {
+	return client.changeTeamMembers(ctx, environmentId, teamId, "RemoveMembersTeam", systemUserIds)
+}
+
+func (client *client) changeTeamMembers(ctx context.Context, environmentId, teamId, action string, systemUserIds []string) error {
+	if len(systemUserIds) == 0 {
+		return nil
+	}
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	members := make([]map[string]string, 0, len(systemUserIds))
+	for _, systemUserId := range systemUserIds {
+		members = append(members, map[string]string{
+			"@odata.type":  "Microsoft.Dynamics.CRM.systemuser",
+			"systemuserid": systemUserId,
+		})
+	}
+	body := map[string]any{
+		"Members": members,
+	}
+	_, err = client.Api.Execute(ctx, nil, "POST", client.buildDataverseUrl(environmentHost, fmt.Sprintf("teams(%s)/Microsoft.Dynamics.CRM.%s", teamId, action), nil), nil, body, []int{http.StatusNoContent}, nil)
+	return err
+}
@@ -7,15 +7,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/microsoft/terraform-provider-power-platform/internal/api"
 	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
 	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers/array"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment"
 )
@@ -56,23 +59,59 @@ func (client *client) GetDataverseUsers(ctx context.Context, environmentId strin
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.2/systemusers",
+		Path:   fmt.Sprintf("/api/data/%s/systemusers", client.Api.GetConfig().GetDataverseApiVersion()),
 	}
 	userArray := userArrayDto{}
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &userArray)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &userArray)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
+	return userArray.Value, nil
+}
+
+func (client *client) GetDataverseUserBySystemUserId(ctx context.Context, environmentId, systemUserId string) (*userDto, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/systemusers(%s)", client.Api.GetConfig().GetDataverseApiVersion(), systemUserId),
+	}
+	values := url.Values{}
+	values.Add("$expand", "systemuserroles_association($select=roleid,name,ismanaged,_businessunitid_value)")
+	apiUrl.RawQuery = values.Encode()
+
+	user := userDto{}
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &user)
+	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
+	return &user, nil
+}
+
+func (client *client) GetApplicationUsersByApplicationId(ctx context.Context, environmentHost, applicationId string) ([]userDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/systemusers", client.Api.GetConfig().GetDataverseApiVersion()),
+	}
+	values := url.Values{}
+	values.Add("$filter", fmt.Sprintf("applicationid eq %s", applicationId))
+	values.Add("$expand", "systemuserroles_association($select=roleid,name,ismanaged,_businessunitid_value)")
+	apiUrl.RawQuery = values.Encode()
+
+	userArray := userArrayDto{}
+	_, err := client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &userArray)
+	if err != nil {
 		return nil, err
 	}
 	return userArray.Value, nil
 }
 
-func (client *client) GetDataverseUserBySystemUserId(ctx context.Context, environmentId, systemUserId string) (*userDto, error) {
+// GetApplicationUsers lists the application users of an environment, optionally narrowed down by an OData filter.
+func (client *client) GetApplicationUsers(ctx context.Context, environmentId, filter string) ([]userDto, error) {
 	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
 	if err != nil {
 		return nil, err
@@ -80,24 +119,137 @@ func (client *client) GetDataverseUserBySystemUserId(ctx context.Context, enviro
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.2/systemusers(" + systemUserId + ")",
+		Path:   fmt.Sprintf("/api/data/%s/systemusers", client.Api.GetConfig().GetDataverseApiVersion()),
 	}
 	values := url.Values{}
+	if filter != "" {
+		values.Add("$filter", fmt.Sprintf("applicationid ne null and (%s)", filter))
+	} else {
+		values.Add("$filter", "applicationid ne null")
+	}
 	values.Add("$expand", "systemuserroles_association($select=roleid,name,ismanaged,_businessunitid_value)")
 	apiUrl.RawQuery = values.Encode()
 
-	user := userDto{}
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &user)
+	userArray := userArrayDto{}
+	_, err = client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &userArray)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
+	return userArray.Value, nil
+}
+
+// GetApplicationUser returns the Dataverse application user created for the given Entra application (client) id.
+func (client *client) GetApplicationUser(ctx context.Context, environmentId, applicationId string) (*userDto, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
+	users, err := client.GetApplicationUsersByApplicationId(ctx, environmentHost, applicationId)
+	if err != nil {
 		return nil, err
 	}
-	return &user, nil
+	if len(users) == 0 {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("application user for application '%s' not found", applicationId))
+	}
+	return &users[0], nil
+}
+
+// CreateApplicationUser creates the Dataverse application user of an Entra application (client) id.
+// The service principal of the application registration is resolved by the Power Platform API, so only the client id is needed.
+func (client *client) CreateApplicationUser(ctx context.Context, environmentId, applicationId string) (*userDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.BapiUrl,
+		Path:   fmt.Sprintf("/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/%s/addAppUser", environmentId),
+	}
+	values := url.Values{}
+	values.Add("api-version", "2023-06-01")
+	apiUrl.RawQuery = values.Encode()
+
+	userToCreate := map[string]any{
+		"servicePrincipalAppId": applicationId,
+	}
+
+	_, err := client.Api.Execute(ctx, nil, "POST", apiUrl.String(), api.IdempotencyKeyHeaders("addAppUser", environmentId, applicationId), userToCreate, []int{http.StatusOK}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.GetApplicationUser(ctx, environmentId, applicationId)
+}
+
+// GetUserPrivileges returns the privileges that the security roles of the user, including the roles of its teams, grant to the user.
+func (client *client) GetUserPrivileges(ctx context.Context, environmentId, systemUserId string) ([]userPrivilegeDto, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	privileges := userPrivilegesDto{}
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, fmt.Sprintf("systemusers(%s)/Microsoft.Dynamics.CRM.RetrieveUserPrivileges()", systemUserId), nil), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &privileges)
+	if err != nil {
+		return nil, err
+	}
+	return privileges.RolePrivileges, nil
+}
+
+// GetApplicationUserEnvironments looks up the application user for the given application (client) id in every Dataverse environment of the tenant.
+// Environments are queried in parallel with at most APPLICATION_USER_ENVIRONMENTS_MAX_CONCURRENCY requests in flight.
+// Environments that could not be queried are returned in the error map keyed by environment id instead of failing the whole lookup.
+func (client *client) GetApplicationUserEnvironments(ctx context.Context, applicationId string) ([]applicationUserEnvironmentDto, map[string]error, error) {
+	environments, err := client.environmentClient.GetEnvironments(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make([][]applicationUserEnvironmentDto, len(environments))
+	errs := make([]error, len(environments))
+
+	semaphore := make(chan struct{}, APPLICATION_USER_ENVIRONMENTS_MAX_CONCURRENCY)
+	var wg sync.WaitGroup
+	for i, env := range environments {
+		if env.Properties == nil || env.Properties.LinkedEnvironmentMetadata == nil || env.Properties.LinkedEnvironmentMetadata.InstanceURL == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Skipping environment '%s' without Dataverse", env.Name))
+			continue
+		}
+		envUrl, err := url.Parse(strings.TrimSuffix(env.Properties.LinkedEnvironmentMetadata.InstanceURL, "/"))
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, env environment.EnvironmentDto, environmentHost string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			users, err := client.GetApplicationUsersByApplicationId(ctx, environmentHost, applicationId)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			for _, user := range users {
+				results[i] = append(results[i], applicationUserEnvironmentDto{
+					EnvironmentId:          env.Name,
+					EnvironmentDisplayName: env.Properties.DisplayName,
+					User:                   user,
+				})
+			}
+		}(i, env, envUrl.Host)
+	}
+	wg.Wait()
+
+	applicationUsers := []applicationUserEnvironmentDto{}
+	failedEnvironments := map[string]error{}
+	for i, env := range environments {
+		if errs[i] != nil {
+			failedEnvironments[env.Name] = errs[i]
+			continue
+		}
+		applicationUsers = append(applicationUsers, results[i]...)
+	}
+	return applicationUsers, failedEnvironments, nil
 }
 
 func (client *client) GetEnvironmentUserByAadObjectId(ctx context.Context, environmentId, aadObjectId string) (*userDto, error) {
@@ -152,7 +304,7 @@ func (client *client) GetDataverseUserByAadObjectId(ctx context.Context, environ
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.2/systemusers",
+		Path:   fmt.Sprintf("/api/data/%s/systemusers", client.Api.GetConfig().GetDataverseApiVersion()),
 	}
 	values := url.Values{}
 	values.Add("$filter", fmt.Sprintf("azureactivedirectoryobjectid eq %s", aadObjectId))
@@ -160,16 +312,10 @@ func (client *client) GetDataverseUserByAadObjectId(ctx context.Context, environ
 	apiUrl.RawQuery = values.Encode()
 
 	user := userArrayDto{}
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &user)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &user)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 
 	if len(user.Value) == 0 {
 		if err := client.Api.SleepWithContext(ctx, api.DefaultRetryAfter()); err != nil {
@@ -217,7 +363,7 @@ func (client *client) RemoveEnvironmentUserSecurityRoles(ctx context.Context, en
 
 	if resp.HttpResponse.StatusCode == http.StatusInternalServerError {
 		tflog.Debug(ctx, fmt.Sprintf("Error Status Code: %d", resp.HttpResponse.StatusCode))
-		tflog.Debug(ctx, fmt.Sprintf("Error removing security roles: %s", resp.BodyAsBytes))
+		tflog.Debug(ctx, fmt.Sprintf("Error removing security roles: %s", helpers.ScrubSensitiveValues(resp.BodyAsBytes)))
 	}
 
 	userRead, err = client.GetEnvironmentUserByAadObjectId(ctx, environmentId, aadObjectId)
@@ -290,7 +436,9 @@ func (client *client) CreateEnvironmentUser(ctx context.Context, environmentId,
 	return client.AddEnvironmentUserSecurityRoles(ctx, environmentId, aadObjectId, securityRoles)
 }
 
-func (client *client) CreateDataverseUser(ctx context.Context, environmentId, aadObjectId string) (*userDto, error) {
+// CreateDataverseUser adds the Entra user to the Dataverse environment.
+// While Entra has not yet propagated the license of the user, the request is retried with exponential backoff until maxLicenseWait has been waited or the context ends.
+func (client *client) CreateDataverseUser(ctx context.Context, environmentId, aadObjectId string, maxLicenseWait time.Duration) (*userDto, error) {
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   client.Api.GetConfig().Urls.BapiUrl,
@@ -304,26 +452,28 @@ func (client *client) CreateDataverseUser(ctx context.Context, environmentId, aa
 		"objectId": aadObjectId,
 	}
 
-	// 9 minutes of retries.
-	retryCount := 6 * 9
-	var err error
-
-	for retryCount > 0 {
-		_, err = client.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, userToCreate, []int{http.StatusOK}, nil)
-		// the license assignment in Entra is async, so we need to wait for that to happen if a user is created in the same terraform run.
-		if err == nil || !strings.Contains(err.Error(), "userNotLicensed") {
+	// the license assignment in Entra is async, so we need to wait for that to happen if a user is created in the same terraform run.
+	delay := USER_LICENSE_WAIT_INITIAL_DELAY
+	waited := time.Duration(0)
+	for {
+		_, err := client.Api.Execute(ctx, nil, "POST", apiUrl.String(), api.IdempotencyKeyHeaders("addUser", environmentId, aadObjectId), userToCreate, []int{http.StatusOK}, nil)
+		if err == nil {
 			break
 		}
-		tflog.Debug(ctx, fmt.Sprintf("Error creating user: %s", err.Error()))
-		err = client.Api.SleepWithContext(ctx, 10*time.Second)
-		if err != nil {
+		if !strings.Contains(err.Error(), "userNotLicensed") {
 			return nil, err
 		}
+		if waited >= maxLicenseWait {
+			return nil, fmt.Errorf("the user is still not licensed after waiting %s for the license assignment: %w", maxLicenseWait, err)
+		}
 
-		retryCount--
-	}
-	if err != nil {
-		return nil, err
+		sleep := min(licenseWaitDelay(delay), maxLicenseWait-waited)
+		tflog.Debug(ctx, fmt.Sprintf("User is not licensed yet, retrying in %s: %s", sleep, err.Error()))
+		if err := client.Api.SleepWithContext(ctx, sleep); err != nil {
+			return nil, err
+		}
+		waited += sleep
+		delay = min(delay*2, USER_LICENSE_WAIT_MAX_DELAY)
 	}
 
 	user, err := client.GetDataverseUserByAadObjectId(ctx, environmentId, aadObjectId)
@@ -342,19 +492,13 @@ func (client *client) UpdateDataverseUser(ctx context.Context, environmentId, sy
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.2/systemusers(" + systemUserId + ")",
+		Path:   fmt.Sprintf("/api/data/%s/systemusers(%s)", client.Api.GetConfig().GetDataverseApiVersion(), systemUserId),
 	}
 
-	resp, err := client.Api.Execute(ctx, nil, "PATCH", apiUrl.String(), nil, userUpdate, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, nil)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "PATCH", apiUrl.String(), nil, userUpdate, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, nil)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 
 	user, err := client.GetDataverseUserBySystemUserId(ctx, environmentId, systemUserId)
 	if err != nil {
@@ -371,20 +515,47 @@ func (client *client) DeleteDataverseUser(ctx context.Context, environmentId, sy
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.2/systemusers(" + systemUserId + ")",
+		Path:   fmt.Sprintf("/api/data/%s/systemusers(%s)", client.Api.GetConfig().GetDataverseApiVersion(), systemUserId),
 	}
 
-	resp, err := client.Api.Execute(ctx, nil, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusNoContent, http.StatusForbidden, http.StatusNotFound}, nil)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusNoContent}, api.NotFoundOrForbiddenResponseErrors, nil)
 	if err != nil {
 		return err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return err
-	} else if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return err
+
+	// Dataverse accepts the delete before the systemuser record is actually removed,
+	// so wait until it no longer resolves to allow an immediate re-create of the same user.
+	return client.waitForDataverseUserDeleted(ctx, environmentHost, systemUserId)
+}
+
+func (client *client) waitForDataverseUserDeleted(ctx context.Context, environmentHost, systemUserId string) error {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/systemusers(%s)", client.Api.GetConfig().GetDataverseApiVersion(), systemUserId),
 	}
+	values := url.Values{}
+	values.Add("$select", "systemuserid,isdisabled")
+	apiUrl.RawQuery = values.Encode()
 
-	return nil
+	progress := client.Api.NewOperationProgress("User deletion")
+	for {
+		user := userDto{}
+		resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &user)
+		if err != nil {
+			return err
+		}
+		if resp.HttpResponse.StatusCode == http.StatusNotFound || user.IsDisabled {
+			return nil
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Systemuser '%s' still exists after delete, waiting for it to be removed", systemUserId))
+		progress.Report(ctx, "Deleting", -1)
+
+		err = client.Api.SleepWithContext(ctx, api.DefaultRetryAfter())
+		if err != nil {
+			return err
+		}
+	}
 }
 
 func (client *client) RemoveDataverseSecurityRoles(ctx context.Context, environmentId, systemUserId string, securityRolesIds []string) (*userDto, error) {
@@ -397,25 +568,19 @@ func (client *client) RemoveDataverseSecurityRoles(ctx context.Context, environm
 		apiUrl := &url.URL{
 			Scheme: constants.HTTPS,
 			Host:   environmentHost,
-			Path:   "/api/data/v9.2/systemusers(" + systemUserId + ")/systemuserroles_association/$ref",
+			Path:   fmt.Sprintf("/api/data/%s/systemusers(%s)/systemuserroles_association/$ref", client.Api.GetConfig().GetDataverseApiVersion(), systemUserId),
 		}
 		values := url.Values{}
-		values.Add("$id", fmt.Sprintf("https://%s/api/data/v9.2/roles(%s)", environmentHost, roleId))
+		values.Add("$id", fmt.Sprintf("https://%s/api/data/%s/roles(%s)", environmentHost, client.Api.GetConfig().GetDataverseApiVersion(), roleId))
 		apiUrl.RawQuery = values.Encode()
 
-		resp, err := client.Api.Execute(ctx, nil, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusNoContent, http.StatusForbidden, http.StatusNotFound}, nil)
+		_, err := client.Api.ExecuteExpecting(ctx, nil, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusNoContent}, api.NotFoundOrForbiddenResponseErrors, nil)
 		if err != nil {
-			if strings.Contains(err.Error(), "0x80060888") && strings.Contains(err.Error(), roleId) {
+			if customerrors.ApiErrorCode(err) == "0x80060888" && strings.Contains(err.Error(), roleId) {
 				return nil, fmt.Errorf("role with id '%s' is not valid", roleId)
 			}
 			return nil, err
 		}
-		if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-			return nil, err
-		}
-		if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-			return nil, err
-		}
 	}
 
 	user, err := client.GetDataverseUserBySystemUserId(ctx, environmentId, systemUserId)
@@ -433,26 +598,20 @@ func (client *client) AddDataverseSecurityRoles(ctx context.Context, environment
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.2/systemusers(" + systemUserId + ")/systemuserroles_association/$ref",
+		Path:   fmt.Sprintf("/api/data/%s/systemusers(%s)/systemuserroles_association/$ref", client.Api.GetConfig().GetDataverseApiVersion(), systemUserId),
 	}
 
 	for _, roleId := range securityRolesIds {
 		roleToassociate := map[string]any{
-			"@odata.id": fmt.Sprintf("https://%s/api/data/v9.2/roles(%s)", environmentHost, roleId),
+			"@odata.id": fmt.Sprintf("https://%s/api/data/%s/roles(%s)", environmentHost, client.Api.GetConfig().GetDataverseApiVersion(), roleId),
 		}
-		resp, err := client.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, roleToassociate, []int{http.StatusNoContent, http.StatusForbidden, http.StatusNotFound}, nil)
+		_, err := client.Api.ExecuteExpecting(ctx, nil, "POST", apiUrl.String(), nil, roleToassociate, []int{http.StatusNoContent}, api.NotFoundOrForbiddenResponseErrors, nil)
 		if err != nil {
-			if strings.Contains(err.Error(), "0x80060888") && strings.Contains(err.Error(), roleId) {
+			if customerrors.ApiErrorCode(err) == "0x80060888" && strings.Contains(err.Error(), roleId) {
 				return nil, fmt.Errorf("role with id '%s' is not valid", roleId)
 			}
 			return nil, err
 		}
-		if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-			return nil, err
-		}
-		if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-			return nil, err
-		}
 	}
 	user, err := client.GetDataverseUserBySystemUserId(ctx, environmentId, systemUserId)
 	if err != nil {
@@ -461,6 +620,95 @@ func (client *client) AddDataverseSecurityRoles(ctx context.Context, environment
 	return user, nil
 }
 
+// UpdateApplicationUserBusinessUnit moves an application user to another business unit.
+// Dataverse removes the security roles of the user when it changes business unit, so they have to be assigned again afterwards.
+func (client *client) UpdateApplicationUserBusinessUnit(ctx context.Context, environmentId, systemUserId, businessUnitId string) (*userDto, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/systemusers(%s)", client.Api.GetConfig().GetDataverseApiVersion(), systemUserId),
+	}
+	userUpdate := map[string]any{
+		"businessunitid@odata.bind": fmt.Sprintf("/businessunits(%s)", businessUnitId),
+	}
+
+	_, err = client.Api.Execute(ctx, nil, "PATCH", apiUrl.String(), nil, userUpdate, []int{http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetDataverseUserBySystemUserId(ctx, environmentId, systemUserId)
+}
+
+// GetBusinessUnitSecurityRoleIds maps security roles to their copy in the given business unit.
+// Every business unit has its own copy of the roles of the root business unit, and users can only be assigned the copies of their own business unit.
+func (client *client) GetBusinessUnitSecurityRoleIds(ctx context.Context, environmentId, businessUnitId string, securityRoleIds []string) (map[string]string, error) {
+	roles, err := client.GetDataverseSecurityRoles(ctx, environmentId, "")
+	if err != nil {
+		return nil, err
+	}
+
+	rolesById := map[string]securityRoleDto{}
+	for _, role := range roles {
+		rolesById[strings.ToLower(role.RoleId)] = role
+	}
+
+	businessUnitRoleIds := map[string]string{}
+	for _, roleId := range securityRoleIds {
+		role, ok := rolesById[strings.ToLower(roleId)]
+		if !ok || strings.EqualFold(role.BusinessUnitId, businessUnitId) {
+			// unknown roles are assigned as they are and reported as invalid by Dataverse.
+			businessUnitRoleIds[roleId] = roleId
+			continue
+		}
+
+		rootRoleId := role.ParentRootRoleId
+		if rootRoleId == "" {
+			rootRoleId = role.RoleId
+		}
+		for _, businessUnitRole := range roles {
+			if strings.EqualFold(businessUnitRole.BusinessUnitId, businessUnitId) && strings.EqualFold(businessUnitRole.ParentRootRoleId, rootRoleId) {
+				businessUnitRoleIds[roleId] = businessUnitRole.RoleId
+				break
+			}
+		}
+		if _, ok := businessUnitRoleIds[roleId]; !ok {
+			return nil, fmt.Errorf("security role '%s' has no copy in business unit '%s'", roleId, businessUnitId)
+		}
+	}
+	return businessUnitRoleIds, nil
+}
+
+// GetSecurityRoleIdsByName resolves security role names to the ids of the roles of the given business unit, failing when a name is missing or ambiguous.
+func (client *client) GetSecurityRoleIdsByName(ctx context.Context, environmentId, businessUnitId string, securityRoleNames []string) ([]string, error) {
+	roles, err := client.GetDataverseSecurityRoles(ctx, environmentId, businessUnitId)
+	if err != nil {
+		return nil, err
+	}
+
+	roleIds := make([]string, 0, len(securityRoleNames))
+	for _, name := range securityRoleNames {
+		matchingRoleIds := []string{}
+		for _, role := range roles {
+			if strings.EqualFold(role.Name, name) {
+				matchingRoleIds = append(matchingRoleIds, role.RoleId)
+			}
+		}
+		switch len(matchingRoleIds) {
+		case 0:
+			return nil, fmt.Errorf("security role '%s' not found in business unit '%s'", name, businessUnitId)
+		case 1:
+			roleIds = append(roleIds, matchingRoleIds[0])
+		default:
+			return nil, fmt.Errorf("security role name '%s' is ambiguous, %d roles of business unit '%s' have this name, use security_roles instead", name, len(matchingRoleIds), businessUnitId)
+		}
+	}
+	return roleIds, nil
+}
+
 func (client *client) GetEnvironmentHostById(ctx context.Context, environmentId string) (string, error) {
 	env, err := client.getEnvironment(ctx, environmentId)
 	if err != nil {
@@ -491,7 +739,7 @@ func (client *client) getEnvironment(ctx context.Context, environmentId string)
 	env := environmentIdDto{}
 	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &env)
 	if err != nil {
-		var httpError *customerrors.UnexpectedHttpStatusCodeError
+		var httpError customerrors.UnexpectedHttpStatusCodeError
 		if errors.As(err, &httpError) && httpError.StatusCode == http.StatusNotFound {
 			return nil, customerrors.WrapIntoProviderError(err, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("environment %s not found", environmentId))
 		}
@@ -509,7 +757,7 @@ func (client *client) GetDataverseSecurityRoles(ctx context.Context, environment
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.2/roles",
+		Path:   fmt.Sprintf("/api/data/%s/roles", client.Api.GetConfig().GetDataverseApiVersion()),
 	}
 	if businessUnitId != "" {
 		var values = url.Values{}
@@ -517,15 +765,14 @@ func (client *client) GetDataverseSecurityRoles(ctx context.Context, environment
 		apiUrl.RawQuery = values.Encode()
 	}
 	securityRoleArray := securityRoleArrayDto{}
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &securityRoleArray)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &securityRoleArray)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 	return securityRoleArray.Value, nil
 }
+
+// licenseWaitDelay adds up to 20% of jitter to the delay, so that users created in parallel do not retry in lockstep.
+func licenseWaitDelay(delay time.Duration) time.Duration {
+	return delay + time.Duration(rand.Int63n(int64(delay)/5+1))
+}
@@ -3,7 +3,65 @@
 
 package authorization
 
+import "time"
+
 const (
 	ROLE_ENVIRONMENT_ADMIN = "Environment Admin"
 	ROLE_ENVIRONMENT_MAKER = "Environment Maker"
 )
+
+// APPLICATION_USER_ENVIRONMENTS_MAX_CONCURRENCY limits how many environments are queried in parallel when looking up application users across the tenant.
+const APPLICATION_USER_ENVIRONMENTS_MAX_CONCURRENCY = 5
+
+// Depths at which a security role grants a privilege, from the records owned by the user to all the records of the organization.
+const (
+	PRIVILEGE_DEPTH_BASIC  = "Basic"
+	PRIVILEGE_DEPTH_LOCAL  = "Local"
+	PRIVILEGE_DEPTH_DEEP   = "Deep"
+	PRIVILEGE_DEPTH_GLOBAL = "Global"
+)
+
+// privilegeDepthMasks maps the privilegedepthmask values of role privileges to their depth.
+var privilegeDepthMasks = map[int64]string{
+	1: PRIVILEGE_DEPTH_BASIC,
+	2: PRIVILEGE_DEPTH_LOCAL,
+	4: PRIVILEGE_DEPTH_DEEP,
+	8: PRIVILEGE_DEPTH_GLOBAL,
+}
+
+const (
+	TEAM_TYPE_OWNER                = "Owner"
+	TEAM_TYPE_ACCESS               = "Access"
+	TEAM_TYPE_ENTRA_SECURITY_GROUP = "EntraSecurityGroup"
+	TEAM_TYPE_ENTRA_OFFICE_GROUP   = "EntraOfficeGroup"
+)
+
+// teamTypes maps the team types to their teamtype value.
+var teamTypes = map[string]int64{
+	TEAM_TYPE_OWNER:                0,
+	TEAM_TYPE_ACCESS:               1,
+	TEAM_TYPE_ENTRA_SECURITY_GROUP: 2,
+	TEAM_TYPE_ENTRA_OFFICE_GROUP:   3,
+}
+
+const (
+	TEAM_MEMBERSHIP_TYPE_MEMBERS_AND_GUESTS = "MembersAndGuests"
+	TEAM_MEMBERSHIP_TYPE_MEMBERS            = "Members"
+	TEAM_MEMBERSHIP_TYPE_OWNERS             = "Owners"
+	TEAM_MEMBERSHIP_TYPE_GUESTS             = "Guests"
+)
+
+// teamMembershipTypes maps the membership types of Entra group teams to their membershiptype value.
+var teamMembershipTypes = map[string]int64{
+	TEAM_MEMBERSHIP_TYPE_MEMBERS_AND_GUESTS: 0,
+	TEAM_MEMBERSHIP_TYPE_MEMBERS:            1,
+	TEAM_MEMBERSHIP_TYPE_OWNERS:             2,
+	TEAM_MEMBERSHIP_TYPE_GUESTS:             3,
+}
+
+// Bounds of the exponential backoff used while waiting for the Entra license of a new user to be picked up by the environment.
+const (
+	USER_LICENSE_WAIT_DEFAULT_TIMEOUT = 9 * time.Minute
+	USER_LICENSE_WAIT_INITIAL_DELAY   = 5 * time.Second
+	USER_LICENSE_WAIT_MAX_DELAY       = time.Minute
+)
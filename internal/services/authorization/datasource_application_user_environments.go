@@ -0,0 +1,197 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var (
+	_ datasource.DataSource              = &ApplicationUserEnvironmentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ApplicationUserEnvironmentsDataSource{}
+)
+
+type ApplicationUserEnvironmentsDataSource struct {
+	helpers.TypeInfo
+	UserClient client
+}
+
+func NewApplicationUserEnvironmentsDataSource() datasource.DataSource {
+	return &ApplicationUserEnvironmentsDataSource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "application_user_environments",
+		},
+	}
+}
+
+func (d *ApplicationUserEnvironmentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches every environment in the tenant where a service principal exists as a Dataverse application user, together with the security roles assigned to it. This is useful for periodic access reviews. For more information see [Manage application users](https://learn.microsoft.com/power-platform/admin/manage-application-users).\n\nEnvironments that can't be queried by the provider's identity are skipped and reported as warnings.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Read: true,
+			}),
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application (client) id of the service principal",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "application_id must be a valid application (client) id guid"),
+				},
+			},
+			"environments": schema.ListNestedAttribute{
+				MarkdownDescription: "List of environments where the service principal exists as an application user",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"environment_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the environment",
+							Computed:            true,
+						},
+						"environment_display_name": schema.StringAttribute{
+							MarkdownDescription: "Display name of the environment",
+							Computed:            true,
+						},
+						"system_user_id": schema.StringAttribute{
+							MarkdownDescription: "Dataverse system user id of the application user",
+							Computed:            true,
+						},
+						"business_unit_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the business unit the application user belongs to",
+							Computed:            true,
+						},
+						"security_roles": schema.ListNestedAttribute{
+							MarkdownDescription: "Security roles assigned to the application user",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"role_id": schema.StringAttribute{
+										MarkdownDescription: "Security role id",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Security role name",
+										Computed:            true,
+									},
+									"is_managed": schema.BoolAttribute{
+										MarkdownDescription: "Is the security role managed",
+										Computed:            true,
+									},
+									"business_unit_id": schema.StringAttribute{
+										MarkdownDescription: "Id of the business unit",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationUserEnvironmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.UserClient = newUserClient(client.Api)
+}
+
+func (d *ApplicationUserEnvironmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *ApplicationUserEnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var state ApplicationUserEnvironmentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.ApplicationId.ValueString() == "" {
+		resp.Diagnostics.AddError("application_id cannot be an empty string", "application_id cannot be an empty string")
+		return
+	}
+
+	applicationUsers, failedEnvironments, err := d.UserClient.GetApplicationUserEnvironments(ctx, state.ApplicationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+
+	failedEnvironmentIds := make([]string, 0, len(failedEnvironments))
+	for environmentId := range failedEnvironments {
+		failedEnvironmentIds = append(failedEnvironmentIds, environmentId)
+	}
+	sort.Strings(failedEnvironmentIds)
+	for _, environmentId := range failedEnvironmentIds {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Unable to read application users in environment '%s'", environmentId), failedEnvironments[environmentId].Error())
+	}
+
+	state.Environments = []ApplicationUserEnvironmentDataSourceModel{}
+	for _, applicationUser := range applicationUsers {
+		state.Environments = append(state.Environments, convertFromApplicationUserEnvironmentDto(applicationUser))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func convertFromApplicationUserEnvironmentDto(applicationUser applicationUserEnvironmentDto) ApplicationUserEnvironmentDataSourceModel {
+	model := ApplicationUserEnvironmentDataSourceModel{
+		EnvironmentId:          types.StringValue(applicationUser.EnvironmentId),
+		EnvironmentDisplayName: types.StringValue(applicationUser.EnvironmentDisplayName),
+		SystemUserId:           types.StringValue(applicationUser.User.Id),
+		BusinessUnitId:         types.StringValue(applicationUser.User.BusinessUnitId),
+		SecurityRoles:          []SecurityRoleDataSourceModel{},
+	}
+	for _, role := range applicationUser.User.SecurityRoles {
+		model.SecurityRoles = append(model.SecurityRoles, SecurityRoleDataSourceModel{
+			RoleId:         types.StringValue(role.RoleId),
+			Name:           types.StringValue(role.Name),
+			IsManaged:      types.BoolValue(role.IsManaged),
+			BusinessUnitId: types.StringValue(role.BusinessUnitId),
+		})
+	}
+	return model
+}
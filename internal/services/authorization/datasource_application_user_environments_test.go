@@ -0,0 +1,131 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccApplicationUserEnvironmentsDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"azuread": {
+				VersionConstraint: constants.AZURE_AD_PROVIDER_VERSION_CONSTRAINT,
+				Source:            "hashicorp/azuread",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "azuread_client_config" "current" {}
+
+				resource "powerplatform_environment" "env" {
+					display_name      = "` + mocks.TestName() + `"
+					location          = "unitedstates"
+					environment_type  = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_application_user_environments" "current" {
+					application_id = data.azuread_client_config.current.client_id
+
+					depends_on = [powerplatform_environment.env]
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.powerplatform_application_user_environments.current", "environments.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestMatchResourceAttr("data.powerplatform_application_user_environments.current", "environments.0.environment_id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_application_user_environments.current", "environments.0.system_user_id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_application_user_environments.current", "environments.0.business_unit_id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_application_user_environments.current", "environments.0.security_roles.#", regexp.MustCompile(`^[1-9]\d*$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitApplicationUserEnvironmentsDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments?%24expand=properties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/application_user_environments/Validate_Read/get_environments.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^https://([\d-]+)\.crm4\.dynamics\.com/api/data/v9\.2/systemusers\?`,
+		func(req *http.Request) (*http.Response, error) {
+			if req.URL.Query().Get("$filter") != "applicationid eq 00000000-0000-0000-0000-000000000100" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+			}
+			id := httpmock.MustGetSubmatch(req, 1)
+			if id == "00000000-0000-0000-0000-000000000004" {
+				resp := httpmock.NewStringResponse(http.StatusForbidden, `{"error":{"code":"0x80072560","message":"The user is not a member of the organization."}}`)
+				resp.Request = req
+				return resp, nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/application_user_environments/Validate_Read/get_application_users_"+id+".json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_application_user_environments" "app" {
+					application_id = "00000000-0000-0000-0000-000000000100"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.#", "2"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.0.environment_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.0.environment_display_name", "Production"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.0.system_user_id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.0.business_unit_id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.0.security_roles.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.0.security_roles.0.role_id", "00000000-0000-0000-0000-000000000030"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.0.security_roles.0.name", "System Administrator"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.0.security_roles.1.name", "Basic User"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.1.environment_id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.1.environment_display_name", "Sandbox"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.1.system_user_id", "00000000-0000-0000-0000-000000000011"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.1.security_roles.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_user_environments.app", "environments.1.security_roles.0.name", "Environment Maker"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitApplicationUserEnvironmentsDataSource_Validate_Invalid_Application_Id(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_application_user_environments" "app" {
+					application_id = "00000000-0000-0000-0000-000000000100 or applicationid ne null"
+				}`,
+				ExpectError: regexp.MustCompile(`application_id must be a valid application \(client\) id guid`),
+			},
+		},
+	})
+}
@@ -0,0 +1,210 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var (
+	_ datasource.DataSource              = &ApplicationUsersDataSource{}
+	_ datasource.DataSourceWithConfigure = &ApplicationUsersDataSource{}
+)
+
+type ApplicationUsersDataSource struct {
+	helpers.TypeInfo
+	UserClient client
+}
+
+func NewApplicationUsersDataSource() datasource.DataSource {
+	return &ApplicationUsersDataSource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "application_users",
+		},
+	}
+}
+
+func (d *ApplicationUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the list of application users of a Dataverse environment, together with the security roles assigned to them. For more information see [Manage application users](https://learn.microsoft.com/power-platform/admin/manage-application-users).",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Read: true,
+			}),
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Dataverse environment",
+				Required:            true,
+			},
+			"filter": schema.StringAttribute{
+				MarkdownDescription: "OData filter on the `systemusers` table to narrow down the application users, for example `isdisabled eq false`",
+				Optional:            true,
+			},
+			"application_users": schema.ListNestedAttribute{
+				MarkdownDescription: "List of application users",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Id of the Dataverse systemuser of the application user",
+							Computed:            true,
+						},
+						"application_id": schema.StringAttribute{
+							MarkdownDescription: "Application (client) id of the Entra application registration",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the application user",
+							Computed:            true,
+						},
+						"aad_id": schema.StringAttribute{
+							MarkdownDescription: "Object id of the Entra service principal of the application",
+							Computed:            true,
+						},
+						"business_unit_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the business unit to which the application user belongs",
+							Computed:            true,
+						},
+						"is_disabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the application user is disabled",
+							Computed:            true,
+						},
+						"application_id_uri": schema.StringAttribute{
+							MarkdownDescription: "Application id uri of the Entra application registration, empty when the application doesn't expose an api",
+							Computed:            true,
+						},
+						"created_time": schema.StringAttribute{
+							MarkdownDescription: "Date and time the application user was created in the environment",
+							Computed:            true,
+						},
+						"last_modified_time": schema.StringAttribute{
+							MarkdownDescription: "Date and time the application user was last modified",
+							Computed:            true,
+						},
+						"security_roles": schema.ListNestedAttribute{
+							MarkdownDescription: "Security roles assigned to the application user",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"role_id": schema.StringAttribute{
+										MarkdownDescription: "Security role id",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Security role name",
+										Computed:            true,
+									},
+									"is_managed": schema.BoolAttribute{
+										MarkdownDescription: "Is the security role managed",
+										Computed:            true,
+									},
+									"business_unit_id": schema.StringAttribute{
+										MarkdownDescription: "Id of the business unit",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApplicationUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.UserClient = newUserClient(client.Api)
+}
+
+func (d *ApplicationUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *ApplicationUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var state ApplicationUsersDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.EnvironmentId.ValueString() == "" {
+		resp.Diagnostics.AddError("environment_id cannot be an empty string", "environment_id cannot be an empty string")
+		return
+	}
+
+	users, err := d.UserClient.GetApplicationUsers(ctx, state.EnvironmentId.ValueString(), state.Filter.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+
+	state.ApplicationUsers = []ApplicationUserDataSourceModel{}
+	for _, user := range users {
+		state.ApplicationUsers = append(state.ApplicationUsers, convertFromApplicationUserDataSourceDto(user))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func convertFromApplicationUserDataSourceDto(user userDto) ApplicationUserDataSourceModel {
+	model := ApplicationUserDataSourceModel{
+		Id:               types.StringValue(user.Id),
+		ApplicationId:    types.StringValue(user.ApplicationId),
+		Name:             types.StringValue(user.FullName),
+		AadId:            types.StringValue(user.AadObjectId),
+		BusinessUnitId:   types.StringValue(user.BusinessUnitId),
+		IsDisabled:       types.BoolValue(user.IsDisabled),
+		SecurityRoles:    []SecurityRoleDataSourceModel{},
+		ApplicationIdUri: types.StringValue(user.ApplicationIdUri),
+		CreatedTime:      types.StringValue(user.CreatedOn),
+		LastModifiedTime: types.StringValue(user.ModifiedOn),
+	}
+	for _, role := range user.SecurityRoles {
+		model.SecurityRoles = append(model.SecurityRoles, SecurityRoleDataSourceModel{
+			RoleId:         types.StringValue(role.RoleId),
+			Name:           types.StringValue(role.Name),
+			IsManaged:      types.BoolValue(role.IsManaged),
+			BusinessUnitId: types.StringValue(role.BusinessUnitId),
+		})
+	}
+	return model
+}
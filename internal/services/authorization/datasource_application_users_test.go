@@ -0,0 +1,101 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccApplicationUsersDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_application_users" "all" {
+					environment_id = powerplatform_environment.env.id
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.powerplatform_application_users.all", "application_users.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestMatchResourceAttr("data.powerplatform_application_users.all", "application_users.0.id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_application_users.all", "application_users.0.application_id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_application_users.all", "application_users.0.business_unit_id", regexp.MustCompile(helpers.GuidRegex)),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitApplicationUsersDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/application_users/Validate_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24filter=applicationid+ne+null",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/application_users/Validate_Read/get_application_users.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24filter=applicationid+ne+null+and+%28isdisabled+eq+false%29",
+		httpmock.NewStringResponder(http.StatusOK, `{"value":[]}`))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_application_users" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}
+
+				data "powerplatform_application_users" "enabled" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					filter         = "isdisabled eq false"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.0.id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.0.application_id", "00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.0.name", "# automation"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.0.aad_id", "00000000-0000-0000-0000-000000000200"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.0.business_unit_id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.0.is_disabled", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.0.application_id_uri", "api://00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.0.created_time", "2026-01-05T08:30:00Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.0.last_modified_time", "2026-02-10T16:45:00Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.0.security_roles.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.0.security_roles.0.role_id", "00000000-0000-0000-0000-000000000030"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.0.security_roles.0.name", "Basic User"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.1.is_disabled", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.all", "application_users.1.security_roles.#", "0"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_application_users.enabled", "application_users.#", "0"),
+				),
+			},
+		},
+	})
+}
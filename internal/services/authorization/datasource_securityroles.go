@@ -6,6 +6,7 @@ package authorization
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -52,6 +53,18 @@ func (d *SecurityRolesDataSource) Schema(ctx context.Context, req datasource.Sch
 				MarkdownDescription: "Id of the business unit to filter the security roles",
 				Optional:            true,
 			},
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Case insensitive prefix of the name to filter the security roles",
+				Optional:            true,
+			},
+			"is_managed": schema.BoolAttribute{
+				MarkdownDescription: "Filter the security roles by whether they are managed, for example `false` to only return the custom roles of the environment",
+				Optional:            true,
+			},
+			"total_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of security roles matching the filters",
+				Computed:            true,
+			},
 			"security_roles": schema.ListNestedAttribute{
 				MarkdownDescription: "List of security roles",
 				Computed:            true,
@@ -141,7 +154,12 @@ func (d *SecurityRolesDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
+	namePrefix := strings.ToLower(state.NamePrefix.ValueString())
 	for _, role := range roles {
+		if !strings.HasPrefix(strings.ToLower(role.Name), namePrefix) ||
+			(!state.IsManaged.IsNull() && state.IsManaged.ValueBool() != role.IsManaged) {
+			continue
+		}
 		state.SecurityRoles = append(state.SecurityRoles, SecurityRoleDataSourceModel{
 			RoleId:         types.StringValue(role.RoleId),
 			Name:           types.StringValue(role.Name),
@@ -150,6 +168,8 @@ func (d *SecurityRolesDataSource) Read(ctx context.Context, req datasource.ReadR
 		})
 	}
 
+	state.TotalCount = types.Int64Value(int64(len(state.SecurityRoles)))
+
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
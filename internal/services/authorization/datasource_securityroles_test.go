@@ -78,6 +78,7 @@ func TestUnitSecurityDataSource_Validate_Read(t *testing.T) {
 
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("data.powerplatform_security_roles.all", "security_roles.#", "72"),
+					resource.TestCheckResourceAttr("data.powerplatform_security_roles.all", "total_count", "72"),
 					resource.TestCheckResourceAttr("data.powerplatform_security_roles.all", "environment_id", "00000000-0000-0000-0000-000000000001"),
 
 					resource.TestCheckResourceAttr("data.powerplatform_security_roles.all", "security_roles.0.role_id", "4931681d-8163-e811-a965-000d3a11fe32"),
@@ -198,3 +199,60 @@ func TestUnitSecurityDataSource_Validate_Read_Filter_BusinessUnit(t *testing.T)
 		},
 	})
 }
+
+func TestUnitSecurityDataSource_Validate_Read_Filter_Name_And_Managed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mocks.ActivateEnvironmentHttpMocks()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/security_roles/Validate_Read_Filter_Name_And_Managed/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/security_roles/Validate_Read_Filter_Name_And_Managed/get_security_roles.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_security_roles" "contoso" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name_prefix    = "contoso"
+				}
+
+				data "powerplatform_security_roles" "custom" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					is_managed     = false
+				}
+
+				data "powerplatform_security_roles" "contoso_managed" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name_prefix    = "Contoso S"
+					is_managed     = true
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_security_roles.contoso", "security_roles.#", "3"),
+					resource.TestCheckResourceAttr("data.powerplatform_security_roles.contoso", "total_count", "3"),
+					resource.TestCheckResourceAttr("data.powerplatform_security_roles.contoso", "security_roles.0.name", "Contoso Sales"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_security_roles.custom", "security_roles.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_security_roles.custom", "security_roles.0.role_id", "00000000-0000-0000-0000-000000000031"),
+					resource.TestCheckResourceAttr("data.powerplatform_security_roles.custom", "security_roles.1.role_id", "00000000-0000-0000-0000-000000000033"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_security_roles.contoso_managed", "security_roles.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_security_roles.contoso_managed", "total_count", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_security_roles.contoso_managed", "security_roles.0.name", "Contoso Support"),
+					resource.TestCheckResourceAttr("data.powerplatform_security_roles.contoso_managed", "security_roles.0.is_managed", "true"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,270 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var (
+	_ datasource.DataSource              = &TablePermissionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &TablePermissionsDataSource{}
+)
+
+type TablePermissionsDataSource struct {
+	helpers.TypeInfo
+	UserClient client
+}
+
+func NewTablePermissionsDataSource() datasource.DataSource {
+	return &TablePermissionsDataSource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "table_permissions",
+		},
+	}
+}
+
+func (d *TablePermissionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the security roles that grant privileges on a Dataverse table, the depth of each privilege and the teams and users the roles are assigned to, for example to audit who can access the records of the table. For more information see [Security concepts in Microsoft Dataverse](https://learn.microsoft.com/power-platform/admin/wp-security-cds)",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Read: true,
+			}),
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Dataverse environment",
+				Required:            true,
+			},
+			"table_logical_name": schema.StringAttribute{
+				MarkdownDescription: "Logical name of the table, for example `account`",
+				Required:            true,
+			},
+			"permissions": schema.ListNestedAttribute{
+				MarkdownDescription: "Security roles that grant at least one privilege on the table, ordered by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"role_id": schema.StringAttribute{
+							MarkdownDescription: "Security role id",
+							Computed:            true,
+						},
+						"role_name": schema.StringAttribute{
+							MarkdownDescription: "Security role name",
+							Computed:            true,
+						},
+						"business_unit_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the business unit of the security role",
+							Computed:            true,
+						},
+						"privileges": schema.ListNestedAttribute{
+							MarkdownDescription: "Privileges on the table granted by the security role",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"privilege_name": schema.StringAttribute{
+										MarkdownDescription: "Name of the privilege, for example `prvReadAccount`",
+										Computed:            true,
+									},
+									"access_right": schema.StringAttribute{
+										MarkdownDescription: "Access right given by the privilege, for example `Read` or `Write`",
+										Computed:            true,
+									},
+									"depth": schema.StringAttribute{
+										MarkdownDescription: "Depth of the privilege: `Basic`, `Local`, `Deep` or `Global`",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"teams": schema.ListNestedAttribute{
+							MarkdownDescription: "Teams the security role is assigned to. Members of these teams are granted the privileges of the role as well",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"team_id": schema.StringAttribute{
+										MarkdownDescription: "Team id",
+										Computed:            true,
+									},
+									"name": schema.StringAttribute{
+										MarkdownDescription: "Team name",
+										Computed:            true,
+									},
+								},
+							},
+						},
+						"users": schema.ListNestedAttribute{
+							MarkdownDescription: "Users the security role is directly assigned to",
+							Computed:            true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"system_user_id": schema.StringAttribute{
+										MarkdownDescription: "Id of the Dataverse systemuser",
+										Computed:            true,
+									},
+									"full_name": schema.StringAttribute{
+										MarkdownDescription: "Full name of the user",
+										Computed:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *TablePermissionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.UserClient = newUserClient(client.Api)
+}
+
+func (d *TablePermissionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *TablePermissionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var state TablePermissionsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentId := state.EnvironmentId.ValueString()
+	privileges, err := d.UserClient.GetTablePrivileges(ctx, environmentId, state.TableLogicalName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+
+	privilegeIds := make([]string, 0, len(privileges))
+	for _, privilege := range privileges {
+		privilegeIds = append(privilegeIds, privilege.PrivilegeId)
+	}
+	grants, err := d.UserClient.GetRolePrivilegeGrants(ctx, environmentId, privilegeIds)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+
+	grantsByRole := map[string][]rolePrivilegeGrantDto{}
+	for _, grant := range grants {
+		roleId := strings.ToLower(grant.RoleId)
+		grantsByRole[roleId] = append(grantsByRole[roleId], grant)
+	}
+	roleIds := make([]string, 0, len(grantsByRole))
+	for roleId := range grantsByRole {
+		roleIds = append(roleIds, roleId)
+	}
+	roles, err := d.UserClient.GetSecurityRoleAssignees(ctx, environmentId, roleIds)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+
+	state.Permissions = convertFromTablePermissionsDto(privileges, grantsByRole, roles)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// convertFromTablePermissionsDto orders the roles by name, their privileges as the table defines them and their assignees by name, so that the output is stable.
+func convertFromTablePermissionsDto(privileges []tablePrivilegeDto, grantsByRole map[string][]rolePrivilegeGrantDto, roles []securityRoleAssigneesDto) []TablePermissionDataSourceModel {
+	slices.SortFunc(roles, func(a, b securityRoleAssigneesDto) int {
+		return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.RoleId, b.RoleId))
+	})
+
+	permissions := make([]TablePermissionDataSourceModel, 0, len(roles))
+	for _, role := range roles {
+		permission := TablePermissionDataSourceModel{
+			RoleId:         types.StringValue(role.RoleId),
+			RoleName:       types.StringValue(role.Name),
+			BusinessUnitId: types.StringValue(role.BusinessUnitId),
+			Privileges:     []TablePermissionPrivilegeDataSourceModel{},
+			Teams:          []TablePermissionTeamDataSourceModel{},
+			Users:          []TablePermissionUserDataSourceModel{},
+		}
+
+		roleGrants := grantsByRole[strings.ToLower(role.RoleId)]
+		for _, privilege := range privileges {
+			for _, grant := range roleGrants {
+				if !strings.EqualFold(grant.PrivilegeId, privilege.PrivilegeId) {
+					continue
+				}
+				depth, ok := privilegeDepthMasks[grant.DepthMask]
+				if !ok {
+					depth = fmt.Sprintf("%d", grant.DepthMask)
+				}
+				permission.Privileges = append(permission.Privileges, TablePermissionPrivilegeDataSourceModel{
+					PrivilegeName: types.StringValue(privilege.Name),
+					AccessRight:   types.StringValue(privilege.PrivilegeType),
+					Depth:         types.StringValue(depth),
+				})
+			}
+		}
+
+		slices.SortFunc(role.Teams, func(a, b teamDto) int {
+			return cmp.Or(strings.Compare(a.Name, b.Name), strings.Compare(a.Id, b.Id))
+		})
+		for _, team := range role.Teams {
+			permission.Teams = append(permission.Teams, TablePermissionTeamDataSourceModel{
+				TeamId: types.StringValue(team.Id),
+				Name:   types.StringValue(team.Name),
+			})
+		}
+
+		slices.SortFunc(role.Users, func(a, b userDto) int {
+			return cmp.Or(strings.Compare(a.FullName, b.FullName), strings.Compare(a.Id, b.Id))
+		})
+		for _, user := range role.Users {
+			permission.Users = append(permission.Users, TablePermissionUserDataSourceModel{
+				SystemUserId: types.StringValue(user.Id),
+				FullName:     types.StringValue(user.FullName),
+			})
+		}
+		permissions = append(permissions, permission)
+	}
+	return permissions
+}
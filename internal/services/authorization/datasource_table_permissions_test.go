@@ -0,0 +1,142 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccTablePermissionsDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_table_permissions" "account" {
+					environment_id     = powerplatform_environment.env.id
+					table_logical_name = "account"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.powerplatform_table_permissions.account", "permissions.#", regexp.MustCompile(`^[1-9]\d*$`)),
+					resource.TestMatchResourceAttr("data.powerplatform_table_permissions.account", "permissions.0.role_id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_table_permissions.account", "permissions.0.role_name", regexp.MustCompile(helpers.StringRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_table_permissions.account", "permissions.0.privileges.0.privilege_name", regexp.MustCompile(`^prv[A-Za-z]+Account$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitTablePermissionsDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/table_permissions/Validate_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/EntityDefinitions%28LogicalName=%27account%27%29?%24select=LogicalName%2CPrivileges",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/table_permissions/Validate_Read/get_table_account.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roleprivilegescollection?%24filter=Microsoft.Dynamics.CRM.In%28PropertyName%3D%27privilegeid%27%2CPropertyValues%3D%5B%270e48a2b6-4e2a-4b3c-9fd2-9f3d9d1b6d8f%27%2C%27886b280c-6396-4d56-a0a3-2c1b0a50ceb0%27%2C%27d26fe964-230b-42dd-ad93-5cc879de411e%27%5D%29&%24select=roleid%2Cprivilegeid%2Cprivilegedepthmask",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/table_permissions/Validate_Read/get_role_privileges.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles?%24expand=systemuserroles_association%28%24select%3Dsystemuserid%2Cfullname%29%2Cteamroles_association%28%24select%3Dteamid%2Cname%29&%24filter=Microsoft.Dynamics.CRM.In%28PropertyName%3D%27roleid%27%2CPropertyValues%3D%5B%2700000000-0000-0000-0000-000000000030%27%2C%2700000000-0000-0000-0000-000000000031%27%5D%29&%24select=roleid%2Cname%2C_businessunitid_value",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/table_permissions/Validate_Read/get_roles.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_table_permissions" "account" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					table_logical_name = "account"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.#", "2"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.0.role_id", "00000000-0000-0000-0000-000000000030"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.0.role_name", "Basic User"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.0.privileges.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.0.privileges.0.privilege_name", "prvReadAccount"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.0.privileges.0.access_right", "Read"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.0.privileges.0.depth", "Basic"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.0.teams.#", "0"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.0.users.#", "0"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.role_name", "Salesperson"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.business_unit_id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.privileges.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.privileges.0.privilege_name", "prvCreateAccount"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.privileges.0.depth", "Local"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.privileges.1.privilege_name", "prvReadAccount"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.privileges.1.depth", "Global"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.teams.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.teams.0.team_id", "00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.teams.0.name", "Sales"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.users.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.users.0.full_name", "Adele Vance"),
+					resource.TestCheckResourceAttr("data.powerplatform_table_permissions.account", "permissions.1.users.1.system_user_id", "00000000-0000-0000-0000-000000000202"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitTablePermissionsDataSource_Validate_Table_Not_Found(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/table_permissions/Validate_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/EntityDefinitions%28LogicalName=%27missing%27%29?%24select=LogicalName%2CPrivileges",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error":{"code":"0x80060888","message":"Could not find entity"}}`))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_table_permissions" "missing" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					table_logical_name = "missing"
+				}`,
+				ExpectError: regexp.MustCompile(`table 'missing' not found`),
+			},
+		},
+	})
+}
@@ -4,30 +4,162 @@
 package authorization
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 )
 
 type userDto struct {
-	Id             string            `json:"systemuserid"`
-	DomainName     string            `json:"domainname"`
-	FirstName      string            `json:"firstname"`
-	LastName       string            `json:"lastname"`
-	AadObjectId    string            `json:"azureactivedirectoryobjectid"`
-	BusinessUnitId string            `json:"_businessunitid_value"`
-	SecurityRoles  []securityRoleDto `json:"systemuserroles_association,omitempty"`
+	Id             string `json:"systemuserid"`
+	DomainName     string `json:"domainname"`
+	FirstName      string `json:"firstname"`
+	LastName       string `json:"lastname"`
+	FullName       string `json:"fullname,omitempty"`
+	AadObjectId    string `json:"azureactivedirectoryobjectid"`
+	BusinessUnitId string `json:"_businessunitid_value"`
+	ApplicationId  string `json:"applicationid,omitempty"`
+	// ApplicationIdUri is the application id uri of the Entra application of an application user, such as `api://<application_id>`.
+	ApplicationIdUri string            `json:"applicationiduri,omitempty"`
+	IsDisabled       bool              `json:"isdisabled,omitempty"`
+	CreatedOn        string            `json:"createdon,omitempty"`
+	ModifiedOn       string            `json:"modifiedon,omitempty"`
+	SecurityRoles    []securityRoleDto `json:"systemuserroles_association,omitempty"`
 }
 
 type securityRoleDto struct {
-	RoleId         string `json:"roleid"`
-	Name           string `json:"name"`
-	IsManaged      bool   `json:"ismanaged"`
-	BusinessUnitId string `json:"_businessunitid_value"`
+	RoleId           string `json:"roleid"`
+	Name             string `json:"name"`
+	IsManaged        bool   `json:"ismanaged"`
+	BusinessUnitId   string `json:"_businessunitid_value"`
+	ParentRootRoleId string `json:"_parentrootroleid_value,omitempty"`
+	Description      string `json:"description,omitempty"`
 }
 
 type securityRoleArrayDto struct {
 	Value []securityRoleDto `json:"value"`
 }
 
+type securityRoleCreateDto struct {
+	Name         string `json:"name"`
+	Description  string `json:"description,omitempty"`
+	BusinessUnit string `json:"businessunitid@odata.bind"`
+}
+
+type securityRoleUpdateDto struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type rolePrivilegeDto struct {
+	Depth          string `json:"Depth"`
+	PrivilegeId    string `json:"PrivilegeId"`
+	BusinessUnitId string `json:"BusinessUnitId"`
+	PrivilegeName  string `json:"PrivilegeName,omitempty"`
+}
+
+type rolePrivilegeArrayDto struct {
+	RolePrivileges []rolePrivilegeDto `json:"RolePrivileges"`
+}
+
+type privilegeDto struct {
+	Id   string `json:"privilegeid"`
+	Name string `json:"name"`
+}
+
+type privilegeArrayDto struct {
+	Value []privilegeDto `json:"value"`
+}
+
+type teamDto struct {
+	Id             string            `json:"teamid"`
+	Name           string            `json:"name"`
+	Description    string            `json:"description,omitempty"`
+	TeamType       int64             `json:"teamtype"`
+	MembershipType *int64            `json:"membershiptype,omitempty"`
+	EntraGroupId   string            `json:"azureactivedirectoryobjectid,omitempty"`
+	BusinessUnitId string            `json:"_businessunitid_value"`
+	SecurityRoles  []securityRoleDto `json:"teamroles_association,omitempty"`
+}
+
+func (t *teamDto) securityRolesArray() []string {
+	roles := []string{}
+	for _, role := range t.SecurityRoles {
+		roles = append(roles, role.RoleId)
+	}
+	return roles
+}
+
+type teamCreateDto struct {
+	Name           string `json:"name"`
+	Description    string `json:"description,omitempty"`
+	TeamType       int64  `json:"teamtype"`
+	MembershipType *int64 `json:"membershiptype,omitempty"`
+	EntraGroupId   string `json:"azureactivedirectoryobjectid,omitempty"`
+	BusinessUnit   string `json:"businessunitid@odata.bind"`
+}
+
+type teamUpdateDto struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type businessUnitDto struct {
+	Id string `json:"businessunitid"`
+}
+
+type businessUnitArrayDto struct {
+	Value []businessUnitDto `json:"value"`
+}
+
+type tableDto struct {
+	LogicalName string              `json:"LogicalName"`
+	Privileges  []tablePrivilegeDto `json:"Privileges"`
+}
+
+type tablePrivilegeDto struct {
+	PrivilegeId   string `json:"PrivilegeId"`
+	Name          string `json:"Name"`
+	PrivilegeType string `json:"PrivilegeType"`
+}
+
+type rolePrivilegeGrantDto struct {
+	RoleId      string `json:"roleid"`
+	PrivilegeId string `json:"privilegeid"`
+	DepthMask   int64  `json:"privilegedepthmask"`
+}
+
+type rolePrivilegeGrantArrayDto struct {
+	Value []rolePrivilegeGrantDto `json:"value"`
+}
+
+type userPrivilegeDto struct {
+	PrivilegeId    string `json:"PrivilegeId"`
+	PrivilegeName  string `json:"PrivilegeName"`
+	Depth          string `json:"Depth"`
+	BusinessUnitId string `json:"BusinessUnitId"`
+}
+
+type userPrivilegesDto struct {
+	RolePrivileges []userPrivilegeDto `json:"RolePrivileges"`
+}
+
+type securityRoleAssigneesDto struct {
+	RoleId         string    `json:"roleid"`
+	Name           string    `json:"name"`
+	BusinessUnitId string    `json:"_businessunitid_value"`
+	Users          []userDto `json:"systemuserroles_association"`
+	Teams          []teamDto `json:"teamroles_association"`
+}
+
+type securityRoleAssigneesArrayDto struct {
+	Value []securityRoleAssigneesDto `json:"value"`
+}
+
 func (u *userDto) securityRolesArray() []string {
 	if len(u.SecurityRoles) == 0 {
 		return []string{}
@@ -43,6 +175,12 @@ type userArrayDto struct {
 	Value []userDto `json:"value"`
 }
 
+type applicationUserEnvironmentDto struct {
+	EnvironmentId          string
+	EnvironmentDisplayName string
+	User                   userDto
+}
+
 type environmentIdDto struct {
 	Id         string                     `json:"id"`
 	Name       string                     `json:"name"`
@@ -120,3 +258,79 @@ func convertDataverseFromUserDto(userDto *userDto, disableDelete bool) UserResou
 	model.DisableDelete = types.BoolValue(disableDelete)
 	return model
 }
+
+// convertFromApplicationUserDto reports the assigned security roles by the configured role id or name that was used to assign them.
+// Roles of another business unit are assigned as their copy in the business unit of the user, businessUnitRoleIds maps the configured ids to these copies.
+func convertFromApplicationUserDto(model *ApplicationUserResourceModel, userDto *userDto, businessUnitRoleIds map[string]string, profileRoles []string) error {
+	model.Id = types.StringValue(userDto.Id)
+	// Dataverse returns the application id in lower case, keep the configured casing if it is the same id.
+	if !strings.EqualFold(model.ApplicationId.ValueString(), userDto.ApplicationId) {
+		model.ApplicationId = types.StringValue(userDto.ApplicationId)
+	}
+	model.AadId = types.StringValue(userDto.AadObjectId)
+	model.BusinessUnitId = types.StringValue(userDto.BusinessUnitId)
+	model.Name = types.StringValue(userDto.FullName)
+	model.ApplicationIdUri = types.StringValue(userDto.ApplicationIdUri)
+	model.CreatedTime = types.StringValue(userDto.CreatedOn)
+	model.LastModifiedTime = types.StringValue(userDto.ModifiedOn)
+
+	configuredRoles := model.SecurityRoles
+	configuredRoleNames := model.SecurityRolesByName
+	model.SecurityRoles = []string{}
+	model.SecurityRolesByName = []string{}
+	assignedProfileRoles := []string{}
+	for _, role := range userDto.SecurityRoles {
+		assignedByName := false
+		for _, name := range configuredRoleNames {
+			if strings.EqualFold(name, role.Name) {
+				model.SecurityRolesByName = append(model.SecurityRolesByName, name)
+				assignedByName = true
+				break
+			}
+		}
+
+		assignedById := false
+		for _, configuredRole := range configuredRoles {
+			if strings.EqualFold(configuredRole, role.RoleId) || strings.EqualFold(businessUnitRoleIds[configuredRole], role.RoleId) {
+				model.SecurityRoles = append(model.SecurityRoles, configuredRole)
+				assignedById = true
+				break
+			}
+		}
+
+		assignedByProfile := false
+		for _, profileRole := range profileRoles {
+			if strings.EqualFold(profileRole, role.RoleId) || strings.EqualFold(businessUnitRoleIds[profileRole], role.RoleId) {
+				assignedProfileRoles = append(assignedProfileRoles, profileRole)
+				assignedByProfile = true
+				break
+			}
+		}
+
+		if !assignedByName && !assignedById && !assignedByProfile {
+			model.SecurityRoles = append(model.SecurityRoles, role.RoleId)
+		}
+	}
+
+	roleProfileSecurityRoles, err := helpers.StringSliceToSet(assignedProfileRoles)
+	if err != nil {
+		return err
+	}
+	model.RoleProfileSecurityRoles = roleProfileSecurityRoles
+	return nil
+}
+
+// convertFromUserPrivilegesDto summarizes the effective privileges of the application user as their count and a hash,
+// each privilege being counted once per depth whatever the number of roles granting it.
+func convertFromUserPrivilegesDto(model *ApplicationUserResourceModel, privileges []userPrivilegeDto) {
+	grants := make([]string, 0, len(privileges))
+	for _, privilege := range privileges {
+		grants = append(grants, fmt.Sprintf("%s:%s", strings.ToLower(privilege.PrivilegeName), privilege.Depth))
+	}
+	slices.Sort(grants)
+	grants = slices.Compact(grants)
+
+	hash := sha256.Sum256([]byte(strings.Join(grants, "\n")))
+	model.EffectivePrivilegesHash = types.StringValue(hex.EncodeToString(hash[:]))
+	model.EffectivePrivilegeCount = types.Int64Value(int64(len(grants)))
+}
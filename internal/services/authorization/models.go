@@ -5,6 +5,7 @@ package authorization
 
 import (
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 )
@@ -13,6 +14,9 @@ type SecurityRolesListDataSourceModel struct {
 	Timeouts       timeouts.Value                `tfsdk:"timeouts"`
 	EnvironmentId  types.String                  `tfsdk:"environment_id"`
 	BusinessUnitId types.String                  `tfsdk:"business_unit_id"`
+	NamePrefix     types.String                  `tfsdk:"name_prefix"`
+	IsManaged      types.Bool                    `tfsdk:"is_managed"`
+	TotalCount     types.Int64                   `tfsdk:"total_count"`
 	SecurityRoles  []SecurityRoleDataSourceModel `tfsdk:"security_roles"`
 }
 
@@ -23,20 +27,183 @@ type SecurityRoleDataSourceModel struct {
 	BusinessUnitId types.String `tfsdk:"business_unit_id"`
 }
 
+type TablePermissionsDataSourceModel struct {
+	Timeouts         timeouts.Value                   `tfsdk:"timeouts"`
+	EnvironmentId    types.String                     `tfsdk:"environment_id"`
+	TableLogicalName types.String                     `tfsdk:"table_logical_name"`
+	Permissions      []TablePermissionDataSourceModel `tfsdk:"permissions"`
+}
+
+type TablePermissionDataSourceModel struct {
+	RoleId         types.String                              `tfsdk:"role_id"`
+	RoleName       types.String                              `tfsdk:"role_name"`
+	BusinessUnitId types.String                              `tfsdk:"business_unit_id"`
+	Privileges     []TablePermissionPrivilegeDataSourceModel `tfsdk:"privileges"`
+	Teams          []TablePermissionTeamDataSourceModel      `tfsdk:"teams"`
+	Users          []TablePermissionUserDataSourceModel      `tfsdk:"users"`
+}
+
+type TablePermissionPrivilegeDataSourceModel struct {
+	PrivilegeName types.String `tfsdk:"privilege_name"`
+	AccessRight   types.String `tfsdk:"access_right"`
+	Depth         types.String `tfsdk:"depth"`
+}
+
+type TablePermissionTeamDataSourceModel struct {
+	TeamId types.String `tfsdk:"team_id"`
+	Name   types.String `tfsdk:"name"`
+}
+
+type TablePermissionUserDataSourceModel struct {
+	SystemUserId types.String `tfsdk:"system_user_id"`
+	FullName     types.String `tfsdk:"full_name"`
+}
+
+type ApplicationUserEnvironmentsDataSourceModel struct {
+	Timeouts      timeouts.Value                              `tfsdk:"timeouts"`
+	ApplicationId types.String                                `tfsdk:"application_id"`
+	Environments  []ApplicationUserEnvironmentDataSourceModel `tfsdk:"environments"`
+}
+
+type ApplicationUserEnvironmentDataSourceModel struct {
+	EnvironmentId          types.String                  `tfsdk:"environment_id"`
+	EnvironmentDisplayName types.String                  `tfsdk:"environment_display_name"`
+	SystemUserId           types.String                  `tfsdk:"system_user_id"`
+	BusinessUnitId         types.String                  `tfsdk:"business_unit_id"`
+	SecurityRoles          []SecurityRoleDataSourceModel `tfsdk:"security_roles"`
+}
+
+type ApplicationUsersDataSourceModel struct {
+	Timeouts         timeouts.Value                   `tfsdk:"timeouts"`
+	EnvironmentId    types.String                     `tfsdk:"environment_id"`
+	Filter           types.String                     `tfsdk:"filter"`
+	ApplicationUsers []ApplicationUserDataSourceModel `tfsdk:"application_users"`
+}
+
+type ApplicationUserDataSourceModel struct {
+	Id               types.String                  `tfsdk:"id"`
+	ApplicationId    types.String                  `tfsdk:"application_id"`
+	Name             types.String                  `tfsdk:"name"`
+	AadId            types.String                  `tfsdk:"aad_id"`
+	BusinessUnitId   types.String                  `tfsdk:"business_unit_id"`
+	IsDisabled       types.Bool                    `tfsdk:"is_disabled"`
+	SecurityRoles    []SecurityRoleDataSourceModel `tfsdk:"security_roles"`
+	ApplicationIdUri types.String                  `tfsdk:"application_id_uri"`
+	CreatedTime      types.String                  `tfsdk:"created_time"`
+	LastModifiedTime types.String                  `tfsdk:"last_modified_time"`
+}
+
 type UserResource struct {
 	helpers.TypeInfo
 	UserClient client
 }
 
 type UserResourceModel struct {
-	Timeouts          timeouts.Value `tfsdk:"timeouts"`
-	Id                types.String   `tfsdk:"id"`
-	EnvironmentId     types.String   `tfsdk:"environment_id"`
-	AadId             types.String   `tfsdk:"aad_id"`
-	BusinessUnitId    types.String   `tfsdk:"business_unit_id"`
-	SecurityRoles     []string       `tfsdk:"security_roles"`
-	UserPrincipalName types.String   `tfsdk:"user_principal_name"`
-	FirstName         types.String   `tfsdk:"first_name"`
-	LastName          types.String   `tfsdk:"last_name"`
-	DisableDelete     types.Bool     `tfsdk:"disable_delete"`
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Id                 types.String   `tfsdk:"id"`
+	EnvironmentId      types.String   `tfsdk:"environment_id"`
+	AadId              types.String   `tfsdk:"aad_id"`
+	BusinessUnitId     types.String   `tfsdk:"business_unit_id"`
+	SecurityRoles      []string       `tfsdk:"security_roles"`
+	UserPrincipalName  types.String   `tfsdk:"user_principal_name"`
+	FirstName          types.String   `tfsdk:"first_name"`
+	LastName           types.String   `tfsdk:"last_name"`
+	DisableDelete      types.Bool     `tfsdk:"disable_delete"`
+	LicenseWaitTimeout types.String   `tfsdk:"license_wait_timeout"`
+}
+
+type ApplicationUserResource struct {
+	helpers.TypeInfo
+	UserClient client
+}
+
+type ApplicationUserResourceModel struct {
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+	Id                       types.String   `tfsdk:"id"`
+	EnvironmentId            types.String   `tfsdk:"environment_id"`
+	ApplicationId            types.String   `tfsdk:"application_id"`
+	AadId                    types.String   `tfsdk:"aad_id"`
+	BusinessUnitId           types.String   `tfsdk:"business_unit_id"`
+	SecurityRoles            []string       `tfsdk:"security_roles"`
+	SecurityRolesByName      []string       `tfsdk:"security_roles_by_name"`
+	RoleProfile              types.String   `tfsdk:"role_profile"`
+	RoleProfileSecurityRoles types.Set      `tfsdk:"role_profile_security_roles"`
+	EffectivePrivilegesHash  types.String   `tfsdk:"effective_privileges_hash"`
+	EffectivePrivilegeCount  types.Int64    `tfsdk:"effective_privilege_count"`
+	Name                     types.String   `tfsdk:"name"`
+	ApplicationIdUri         types.String   `tfsdk:"application_id_uri"`
+	CreatedTime              types.String   `tfsdk:"created_time"`
+	LastModifiedTime         types.String   `tfsdk:"last_modified_time"`
+}
+
+type SecurityRoleResource struct {
+	helpers.TypeInfo
+	SecurityRoleClient client
+}
+
+type SecurityRoleResourceModel struct {
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	Id             types.String   `tfsdk:"id"`
+	EnvironmentId  types.String   `tfsdk:"environment_id"`
+	Name           types.String   `tfsdk:"name"`
+	Description    types.String   `tfsdk:"description"`
+	BusinessUnitId types.String   `tfsdk:"business_unit_id"`
+	CopyFromRoleId types.String   `tfsdk:"copy_from_role_id"`
+	Privileges     types.Set      `tfsdk:"privileges"`
+}
+
+type SecurityRolePrivilegeModel struct {
+	PrivilegeName types.String `tfsdk:"privilege_name"`
+	Depth         types.String `tfsdk:"depth"`
+}
+
+var securityRolePrivilegeObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"privilege_name": types.StringType,
+		"depth":          types.StringType,
+	},
+}
+
+type TeamResource struct {
+	helpers.TypeInfo
+	TeamClient client
+}
+
+type TeamResourceModel struct {
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	Id             types.String   `tfsdk:"id"`
+	EnvironmentId  types.String   `tfsdk:"environment_id"`
+	Name           types.String   `tfsdk:"name"`
+	Description    types.String   `tfsdk:"description"`
+	TeamType       types.String   `tfsdk:"team_type"`
+	BusinessUnitId types.String   `tfsdk:"business_unit_id"`
+	EntraGroupId   types.String   `tfsdk:"entra_group_id"`
+	MembershipType types.String   `tfsdk:"membership_type"`
+	SecurityRoles  []string       `tfsdk:"security_roles"`
+}
+
+type TeamMembershipResource struct {
+	helpers.TypeInfo
+	TeamClient client
+}
+
+type TeamMembershipResourceModel struct {
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	Id            types.String   `tfsdk:"id"`
+	EnvironmentId types.String   `tfsdk:"environment_id"`
+	TeamId        types.String   `tfsdk:"team_id"`
+	SystemUserIds []string       `tfsdk:"system_user_ids"`
+}
+
+type AppModuleRoleAssignmentResource struct {
+	helpers.TypeInfo
+	AppModuleClient client
+}
+
+type AppModuleRoleAssignmentResourceModel struct {
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	Id              types.String   `tfsdk:"id"`
+	EnvironmentId   types.String   `tfsdk:"environment_id"`
+	AppModuleId     types.String   `tfsdk:"app_module_id"`
+	SecurityRoleIds []string       `tfsdk:"security_role_ids"`
 }
@@ -0,0 +1,291 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &AppModuleRoleAssignmentResource{}
+var _ resource.ResourceWithImportState = &AppModuleRoleAssignmentResource{}
+
+func NewAppModuleRoleAssignmentResource() resource.Resource {
+	return &AppModuleRoleAssignmentResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "app_module_role_assignment",
+		},
+	}
+}
+
+func (r *AppModuleRoleAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *AppModuleRoleAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource associates security roles with a model-driven app, so that only the users having one of the roles can see the app. Only the configured roles are managed, other roles of the app, such as the ones associated by the maker of the app or by another configuration, are left untouched.\n\n" +
+			"Additional Resources:\n\n" +
+			"* [Manage access to model-driven apps](https://learn.microsoft.com/power-apps/maker/model-driven-apps/share-model-driven-app)",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the role assignment, same as `app_module_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Unique environment id (guid)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_module_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the app module of the model-driven app (`appmoduleid`)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "app_module_id must be a valid guid"),
+				},
+			},
+			"security_role_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids of the security roles of the root business unit that can see the app, for example the `role_id` of a `powerplatform_security_roles` data source or the `id` of a `powerplatform_security_role`",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "security_role_ids must be valid guids")),
+				},
+			},
+		},
+	}
+}
+
+func (r *AppModuleRoleAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.AppModuleClient = newUserClient(client.Api)
+}
+
+func (r *AppModuleRoleAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *AppModuleRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleIds, err := r.changeRoles(ctx, plan.EnvironmentId.ValueString(), plan.AppModuleId.ValueString(), plan.SecurityRoleIds, []string{})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromAppModuleRoleIds(plan, roleIds)
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", plan.Id.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AppModuleRoleAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *AppModuleRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roleIds, err := r.AppModuleClient.GetAppModuleRoleIds(ctx, state.EnvironmentId.ValueString(), state.AppModuleId.ValueString())
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromAppModuleRoleIds(state, roleIds)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s with id %s", r.FullTypeName(), state.Id.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *AppModuleRoleAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *AppModuleRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *AppModuleRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	removedRoleIds := []string{}
+	for _, roleId := range state.SecurityRoleIds {
+		if !slices.ContainsFunc(plan.SecurityRoleIds, func(id string) bool { return strings.EqualFold(id, roleId) }) {
+			removedRoleIds = append(removedRoleIds, roleId)
+		}
+	}
+
+	roleIds, err := r.changeRoles(ctx, state.EnvironmentId.ValueString(), state.AppModuleId.ValueString(), plan.SecurityRoleIds, removedRoleIds)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromAppModuleRoleIds(plan, roleIds)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AppModuleRoleAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *AppModuleRoleAssignmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.changeRoles(ctx, state.EnvironmentId.ValueString(), state.AppModuleId.ValueString(), []string{}, state.SecurityRoleIds)
+	if err != nil {
+		if customerrors.Code(err) == customerrors.ERROR_OBJECT_NOT_FOUND {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.FullTypeName()))
+}
+
+// ImportState imports the roles of an app module by its environment and app module id, separated by a slash: `<environment_id>/<app_module_id>`.
+// All the current roles of the app module are imported.
+func (r *AppModuleRoleAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	environmentId, appModuleId, found := strings.Cut(req.ID, "/")
+	if !found || environmentId == "" || appModuleId == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>/<app_module_id>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_module_id"), appModuleId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), appModuleId)...)
+}
+
+// changeRoles associates the wanted roles that are not associated with the app module yet and removes the given roles that still are.
+// It returns the roles of the app module after the change.
+func (r *AppModuleRoleAssignmentResource) changeRoles(ctx context.Context, environmentId, appModuleId string, wantedRoleIds, removedRoleIds []string) ([]string, error) {
+	roleIds, err := r.AppModuleClient.GetAppModuleRoleIds(ctx, environmentId, appModuleId)
+	if err != nil {
+		return nil, err
+	}
+	isAssigned := func(roleId string) bool {
+		return slices.ContainsFunc(roleIds, func(id string) bool { return strings.EqualFold(id, roleId) })
+	}
+
+	addedRoleIds := []string{}
+	for _, roleId := range wantedRoleIds {
+		if !isAssigned(roleId) {
+			addedRoleIds = append(addedRoleIds, roleId)
+		}
+	}
+	leavingRoleIds := []string{}
+	for _, roleId := range removedRoleIds {
+		if isAssigned(roleId) {
+			leavingRoleIds = append(leavingRoleIds, roleId)
+		}
+	}
+	if len(addedRoleIds) == 0 && len(leavingRoleIds) == 0 {
+		return roleIds, nil
+	}
+
+	if err := r.AppModuleClient.AddAppModuleRoles(ctx, environmentId, appModuleId, addedRoleIds); err != nil {
+		return nil, err
+	}
+	if err := r.AppModuleClient.RemoveAppModuleRoles(ctx, environmentId, appModuleId, leavingRoleIds); err != nil {
+		return nil, err
+	}
+	return r.AppModuleClient.GetAppModuleRoleIds(ctx, environmentId, appModuleId)
+}
+
+// convertFromAppModuleRoleIds reports the configured roles that are associated with the app module, or all its roles when nothing is configured yet, such as after an import.
+func convertFromAppModuleRoleIds(model *AppModuleRoleAssignmentResourceModel, roleIds []string) {
+	model.Id = model.AppModuleId
+
+	if model.SecurityRoleIds == nil {
+		model.SecurityRoleIds = roleIds
+		return
+	}
+
+	configuredRoleIds := model.SecurityRoleIds
+	model.SecurityRoleIds = []string{}
+	for _, roleId := range configuredRoleIds {
+		if slices.ContainsFunc(roleIds, func(id string) bool { return strings.EqualFold(id, roleId) }) {
+			model.SecurityRoleIds = append(model.SecurityRoleIds, roleId)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccAppModuleRoleAssignmentResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_security_roles" "all" {
+					environment_id = powerplatform_environment.env.id
+				}
+
+				data "powerplatform_data_records" "apps" {
+					environment_id    = powerplatform_environment.env.id
+					entity_collection = "appmodules"
+					select            = ["appmoduleid", "uniquename"]
+					top               = 1
+				}
+
+				resource "powerplatform_app_module_role_assignment" "roles" {
+					environment_id    = powerplatform_environment.env.id
+					app_module_id     = data.powerplatform_data_records.apps.rows[0].appmoduleid
+					security_role_ids = [
+						one([for role in data.powerplatform_security_roles.all.security_roles : role.role_id if role.name == "Basic User"]),
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("powerplatform_app_module_role_assignment.roles", "id", "data.powerplatform_data_records.apps", "rows.0.appmoduleid"),
+					resource.TestCheckResourceAttr("powerplatform_app_module_role_assignment.roles", "security_role_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitAppModuleRoleAssignmentResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// the role associated by the maker of the app is not managed by the resource.
+	roles := []string{"00000000-0000-0000-0000-000000000200"}
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/team/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/appmodules%2800000000-0000-0000-0000-000000000100%29/appmoduleroles_association?%24select=roleid",
+		func(req *http.Request) (*http.Response, error) {
+			value := []map[string]string{}
+			for _, role := range roles {
+				value = append(value, map[string]string{"roleid": role})
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"value": value})
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/appmodules%2800000000-0000-0000-0000-000000000100%29/appmoduleroles_association/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string]string{}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			roleId := strings.TrimSuffix(strings.TrimPrefix(body["@odata.id"], "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles("), ")")
+			roles = append(roles, roleId)
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterRegexpResponder("DELETE", regexp.MustCompile(`^https://00000000-0000-0000-0000-000000000001\.crm4\.dynamics\.com/api/data/v9\.2/appmodules%2800000000-0000-0000-0000-000000000100%29/appmoduleroles_association/\$ref\?%24id=.*roles%28([\d-]+)%29$`),
+		func(req *http.Request) (*http.Response, error) {
+			roleId := httpmock.MustGetSubmatch(req, 1)
+			roles = slices.DeleteFunc(roles, func(id string) bool { return id == roleId })
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_app_module_role_assignment" "roles" {
+					environment_id    = "00000000-0000-0000-0000-000000000001"
+					app_module_id     = "00000000-0000-0000-0000-000000000100"
+					security_role_ids = ["00000000-0000-0000-0000-000000000201", "00000000-0000-0000-0000-000000000202"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_app_module_role_assignment.roles", "id", "00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("powerplatform_app_module_role_assignment.roles", "security_role_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_app_module_role_assignment.roles", "security_role_ids.*", "00000000-0000-0000-0000-000000000201"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_app_module_role_assignment.roles", "security_role_ids.*", "00000000-0000-0000-0000-000000000202"),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_app_module_role_assignment" "roles" {
+					environment_id    = "00000000-0000-0000-0000-000000000001"
+					app_module_id     = "00000000-0000-0000-0000-000000000100"
+					security_role_ids = ["00000000-0000-0000-0000-000000000202"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_app_module_role_assignment.roles", "security_role_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_app_module_role_assignment.roles", "security_role_ids.*", "00000000-0000-0000-0000-000000000202"),
+					func(s *terraform.State) error {
+						if !slices.Equal(roles, []string{"00000000-0000-0000-0000-000000000200", "00000000-0000-0000-0000-000000000202"}) {
+							return fmt.Errorf("unexpected app module roles %v", roles)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// a role removed from the app outside of terraform is associated again.
+				PreConfig: func() {
+					roles = slices.DeleteFunc(roles, func(id string) bool { return id == "00000000-0000-0000-0000-000000000202" })
+				},
+				Config: `
+				resource "powerplatform_app_module_role_assignment" "roles" {
+					environment_id    = "00000000-0000-0000-0000-000000000001"
+					app_module_id     = "00000000-0000-0000-0000-000000000100"
+					security_role_ids = ["00000000-0000-0000-0000-000000000202"]
+				}`,
+				Check: resource.TestCheckResourceAttr("powerplatform_app_module_role_assignment.roles", "security_role_ids.#", "1"),
+			},
+		},
+	})
+
+	if !slices.Equal(roles, []string{"00000000-0000-0000-0000-000000000200"}) {
+		t.Errorf("expected only the unmanaged role to remain, got %v", roles)
+	}
+}
+
+func TestUnitAppModuleRoleAssignmentResource_Validate_App_Module_Not_Found(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/team/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/appmodules%2800000000-0000-0000-0000-000000000100%29/appmoduleroles_association?%24select=roleid",
+		httpmock.NewStringResponder(http.StatusNotFound, `{"error":{"code":"0x80040217","message":"appmodule With Id = 00000000-0000-0000-0000-000000000100 Does Not Exist"}}`))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_app_module_role_assignment" "roles" {
+					environment_id    = "00000000-0000-0000-0000-000000000001"
+					app_module_id     = "00000000-0000-0000-0000-000000000100"
+					security_role_ids = ["00000000-0000-0000-0000-000000000201"]
+				}`,
+				ExpectError: regexp.MustCompile(`app module '00000000-0000-0000-0000-000000000100' not found`),
+			},
+		},
+	})
+}
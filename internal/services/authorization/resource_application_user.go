@@ -0,0 +1,525 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers/array"
+	"github.com/microsoft/terraform-provider-power-platform/internal/modifiers"
+)
+
+var _ resource.Resource = &ApplicationUserResource{}
+var _ resource.ResourceWithModifyPlan = &ApplicationUserResource{}
+var _ resource.ResourceWithImportState = &ApplicationUserResource{}
+
+func NewApplicationUserResource() resource.Resource {
+	return &ApplicationUserResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "application_user",
+		},
+	}
+}
+
+func (r *ApplicationUserResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *ApplicationUserResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource creates an application user for an Entra application registration in a Dataverse environment. The application registration is looked up by its application (client) id, there is no need to know the id of its service principal.\n\n" +
+			"Additional Resources:\n\n" +
+			"* [Manage application users](https://learn.microsoft.com/power-platform/admin/manage-application-users)",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique id (guid) of the Dataverse systemuser of the application user",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Unique environment id (guid)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application (client) id of the Entra application registration",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "application_id must be a valid application (client) id guid"),
+				},
+			},
+			"aad_id": schema.StringAttribute{
+				MarkdownDescription: "Object id of the Entra service principal of the application",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"business_unit_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the business unit to which the application user belongs. When not set, the application user is created in the root business unit. Changing the business unit assigns the security roles again in the new business unit",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.UseStateForServerDefaultString(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "business_unit_id must be a valid guid"),
+				},
+			},
+			"security_roles": schema.SetAttribute{
+				MarkdownDescription: "Security roles Ids assigned to the application user. Roles of another business unit are assigned as their copy in the business unit of the application user",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"security_roles_by_name": schema.SetAttribute{
+				MarkdownDescription: "Names of the security roles assigned to the application user, as an alternative to `security_roles` since role ids differ between environments. The names are resolved to the roles of the business unit of the application user and must identify a single role",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"role_profile": schema.StringAttribute{
+				MarkdownDescription: "Name of a profile of the provider `role_profiles` whose security roles are assigned to the application user, in addition to `security_roles` and `security_roles_by_name`",
+				Optional:            true,
+			},
+			"role_profile_security_roles": schema.SetAttribute{
+				MarkdownDescription: "Security roles Ids of `role_profile` assigned to the application user. A role of the profile that is unassigned outside of Terraform, or a change of the profile in the provider configuration, shows as a change of this attribute",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+			"effective_privileges_hash": schema.StringAttribute{
+				MarkdownDescription: "SHA-256 hash of the privileges, with their depth, that the application user is granted by its security roles and the roles of its teams. The hash changes when roles or their privileges are changed outside of Terraform, even when `security_roles` stays the same",
+				Computed:            true,
+			},
+			"effective_privilege_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of distinct privileges, with their depth, that the application user is granted",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the application user, which Dataverse takes from the Entra application registration",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id_uri": schema.StringAttribute{
+				MarkdownDescription: "Application id uri of the Entra application registration, empty when the application doesn't expose an api",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_time": schema.StringAttribute{
+				MarkdownDescription: "Date and time the application user was created in the environment",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_modified_time": schema.StringAttribute{
+				MarkdownDescription: "Date and time the application user was last modified, for example when its business unit changed",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *ApplicationUserResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.UserClient = newUserClient(client.Api)
+}
+
+func (r *ApplicationUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *ApplicationUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.UserClient.CreateApplicationUser(ctx, plan.EnvironmentId.ValueString(), plan.ApplicationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	// keep track of the application user before assigning the roles, so that it gets destroyed if the assignment fails.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), user.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), plan.EnvironmentId.ValueString())...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("application_id"), plan.ApplicationId.ValueString())...)
+
+	if !plan.BusinessUnitId.IsUnknown() && !strings.EqualFold(plan.BusinessUnitId.ValueString(), user.BusinessUnitId) {
+		user, err = r.UserClient.UpdateApplicationUserBusinessUnit(ctx, plan.EnvironmentId.ValueString(), user.Id, plan.BusinessUnitId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	profileRoles, err := r.getRoleProfileSecurityRoles(plan.RoleProfile)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	user, businessUnitRoleIds, err := r.assignSecurityRoles(ctx, plan.EnvironmentId.ValueString(), user, array.Union(plan.SecurityRoles, profileRoles), plan.SecurityRolesByName)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	if err := convertFromApplicationUserDto(plan, user, businessUnitRoleIds, profileRoles); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error when converting %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	privileges, err := r.UserClient.GetUserPrivileges(ctx, plan.EnvironmentId.ValueString(), user.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+	convertFromUserPrivilegesDto(plan, privileges)
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", plan.Id.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ApplicationUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *ApplicationUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var user *userDto
+	var err error
+	if state.ApplicationId.IsNull() {
+		// an imported application user is identified by its system user id until its application id is read.
+		user, err = r.UserClient.GetDataverseUserBySystemUserId(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+		if err == nil && user.ApplicationId == "" {
+			err = fmt.Errorf("system user '%s' is not an application user", state.Id.ValueString())
+		}
+	} else {
+		user, err = r.UserClient.GetApplicationUser(ctx, state.EnvironmentId.ValueString(), state.ApplicationId.ValueString())
+	}
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	// roles of another business unit are assigned as their copy in the business unit of the user, look up these copies to report them by their configured id.
+	profileRoles := helpers.SetToStringSlice(state.RoleProfileSecurityRoles)
+	businessUnitRoleIds := map[string]string{}
+	if _, unassignedSecurityRoles := array.Diff(user.securityRolesArray(), array.Union(state.SecurityRoles, profileRoles)); len(unassignedSecurityRoles) > 0 && len(user.SecurityRoles) > 0 {
+		businessUnitRoleIds, err = r.UserClient.GetBusinessUnitSecurityRoleIds(ctx, state.EnvironmentId.ValueString(), user.BusinessUnitId, unassignedSecurityRoles)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	if err := convertFromApplicationUserDto(state, user, businessUnitRoleIds, profileRoles); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error when converting %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	privileges, err := r.UserClient.GetUserPrivileges(ctx, state.EnvironmentId.ValueString(), user.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+	convertFromUserPrivilegesDto(state, privileges)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s with id %s", r.FullTypeName(), state.Id.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *ApplicationUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *ApplicationUserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *ApplicationUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := r.UserClient.GetApplicationUser(ctx, state.EnvironmentId.ValueString(), state.ApplicationId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	if !plan.BusinessUnitId.IsUnknown() && !strings.EqualFold(plan.BusinessUnitId.ValueString(), user.BusinessUnitId) {
+		user, err = r.UserClient.UpdateApplicationUserBusinessUnit(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString(), plan.BusinessUnitId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating business unit %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	profileRoles, err := r.getRoleProfileSecurityRoles(plan.RoleProfile)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating security roles %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	user, businessUnitRoleIds, err := r.assignSecurityRoles(ctx, state.EnvironmentId.ValueString(), user, array.Union(plan.SecurityRoles, profileRoles), plan.SecurityRolesByName)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating security roles %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	if err := convertFromApplicationUserDto(plan, user, businessUnitRoleIds, profileRoles); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error when converting %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	privileges, err := r.UserClient.GetUserPrivileges(ctx, state.EnvironmentId.ValueString(), user.Id)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+	convertFromUserPrivilegesDto(plan, privileges)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// assignSecurityRoles assigns the given security roles, by id or by name, to the application user in its current business unit, removing any other role.
+// It returns the updated user along with the ids of the roles in the business unit of the user.
+func (r *ApplicationUserResource) assignSecurityRoles(ctx context.Context, environmentId string, user *userDto, securityRoles, securityRoleNames []string) (*userDto, map[string]string, error) {
+	businessUnitRoleIds := map[string]string{}
+	if len(securityRoles) > 0 {
+		var err error
+		businessUnitRoleIds, err = r.UserClient.GetBusinessUnitSecurityRoleIds(ctx, environmentId, user.BusinessUnitId, securityRoles)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	wantedSecurityRoles := make([]string, 0, len(securityRoles)+len(securityRoleNames))
+	for _, role := range securityRoles {
+		wantedSecurityRoles = append(wantedSecurityRoles, businessUnitRoleIds[role])
+	}
+	if len(securityRoleNames) > 0 {
+		roleIds, err := r.UserClient.GetSecurityRoleIdsByName(ctx, environmentId, user.BusinessUnitId, securityRoleNames)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, roleId := range roleIds {
+			if !slices.Contains(wantedSecurityRoles, roleId) {
+				wantedSecurityRoles = append(wantedSecurityRoles, roleId)
+			}
+		}
+	}
+
+	addedSecurityRoles, removedSecurityRoles := array.Diff(wantedSecurityRoles, user.securityRolesArray())
+	var err error
+	if len(addedSecurityRoles) > 0 {
+		user, err = r.UserClient.AddDataverseSecurityRoles(ctx, environmentId, user.Id, addedSecurityRoles)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(removedSecurityRoles) > 0 {
+		user, err = r.UserClient.RemoveDataverseSecurityRoles(ctx, environmentId, user.Id, removedSecurityRoles)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return user, businessUnitRoleIds, nil
+}
+
+// getRoleProfileSecurityRoles expands the role profile of the application user into the security role ids of the profile in the provider configuration.
+func (r *ApplicationUserResource) getRoleProfileSecurityRoles(roleProfile types.String) ([]string, error) {
+	if roleProfile.IsNull() || roleProfile.IsUnknown() {
+		return []string{}, nil
+	}
+	roleIds, ok := r.UserClient.Api.GetConfig().RoleProfiles[roleProfile.ValueString()]
+	if !ok {
+		return nil, fmt.Errorf("role profile '%s' is not defined in the role_profiles of the provider configuration", roleProfile.ValueString())
+	}
+	return roleIds, nil
+}
+
+// ModifyPlan expands the role profile of the application user into its security roles and warns about the security roles
+// that the next apply adds to or removes from the application user, as the plan of a large set of roles only shows the whole set being replaced.
+func (r *ApplicationUserResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var roleProfile types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("role_profile"), &roleProfile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !roleProfile.IsUnknown() && r.UserClient.Api != nil {
+		profileRoles, err := r.getRoleProfileSecurityRoles(roleProfile)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("role_profile"), "Unknown role profile", err.Error())
+			return
+		}
+		profileRolesSet, err := helpers.StringSliceToSet(profileRoles)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error when converting %s", r.FullTypeName()), err.Error())
+			return
+		}
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("role_profile_security_roles"), profileRolesSet)...)
+
+		if !req.State.Raw.IsNull() {
+			var stateProfileRoles types.Set
+			resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("role_profile_security_roles"), &stateProfileRoles)...)
+			if !stateProfileRoles.Equal(profileRolesSet) {
+				// the roles of the profile are assigned again, which changes the effective privileges of the application user.
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_privileges_hash"), types.StringUnknown())...)
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("effective_privilege_count"), types.Int64Unknown())...)
+			}
+		}
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	changes := []string{}
+	for _, attribute := range []string{"security_roles", "security_roles_by_name"} {
+		var planRoles, stateRoles types.Set
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root(attribute), &planRoles)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root(attribute), &stateRoles)...)
+		if resp.Diagnostics.HasError() || planRoles.IsUnknown() || stateRoles.IsUnknown() {
+			return
+		}
+
+		var planned, current []string
+		resp.Diagnostics.Append(planRoles.ElementsAs(ctx, &planned, false)...)
+		resp.Diagnostics.Append(stateRoles.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		added, removed := array.Diff(planned, current)
+		slices.Sort(added)
+		slices.Sort(removed)
+		for _, role := range added {
+			changes = append(changes, fmt.Sprintf("+ %s: %s", attribute, role))
+		}
+		for _, role := range removed {
+			changes = append(changes, fmt.Sprintf("- %s: %s", attribute, role))
+		}
+	}
+
+	if len(changes) > 0 {
+		resp.Diagnostics.AddWarning(
+			fmt.Sprintf("Security roles of %s will change", r.FullTypeName()),
+			fmt.Sprintf("The following security roles will be added (+) or removed (-) on the next apply:\n%s", strings.Join(changes, "\n")),
+		)
+	}
+}
+
+func (r *ApplicationUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *ApplicationUserResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.UserClient.DeleteDataverseUser(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.FullTypeName()))
+}
+
+func (r *ApplicationUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	guidRegex := regexp.MustCompile(helpers.GuidRegex)
+	environmentId, systemUserId, found := strings.Cut(req.ID, "/")
+	if !found || !guidRegex.MatchString(environmentId) || !guidRegex.MatchString(systemUserId) {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>/<systemuserid>, where both parts are guids. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), systemUserId)...)
+}
@@ -0,0 +1,829 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/authorization"
+)
+
+func TestAccApplicationUserResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		ExternalProviders: map[string]resource.ExternalProvider{
+			"azuread": {
+				VersionConstraint: constants.AZURE_AD_PROVIDER_VERSION_CONSTRAINT,
+				Source:            "hashicorp/azuread",
+			},
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "azuread_application_registration" "app" {
+					display_name = "` + mocks.TestName() + `"
+				}
+
+				resource "azuread_service_principal" "sp" {
+					client_id = azuread_application_registration.app.client_id
+				}
+
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_security_roles" "all" {
+					environment_id = powerplatform_environment.env.id
+				}
+
+				resource "powerplatform_application_user" "app_user" {
+					environment_id = powerplatform_environment.env.id
+					application_id = azuread_service_principal.sp.client_id
+					security_roles = [
+						one([for role in data.powerplatform_security_roles.all.security_roles : role.role_id if role.name == "Basic User"]),
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_application_user.app_user", "id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_application_user.app_user", "aad_id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_application_user.app_user", "business_unit_id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestCheckResourceAttrPair("powerplatform_application_user.app_user", "application_id", "azuread_service_principal.sp", "client_id"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "security_roles.#", "1"),
+					resource.TestMatchResourceAttr("powerplatform_application_user.app_user", "effective_privileges_hash", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+		},
+	})
+}
+
+// registerUserPrivilegesMock returns the privileges of the application user, every security role granting prvReadAccount and a privilege of its own.
+func registerUserPrivilegesMock(roles *[]string, extraPrivileges *[]string) {
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29/Microsoft.Dynamics.CRM.RetrieveUserPrivileges%28%29",
+		func(req *http.Request) (*http.Response, error) {
+			privileges := []map[string]any{}
+			for _, role := range *roles {
+				privileges = append(privileges,
+					map[string]any{"PrivilegeName": "prvReadAccount", "Depth": "Global", "BusinessUnitId": "00000000-0000-0000-0000-000000000020"},
+					map[string]any{"PrivilegeName": "prvRole" + role, "Depth": "Basic", "BusinessUnitId": "00000000-0000-0000-0000-000000000020"})
+			}
+			if extraPrivileges != nil {
+				for _, privilege := range *extraPrivileges {
+					privileges = append(privileges, map[string]any{"PrivilegeName": privilege, "Depth": "Global", "BusinessUnitId": "00000000-0000-0000-0000-000000000020"})
+				}
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"RolePrivileges": privileges})
+		})
+}
+
+func TestUnitApplicationUserResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	created := false
+	deleted := false
+	roles := []string{}
+	extraPrivileges := []string{}
+	effectivePrivilegesHash := ""
+
+	applicationUser := func() string {
+		securityRoles := []map[string]any{}
+		for _, role := range roles {
+			securityRoles = append(securityRoles, map[string]any{
+				"roleid":                role,
+				"name":                  "Role " + role,
+				"ismanaged":             true,
+				"_businessunitid_value": "00000000-0000-0000-0000-000000000020",
+			})
+		}
+		securityRolesJson, _ := json.Marshal(securityRoles)
+		user := strings.ReplaceAll(httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_application_users.json").String(), "{{security_roles}}", string(securityRolesJson))
+		return strings.ReplaceAll(user, "{{business_unit_id}}", "00000000-0000-0000-0000-000000000020")
+	}
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/addAppUser?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"servicePrincipalAppId":"00000000-0000-0000-0000-000000000100"`) {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			created = true
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24filter=applicationid+eq+00000000-0000-0000-0000-000000000100",
+		func(req *http.Request) (*http.Response, error) {
+			if !created || deleted {
+				return httpmock.NewStringResponse(http.StatusOK, `{"value":[]}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, applicationUser()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29",
+		func(req *http.Request) (*http.Response, error) {
+			users := map[string][]json.RawMessage{}
+			_ = json.Unmarshal([]byte(applicationUser()), &users)
+			return httpmock.NewBytesResponse(http.StatusOK, users["value"][0]), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29/systemuserroles_association/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			role := map[string]string{}
+			_ = json.NewDecoder(req.Body).Decode(&role)
+			roleId := strings.TrimSuffix(strings.TrimPrefix(role["@odata.id"], "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles("), ")")
+			roles = append(roles, roleId)
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterRegexpResponder("DELETE", regexp.MustCompile(`^https://00000000-0000-0000-0000-000000000001\.crm4\.dynamics\.com/api/data/v9\.2/systemusers%2800000000-0000-0000-0000-000000000010%29/systemuserroles_association/\$ref\?%24id=.*roles%28(.+)%29$`),
+		func(req *http.Request) (*http.Response, error) {
+			roleId := httpmock.MustGetSubmatch(req, 1)
+			roles = slices.DeleteFunc(roles, func(role string) bool { return role == roleId })
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_security_roles.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29",
+		func(req *http.Request) (*http.Response, error) {
+			deleted = true
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29?%24select=systemuserid%2Cisdisabled",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+		})
+
+	registerUserPrivilegesMock(&roles, &extraPrivileges)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if !deleted {
+				return errors.New("application user was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_application_user" "app_user" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					application_id = "00000000-0000-0000-0000-000000000100"
+					security_roles = ["00000000-0000-0000-0000-000000000030"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "environment_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "application_id", "00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "aad_id", "00000000-0000-0000-0000-000000000200"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "business_unit_id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "security_roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_application_user.app_user", "security_roles.*", "00000000-0000-0000-0000-000000000030"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "name", "# automation"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "application_id_uri", "api://00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "created_time", "2026-01-05T08:30:00Z"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "last_modified_time", "2026-02-10T16:45:00Z"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "effective_privilege_count", "2"),
+					resource.TestCheckResourceAttrWith("powerplatform_application_user.app_user", "effective_privileges_hash", func(value string) error {
+						effectivePrivilegesHash = value
+						return nil
+					}),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_application_user" "app_user" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					application_id = "00000000-0000-0000-0000-000000000100"
+					security_roles = ["00000000-0000-0000-0000-000000000031"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "security_roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_application_user.app_user", "security_roles.*", "00000000-0000-0000-0000-000000000031"),
+					func(_ *terraform.State) error {
+						if len(roles) != 1 || roles[0] != "00000000-0000-0000-0000-000000000031" {
+							return fmt.Errorf("unexpected security roles assigned: %v", roles)
+						}
+						return nil
+					},
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "effective_privilege_count", "2"),
+					resource.TestCheckResourceAttrWith("powerplatform_application_user.app_user", "effective_privileges_hash", func(value string) error {
+						if value == effectivePrivilegesHash {
+							return errors.New("expected the effective privileges hash to change with the security roles")
+						}
+						effectivePrivilegesHash = value
+						return nil
+					}),
+				),
+			},
+			{
+				// a privilege added to the security role outside of Terraform changes the effective privileges, not the security roles.
+				PreConfig: func() {
+					extraPrivileges = append(extraPrivileges, "prvDeleteAccount")
+				},
+				RefreshState: true,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "security_roles.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "effective_privilege_count", "3"),
+					resource.TestCheckResourceAttrWith("powerplatform_application_user.app_user", "effective_privileges_hash", func(value string) error {
+						if value == effectivePrivilegesHash {
+							return errors.New("expected the effective privileges hash to change with the privileges of the security role")
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				ResourceName:      "powerplatform_application_user.app_user",
+				ImportState:       true,
+				ImportStateId:     "00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000010",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitApplicationUserResource_Validate_Import_Invalid_Id(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_application_user" "app_user" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					application_id = "00000000-0000-0000-0000-000000000100"
+				}`,
+				ResourceName:  "powerplatform_application_user.app_user",
+				ImportState:   true,
+				ImportStateId: "00000000-0000-0000-0000-000000000010",
+				ExpectError:   regexp.MustCompile(`<environment_id>/<systemuserid>`),
+			},
+		},
+	})
+}
+
+func TestUnitApplicationUserResource_Validate_Role_Profile(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	created := false
+	deleted := false
+	roles := []string{}
+	extraPrivileges := []string{}
+
+	applicationUser := func() string {
+		securityRoles := []map[string]any{}
+		for _, role := range roles {
+			securityRoles = append(securityRoles, map[string]any{
+				"roleid":                role,
+				"name":                  "Role " + role,
+				"ismanaged":             true,
+				"_businessunitid_value": "00000000-0000-0000-0000-000000000020",
+			})
+		}
+		securityRolesJson, _ := json.Marshal(securityRoles)
+		user := strings.ReplaceAll(httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_application_users.json").String(), "{{security_roles}}", string(securityRolesJson))
+		return strings.ReplaceAll(user, "{{business_unit_id}}", "00000000-0000-0000-0000-000000000020")
+	}
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/addAppUser?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			created = true
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24filter=applicationid+eq+00000000-0000-0000-0000-000000000100",
+		func(req *http.Request) (*http.Response, error) {
+			if !created || deleted {
+				return httpmock.NewStringResponse(http.StatusOK, `{"value":[]}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, applicationUser()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29",
+		func(req *http.Request) (*http.Response, error) {
+			users := map[string][]json.RawMessage{}
+			_ = json.Unmarshal([]byte(applicationUser()), &users)
+			return httpmock.NewBytesResponse(http.StatusOK, users["value"][0]), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29/systemuserroles_association/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			role := map[string]string{}
+			_ = json.NewDecoder(req.Body).Decode(&role)
+			roleId := strings.TrimSuffix(strings.TrimPrefix(role["@odata.id"], "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles("), ")")
+			roles = append(roles, roleId)
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_security_roles.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29",
+		func(req *http.Request) (*http.Response, error) {
+			deleted = true
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29?%24select=systemuserid%2Cisdisabled",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+		})
+
+	registerUserPrivilegesMock(&roles, &extraPrivileges)
+
+	config := `
+	provider "powerplatform" {
+		use_cli = true
+		role_profiles = {
+			integration = ["00000000-0000-0000-0000-000000000030", "00000000-0000-0000-0000-000000000031"]
+		}
+	}
+
+	resource "powerplatform_application_user" "app_user" {
+		environment_id = "00000000-0000-0000-0000-000000000001"
+		application_id = "00000000-0000-0000-0000-000000000100"
+		role_profile   = "integration"
+	}`
+
+	checkRoles := func(_ *terraform.State) error {
+		slices.Sort(roles)
+		if !slices.Equal(roles, []string{"00000000-0000-0000-0000-000000000030", "00000000-0000-0000-0000-000000000031"}) {
+			return fmt.Errorf("unexpected security roles assigned: %v", roles)
+		}
+		return nil
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "security_roles.#", "0"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "role_profile_security_roles.#", "2"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_application_user.app_user", "role_profile_security_roles.*", "00000000-0000-0000-0000-000000000030"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_application_user.app_user", "role_profile_security_roles.*", "00000000-0000-0000-0000-000000000031"),
+					checkRoles,
+				),
+			},
+			{
+				// a role of the profile unassigned outside of Terraform is assigned again.
+				PreConfig: func() {
+					roles = slices.DeleteFunc(roles, func(role string) bool { return role == "00000000-0000-0000-0000-000000000031" })
+				},
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "role_profile_security_roles.#", "2"),
+					checkRoles,
+				),
+			},
+		},
+	})
+}
+
+func TestUnitApplicationUserResource_Validate_Role_Profile_Unknown(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "powerplatform" {
+					use_cli = true
+					role_profiles = {
+						integration = ["00000000-0000-0000-0000-000000000030"]
+					}
+				}
+
+				resource "powerplatform_application_user" "app_user" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					application_id = "00000000-0000-0000-0000-000000000100"
+					role_profile   = "reporting"
+				}`,
+				ExpectError: regexp.MustCompile("Unknown role profile"),
+			},
+		},
+	})
+}
+
+func TestUnitApplicationUserResource_Validate_Update_Business_Unit(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	created := false
+	deleted := false
+	businessUnitId := "00000000-0000-0000-0000-000000000020"
+	roles := []string{}
+
+	applicationUser := func() string {
+		securityRoles := []map[string]any{}
+		for _, role := range roles {
+			securityRoles = append(securityRoles, map[string]any{
+				"roleid":                role,
+				"name":                  "Role " + role,
+				"ismanaged":             true,
+				"_businessunitid_value": businessUnitId,
+			})
+		}
+		securityRolesJson, _ := json.Marshal(securityRoles)
+		user := strings.ReplaceAll(httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_application_users.json").String(), "{{security_roles}}", string(securityRolesJson))
+		return strings.ReplaceAll(user, "{{business_unit_id}}", businessUnitId)
+	}
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/addAppUser?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			created = true
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24filter=applicationid+eq+00000000-0000-0000-0000-000000000100",
+		func(req *http.Request) (*http.Response, error) {
+			if !created || deleted {
+				return httpmock.NewStringResponse(http.StatusOK, `{"value":[]}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, applicationUser()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29",
+		func(req *http.Request) (*http.Response, error) {
+			users := map[string][]json.RawMessage{}
+			_ = json.Unmarshal([]byte(applicationUser()), &users)
+			return httpmock.NewBytesResponse(http.StatusOK, users["value"][0]), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29",
+		func(req *http.Request) (*http.Response, error) {
+			user := map[string]string{}
+			_ = json.NewDecoder(req.Body).Decode(&user)
+			businessUnitId = strings.TrimSuffix(strings.TrimPrefix(user["businessunitid@odata.bind"], "/businessunits("), ")")
+			// Dataverse removes the security roles of users that change business unit.
+			roles = []string{}
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_security_roles.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29/systemuserroles_association/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			role := map[string]string{}
+			_ = json.NewDecoder(req.Body).Decode(&role)
+			roleId := strings.TrimSuffix(strings.TrimPrefix(role["@odata.id"], "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles("), ")")
+			roles = append(roles, roleId)
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29",
+		func(req *http.Request) (*http.Response, error) {
+			deleted = true
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29?%24select=systemuserid%2Cisdisabled",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+		})
+
+	registerUserPrivilegesMock(&roles, nil)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_application_user" "app_user" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					application_id = "00000000-0000-0000-0000-000000000100"
+					security_roles = ["00000000-0000-0000-0000-000000000030"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "business_unit_id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "security_roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_application_user.app_user", "security_roles.*", "00000000-0000-0000-0000-000000000030"),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_application_user" "app_user" {
+					environment_id   = "00000000-0000-0000-0000-000000000001"
+					application_id   = "00000000-0000-0000-0000-000000000100"
+					business_unit_id = "00000000-0000-0000-0000-000000000021"
+					security_roles   = ["00000000-0000-0000-0000-000000000030"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "business_unit_id", "00000000-0000-0000-0000-000000000021"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "security_roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_application_user.app_user", "security_roles.*", "00000000-0000-0000-0000-000000000030"),
+					func(_ *terraform.State) error {
+						if len(roles) != 1 || roles[0] != "00000000-0000-0000-0000-000000000040" {
+							return fmt.Errorf("expected the copy of the security role in the new business unit to be assigned, got: %v", roles)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitApplicationUserResource_Validate_Security_Roles_By_Name(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	created := false
+	roles := []string{}
+
+	securityRoles := map[string][]map[string]any{}
+	_ = json.Unmarshal([]byte(httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_security_roles.json").String()), &securityRoles)
+
+	applicationUser := func() string {
+		assignedRoles := []map[string]any{}
+		for _, role := range securityRoles["value"] {
+			if slices.Contains(roles, role["roleid"].(string)) {
+				assignedRoles = append(assignedRoles, role)
+			}
+		}
+		assignedRolesJson, _ := json.Marshal(assignedRoles)
+		user := strings.ReplaceAll(httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_application_users.json").String(), "{{security_roles}}", string(assignedRolesJson))
+		return strings.ReplaceAll(user, "{{business_unit_id}}", "00000000-0000-0000-0000-000000000020")
+	}
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/addAppUser?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			created = true
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24filter=applicationid+eq+00000000-0000-0000-0000-000000000100",
+		func(req *http.Request) (*http.Response, error) {
+			if !created {
+				return httpmock.NewStringResponse(http.StatusOK, `{"value":[]}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, applicationUser()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29",
+		func(req *http.Request) (*http.Response, error) {
+			users := map[string][]json.RawMessage{}
+			_ = json.Unmarshal([]byte(applicationUser()), &users)
+			return httpmock.NewBytesResponse(http.StatusOK, users["value"][0]), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/application_user/Validate_Create_And_Update/get_security_roles.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles?%24filter=_businessunitid_value+eq+00000000-0000-0000-0000-000000000020",
+		func(req *http.Request) (*http.Response, error) {
+			businessUnitRoles := []map[string]any{}
+			for _, role := range securityRoles["value"] {
+				if role["_businessunitid_value"] == "00000000-0000-0000-0000-000000000020" {
+					businessUnitRoles = append(businessUnitRoles, role)
+				}
+			}
+			// a custom role with the same name as a built-in role.
+			businessUnitRoles = append(businessUnitRoles, map[string]any{
+				"roleid":                "00000000-0000-0000-0000-000000000032",
+				"name":                  "System Customizer",
+				"_businessunitid_value": "00000000-0000-0000-0000-000000000020",
+			})
+			businessUnitRolesJson, _ := json.Marshal(map[string]any{"value": businessUnitRoles})
+			return httpmock.NewBytesResponse(http.StatusOK, businessUnitRolesJson), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29/systemuserroles_association/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			role := map[string]string{}
+			_ = json.NewDecoder(req.Body).Decode(&role)
+			roleId := strings.TrimSuffix(strings.TrimPrefix(role["@odata.id"], "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles("), ")")
+			roles = append(roles, roleId)
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterRegexpResponder("DELETE", regexp.MustCompile(`^https://00000000-0000-0000-0000-000000000001\.crm4\.dynamics\.com/api/data/v9\.2/systemusers%2800000000-0000-0000-0000-000000000010%29/systemuserroles_association/\$ref\?%24id=.*roles%28(.+)%29$`),
+		func(req *http.Request) (*http.Response, error) {
+			roleId := httpmock.MustGetSubmatch(req, 1)
+			roles = slices.DeleteFunc(roles, func(role string) bool { return role == roleId })
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29",
+		func(req *http.Request) (*http.Response, error) {
+			created = false
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000010%29?%24select=systemuserid%2Cisdisabled",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+		})
+
+	registerUserPrivilegesMock(&roles, nil)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_application_user" "app_user" {
+					environment_id         = "00000000-0000-0000-0000-000000000001"
+					application_id         = "00000000-0000-0000-0000-000000000100"
+					security_roles_by_name = ["Basic User"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "security_roles.#", "0"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "security_roles_by_name.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_application_user.app_user", "security_roles_by_name.*", "Basic User"),
+					func(_ *terraform.State) error {
+						if len(roles) != 1 || roles[0] != "00000000-0000-0000-0000-000000000030" {
+							return fmt.Errorf("unexpected security roles assigned: %v", roles)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_application_user" "app_user" {
+					environment_id         = "00000000-0000-0000-0000-000000000001"
+					application_id         = "00000000-0000-0000-0000-000000000100"
+					security_roles         = ["00000000-0000-0000-0000-000000000030"]
+					security_roles_by_name = ["basic user"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "security_roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_application_user.app_user", "security_roles.*", "00000000-0000-0000-0000-000000000030"),
+					resource.TestCheckResourceAttr("powerplatform_application_user.app_user", "security_roles_by_name.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_application_user.app_user", "security_roles_by_name.*", "basic user"),
+					func(_ *terraform.State) error {
+						if len(roles) != 1 {
+							return fmt.Errorf("expected the role to be assigned once, got: %v", roles)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_application_user" "app_user" {
+					environment_id         = "00000000-0000-0000-0000-000000000001"
+					application_id         = "00000000-0000-0000-0000-000000000100"
+					security_roles_by_name = ["Basic User", "Environment Maker"]
+				}`,
+				ExpectError: regexp.MustCompile(`security role 'Environment Maker' not found in business unit`),
+			},
+			{
+				Config: `
+				resource "powerplatform_application_user" "app_user" {
+					environment_id         = "00000000-0000-0000-0000-000000000001"
+					application_id         = "00000000-0000-0000-0000-000000000100"
+					security_roles_by_name = ["Basic User", "System Customizer"]
+				}`,
+				ExpectError: regexp.MustCompile(`security role name 'System Customizer' is ambiguous`),
+			},
+		},
+	})
+}
+
+func TestUnitApplicationUserResource_Validate_Invalid_Application_Id(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_application_user" "app_user" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					application_id = "not-a-client-id"
+				}`,
+				ExpectError: regexp.MustCompile(`application_id must be a valid application \(client\)\s+id guid`),
+			},
+		},
+	})
+}
+
+func TestUnitApplicationUserResource_Validate_Security_Roles_Change_Warning(t *testing.T) {
+	ctx := context.Background()
+	r := authorization.NewApplicationUserResource()
+	r.Metadata(ctx, fwresource.MetadataRequest{ProviderTypeName: "powerplatform"}, &fwresource.MetadataResponse{})
+	schemaResp := &fwresource.SchemaResponse{}
+	r.Schema(ctx, fwresource.SchemaRequest{}, schemaResp)
+
+	applicationUser := func(securityRoles, securityRolesByName []string) tftypes.Value {
+		state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil)}
+		diags := state.Set(ctx, &authorization.ApplicationUserResourceModel{
+			Timeouts:                 timeouts.Value{Object: types.ObjectNull(schemaResp.Schema.Attributes["timeouts"].GetType().(timeouts.Type).AttrTypes)},
+			Id:                       types.StringValue("00000000-0000-0000-0000-000000000010"),
+			EnvironmentId:            types.StringValue("00000000-0000-0000-0000-000000000001"),
+			ApplicationId:            types.StringValue("00000000-0000-0000-0000-000000000100"),
+			AadId:                    types.StringValue("00000000-0000-0000-0000-000000000200"),
+			BusinessUnitId:           types.StringValue("00000000-0000-0000-0000-000000000020"),
+			SecurityRoles:            securityRoles,
+			SecurityRolesByName:      securityRolesByName,
+			RoleProfileSecurityRoles: types.SetNull(types.StringType),
+		})
+		if diags.HasError() {
+			t.Fatalf("unexpected error building the application user: %v", diags)
+		}
+		return state.Raw
+	}
+
+	modifyPlan := func(state, plan tftypes.Value) *fwresource.ModifyPlanResponse {
+		resp := &fwresource.ModifyPlanResponse{Plan: tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan}}
+		r.(fwresource.ResourceWithModifyPlan).ModifyPlan(ctx, fwresource.ModifyPlanRequest{
+			State: tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+			Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: plan},
+		}, resp)
+		return resp
+	}
+
+	resp := modifyPlan(
+		applicationUser([]string{"00000000-0000-0000-0000-000000000030", "00000000-0000-0000-0000-000000000031"}, []string{"Basic User"}),
+		applicationUser([]string{"00000000-0000-0000-0000-000000000031", "00000000-0000-0000-0000-000000000032"}, []string{"Basic User", "Environment Maker"}))
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", resp.Diagnostics)
+	}
+	expected := "+ security_roles: 00000000-0000-0000-0000-000000000032\n- security_roles: 00000000-0000-0000-0000-000000000030\n+ security_roles_by_name: Environment Maker"
+	if detail := resp.Diagnostics.Warnings()[0].Detail(); !strings.HasSuffix(detail, expected) {
+		t.Errorf("unexpected security role changes:\n%s", detail)
+	}
+
+	resp = modifyPlan(
+		applicationUser([]string{"00000000-0000-0000-0000-000000000030"}, []string{}),
+		applicationUser([]string{"00000000-0000-0000-0000-000000000030"}, []string{}))
+	if len(resp.Diagnostics) != 0 {
+		t.Errorf("expected no warning when the security roles do not change, got %v", resp.Diagnostics)
+	}
+
+	resp = modifyPlan(
+		tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		applicationUser([]string{"00000000-0000-0000-0000-000000000030"}, []string{}))
+	if len(resp.Diagnostics) != 0 {
+		t.Errorf("expected no warning when the application user is created, got %v", resp.Diagnostics)
+	}
+}
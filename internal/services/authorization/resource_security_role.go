@@ -0,0 +1,406 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &SecurityRoleResource{}
+var _ resource.ResourceWithImportState = &SecurityRoleResource{}
+
+func NewSecurityRoleResource() resource.Resource {
+	return &SecurityRoleResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "security_role",
+		},
+	}
+}
+
+func (r *SecurityRoleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *SecurityRoleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource manages a custom security role of a Dataverse environment. The privileges of the role can be copied from an existing role or managed by name. The role can be assigned with the `security_roles` attribute of `powerplatform_application_user`.\n\n" +
+			"Additional Resources:\n\n" +
+			"* [Create or edit a security role](https://learn.microsoft.com/power-platform/admin/create-edit-security-role)",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique id (guid) of the security role",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Unique environment id (guid)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the security role",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the security role",
+				Optional:            true,
+			},
+			"business_unit_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the business unit to which the security role belongs. When not set, the role is created in the root business unit and is available in every business unit of the environment",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "business_unit_id must be a valid guid"),
+				},
+			},
+			"copy_from_role_id": schema.StringAttribute{
+				MarkdownDescription: "Id of an existing security role whose privileges are copied to the new role when it is created. Privileges changed on the source role later on are not copied again",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "copy_from_role_id must be a valid guid"),
+				},
+			},
+			"privileges": schema.SetNestedAttribute{
+				MarkdownDescription: "Privileges granted by the security role. When set, the privileges of the role are replaced with these ones. When not set, the privileges of the role are not managed, for example to keep the ones copied with `copy_from_role_id`",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Set{
+					setvalidator.ConflictsWith(path.MatchRoot("copy_from_role_id")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"privilege_name": schema.StringAttribute{
+							MarkdownDescription: "Name of the privilege, for example `prvReadAccount`",
+							Required:            true,
+						},
+						"depth": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("Depth at which the privilege is granted. Valid values are `%s`, `%s`, `%s` and `%s`", PRIVILEGE_DEPTH_BASIC, PRIVILEGE_DEPTH_LOCAL, PRIVILEGE_DEPTH_DEEP, PRIVILEGE_DEPTH_GLOBAL),
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(PRIVILEGE_DEPTH_BASIC, PRIVILEGE_DEPTH_LOCAL, PRIVILEGE_DEPTH_DEEP, PRIVILEGE_DEPTH_GLOBAL),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SecurityRoleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.SecurityRoleClient = newUserClient(client.Api)
+}
+
+func (r *SecurityRoleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *SecurityRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	businessUnitId := plan.BusinessUnitId.ValueString()
+	if businessUnitId == "" {
+		var err error
+		businessUnitId, err = r.SecurityRoleClient.GetRootBusinessUnitId(ctx, plan.EnvironmentId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	roleId, err := r.SecurityRoleClient.CreateSecurityRole(ctx, plan.EnvironmentId.ValueString(), securityRoleCreateDto{
+		Name:         plan.Name.ValueString(),
+		Description:  plan.Description.ValueString(),
+		BusinessUnit: fmt.Sprintf("/businessunits(%s)", businessUnitId),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	// keep track of the security role before granting the privileges, so that it gets destroyed if granting them fails.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), roleId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), plan.EnvironmentId.ValueString())...)
+
+	var privilegeDepths map[string]string
+	if !plan.Privileges.IsUnknown() {
+		var diags diag.Diagnostics
+		privilegeDepths, diags = convertToPrivilegeDepths(ctx, plan.Privileges)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if plan.CopyFromRoleId.ValueString() != "" {
+		privileges, err := r.SecurityRoleClient.GetSecurityRolePrivileges(ctx, plan.EnvironmentId.ValueString(), plan.CopyFromRoleId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when copying privileges of %s", r.FullTypeName()), err.Error())
+			return
+		}
+		privilegeDepths = map[string]string{}
+		for _, privilege := range privileges {
+			privilegeDepths[privilege.PrivilegeName] = privilege.Depth
+		}
+	}
+
+	if len(privilegeDepths) > 0 {
+		err = r.SecurityRoleClient.ReplaceSecurityRolePrivileges(ctx, plan.EnvironmentId.ValueString(), roleId, businessUnitId, privilegeDepths)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.readSecurityRole(ctx, plan, roleId)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", plan.Id.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SecurityRoleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *SecurityRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	role, err := r.SecurityRoleClient.GetSecurityRole(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	privileges, err := r.SecurityRoleClient.GetSecurityRolePrivileges(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(convertFromSecurityRoleDto(state, role, privileges)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s with id %s", r.FullTypeName(), state.Id.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *SecurityRoleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *SecurityRoleResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *SecurityRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		_, err := r.SecurityRoleClient.UpdateSecurityRole(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString(), securityRoleUpdateDto{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	if !plan.Privileges.IsUnknown() && !plan.Privileges.Equal(state.Privileges) {
+		privilegeDepths, diags := convertToPrivilegeDepths(ctx, plan.Privileges)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := r.SecurityRoleClient.ReplaceSecurityRolePrivileges(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString(), state.BusinessUnitId.ValueString(), privilegeDepths)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating privileges %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(r.readSecurityRole(ctx, plan, state.Id.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *SecurityRoleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *SecurityRoleResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.SecurityRoleClient.DeleteSecurityRole(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.FullTypeName()))
+}
+
+// ImportState imports a security role by its environment and role id, separated by a slash: `<environment_id>/<role_id>`.
+func (r *SecurityRoleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	environmentId, roleId, found := strings.Cut(req.ID, "/")
+	if !found || environmentId == "" || roleId == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>/<role_id>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), roleId)...)
+}
+
+// readSecurityRole reads the security role and its privileges back into the model after they have been changed.
+func (r *SecurityRoleResource) readSecurityRole(ctx context.Context, model *SecurityRoleResourceModel, roleId string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	role, err := r.SecurityRoleClient.GetSecurityRole(ctx, model.EnvironmentId.ValueString(), roleId)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return diags
+	}
+	privileges, err := r.SecurityRoleClient.GetSecurityRolePrivileges(ctx, model.EnvironmentId.ValueString(), roleId)
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return diags
+	}
+	return convertFromSecurityRoleDto(model, role, privileges)
+}
+
+func convertToPrivilegeDepths(ctx context.Context, privileges types.Set) (map[string]string, diag.Diagnostics) {
+	var privilegeModels []SecurityRolePrivilegeModel
+	diags := privileges.ElementsAs(ctx, &privilegeModels, false)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	privilegeDepths := map[string]string{}
+	for _, privilege := range privilegeModels {
+		name := privilege.PrivilegeName.ValueString()
+		if _, ok := privilegeDepths[name]; ok {
+			diags.AddAttributeError(path.Root("privileges"), "Duplicate privilege", fmt.Sprintf("privilege '%s' is granted more than once, a privilege can only be granted at a single depth", name))
+			continue
+		}
+		privilegeDepths[name] = privilege.Depth.ValueString()
+	}
+	return privilegeDepths, diags
+}
+
+func convertFromSecurityRoleDto(model *SecurityRoleResourceModel, role *securityRoleDto, privileges []rolePrivilegeDto) diag.Diagnostics {
+	model.Id = types.StringValue(role.RoleId)
+	model.Name = types.StringValue(role.Name)
+	if role.Description != "" || !model.Description.IsNull() {
+		model.Description = types.StringValue(role.Description)
+	}
+	model.BusinessUnitId = types.StringValue(role.BusinessUnitId)
+
+	privilegeValues := make([]attr.Value, 0, len(privileges))
+	for _, privilege := range privileges {
+		privilegeValue, diags := types.ObjectValue(securityRolePrivilegeObjectType.AttrTypes, map[string]attr.Value{
+			"privilege_name": types.StringValue(privilege.PrivilegeName),
+			"depth":          types.StringValue(privilege.Depth),
+		})
+		if diags.HasError() {
+			return diags
+		}
+		privilegeValues = append(privilegeValues, privilegeValue)
+	}
+	privilegeSet, diags := types.SetValue(securityRolePrivilegeObjectType, privilegeValues)
+	model.Privileges = privilegeSet
+	return diags
+}
@@ -0,0 +1,295 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccSecurityRoleResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_security_role" "role" {
+					environment_id = powerplatform_environment.env.id
+					name           = "` + mocks.TestName() + `"
+					description    = "Reads accounts"
+					privileges = [
+						{
+							privilege_name = "prvReadAccount"
+							depth          = "Global"
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_security_role.role", "id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_security_role.role", "business_unit_id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "privileges.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+// registerSecurityRoleResponders mocks the Dataverse role and privilege endpoints, keeping track of the roles created by the test.
+func registerSecurityRoleResponders(roles map[string]map[string]any, rolePrivileges map[string][]map[string]string) {
+	privilegeNames := map[string]string{
+		"00000000-0000-0000-0000-000000000201": "prvReadAccount",
+		"00000000-0000-0000-0000-000000000202": "prvWriteAccount",
+		"00000000-0000-0000-0000-000000000203": "prvCreateAccount",
+	}
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/security_role/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits?%24filter=_parentbusinessunitid_value+eq+null&%24select=businessunitid",
+		httpmock.NewStringResponder(http.StatusOK, `{"value":[{"businessunitid":"00000000-0000-0000-0000-000000000020"}]}`))
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles",
+		func(req *http.Request) (*http.Response, error) {
+			role := map[string]any{}
+			_ = json.NewDecoder(req.Body).Decode(&role)
+			if role["businessunitid@odata.bind"] != "/businessunits(00000000-0000-0000-0000-000000000020)" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected business unit"), nil
+			}
+			roles["00000000-0000-0000-0000-000000000100"] = map[string]any{
+				"roleid":                "00000000-0000-0000-0000-000000000100",
+				"name":                  role["name"],
+				"description":           role["description"],
+				"ismanaged":             false,
+				"_businessunitid_value": "00000000-0000-0000-0000-000000000020",
+			}
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Add("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles(00000000-0000-0000-0000-000000000100)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000100%29?%24select=roleid%2Cname%2Cdescription%2Cismanaged%2C_businessunitid_value",
+		func(req *http.Request) (*http.Response, error) {
+			role, ok := roles["00000000-0000-0000-0000-000000000100"]
+			if !ok {
+				return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, role)
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000100%29",
+		func(req *http.Request) (*http.Response, error) {
+			update := map[string]any{}
+			_ = json.NewDecoder(req.Body).Decode(&update)
+			roles["00000000-0000-0000-0000-000000000100"]["name"] = update["name"]
+			roles["00000000-0000-0000-0000-000000000100"]["description"] = update["description"]
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000100%29",
+		func(req *http.Request) (*http.Response, error) {
+			delete(roles, "00000000-0000-0000-0000-000000000100")
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	for _, roleId := range []string{"00000000-0000-0000-0000-000000000100", "00000000-0000-0000-0000-000000000300"} {
+		httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%28"+roleId+"%29/Microsoft.Dynamics.CRM.RetrieveRolePrivilegesRole%28%29",
+			func(req *http.Request) (*http.Response, error) {
+				privileges := []map[string]string{}
+				for _, privilege := range rolePrivileges[roleId] {
+					privileges = append(privileges, map[string]string{
+						"Depth":          privilege["Depth"],
+						"PrivilegeId":    privilege["PrivilegeId"],
+						"BusinessUnitId": privilege["BusinessUnitId"],
+						"PrivilegeName":  privilegeNames[privilege["PrivilegeId"]],
+					})
+				}
+				return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"RolePrivileges": privileges})
+			})
+	}
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles%2800000000-0000-0000-0000-000000000100%29/Microsoft.Dynamics.CRM.ReplacePrivilegesRole",
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string][]map[string]string{}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			rolePrivileges["00000000-0000-0000-0000-000000000100"] = body["Privileges"]
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/privileges?%24filter=Microsoft.Dynamics.CRM.In%28PropertyName%3D%27name%27%2CPropertyValues%3D%5B%27prvReadAccount%27%2C%27prvWriteAccount%27%5D%29&%24select=privilegeid%2Cname",
+		httpmock.NewStringResponder(http.StatusOK, `{"value":[{"privilegeid":"00000000-0000-0000-0000-000000000201","name":"prvReadAccount"},{"privilegeid":"00000000-0000-0000-0000-000000000202","name":"prvWriteAccount"}]}`))
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/privileges?%24filter=Microsoft.Dynamics.CRM.In%28PropertyName%3D%27name%27%2CPropertyValues%3D%5B%27prvCreateAccount%27%2C%27prvReadAccount%27%5D%29&%24select=privilegeid%2Cname",
+		httpmock.NewStringResponder(http.StatusOK, `{"value":[{"privilegeid":"00000000-0000-0000-0000-000000000201","name":"prvReadAccount"},{"privilegeid":"00000000-0000-0000-0000-000000000203","name":"prvCreateAccount"}]}`))
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/privileges?%24filter=Microsoft.Dynamics.CRM.In%28PropertyName%3D%27name%27%2CPropertyValues%3D%5B%27prvReadAccount%27%5D%29&%24select=privilegeid%2Cname",
+		httpmock.NewStringResponder(http.StatusOK, `{"value":[{"privilegeid":"00000000-0000-0000-0000-000000000201","name":"prvReadAccount"}]}`))
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/privileges?%24filter=Microsoft.Dynamics.CRM.In%28PropertyName%3D%27name%27%2CPropertyValues%3D%5B%27prvDoesNotExist%27%5D%29&%24select=privilegeid%2Cname",
+		httpmock.NewStringResponder(http.StatusOK, `{"value":[]}`))
+}
+
+func TestUnitSecurityRoleResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	roles := map[string]map[string]any{}
+	rolePrivileges := map[string][]map[string]string{}
+	registerSecurityRoleResponders(roles, rolePrivileges)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_security_role" "role" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Account Reader"
+					description    = "Reads accounts"
+					privileges = [
+						{
+							privilege_name = "prvReadAccount"
+							depth          = "Global"
+						},
+						{
+							privilege_name = "prvWriteAccount"
+							depth          = "Basic"
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "id", "00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "name", "Account Reader"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "description", "Reads accounts"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "business_unit_id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "privileges.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_security_role.role", "privileges.*", map[string]string{
+						"privilege_name": "prvReadAccount",
+						"depth":          "Global",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_security_role.role", "privileges.*", map[string]string{
+						"privilege_name": "prvWriteAccount",
+						"depth":          "Basic",
+					}),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_security_role" "role" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Account Creator"
+					privileges = [
+						{
+							privilege_name = "prvReadAccount"
+							depth          = "Local"
+						},
+						{
+							privilege_name = "prvCreateAccount"
+							depth          = "Basic"
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "id", "00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "name", "Account Creator"),
+					resource.TestCheckNoResourceAttr("powerplatform_security_role.role", "description"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "privileges.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_security_role.role", "privileges.*", map[string]string{
+						"privilege_name": "prvReadAccount",
+						"depth":          "Local",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_security_role.role", "privileges.*", map[string]string{
+						"privilege_name": "prvCreateAccount",
+						"depth":          "Basic",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSecurityRoleResource_Validate_Create_Copy_From_Role(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	roles := map[string]map[string]any{}
+	rolePrivileges := map[string][]map[string]string{
+		"00000000-0000-0000-0000-000000000300": {
+			{"Depth": "Global", "PrivilegeId": "00000000-0000-0000-0000-000000000201", "BusinessUnitId": "00000000-0000-0000-0000-000000000020"},
+		},
+	}
+	registerSecurityRoleResponders(roles, rolePrivileges)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_security_role" "role" {
+					environment_id    = "00000000-0000-0000-0000-000000000001"
+					name              = "Account Reader"
+					copy_from_role_id = "00000000-0000-0000-0000-000000000300"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "id", "00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "copy_from_role_id", "00000000-0000-0000-0000-000000000300"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "privileges.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "privileges.0.privilege_name", "prvReadAccount"),
+					resource.TestCheckResourceAttr("powerplatform_security_role.role", "privileges.0.depth", "Global"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSecurityRoleResource_Validate_Create_Unknown_Privilege(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	roles := map[string]map[string]any{}
+	rolePrivileges := map[string][]map[string]string{}
+	registerSecurityRoleResponders(roles, rolePrivileges)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_security_role" "role" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Account Reader"
+					privileges = [
+						{
+							privilege_name = "prvDoesNotExist"
+							depth          = "Global"
+						},
+					]
+				}`,
+				ExpectError: regexp.MustCompile(`privileges not found: prvDoesNotExist`),
+			},
+		},
+	})
+}
@@ -0,0 +1,441 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers/array"
+)
+
+var _ resource.Resource = &TeamResource{}
+var _ resource.ResourceWithImportState = &TeamResource{}
+var _ resource.ResourceWithValidateConfig = &TeamResource{}
+
+func NewTeamResource() resource.Resource {
+	return &TeamResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "team",
+		},
+	}
+}
+
+func (r *TeamResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *TeamResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource manages a team of a Dataverse environment and the security roles assigned to it. Owner and access teams get their members from `powerplatform_team_membership`, while the members of Entra group teams are the members of the Entra group.\n\n" +
+			"Additional Resources:\n\n" +
+			"* [Manage teams](https://learn.microsoft.com/power-platform/admin/manage-teams)",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique id (guid) of the team",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Unique environment id (guid)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the team",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 160),
+				},
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the team",
+				Optional:            true,
+			},
+			"team_type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Type of the team. Valid values are `%s`, `%s`, `%s` and `%s`. Default is `%s`", TEAM_TYPE_OWNER, TEAM_TYPE_ACCESS, TEAM_TYPE_ENTRA_SECURITY_GROUP, TEAM_TYPE_ENTRA_OFFICE_GROUP, TEAM_TYPE_OWNER),
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(TEAM_TYPE_OWNER),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(TEAM_TYPE_OWNER, TEAM_TYPE_ACCESS, TEAM_TYPE_ENTRA_SECURITY_GROUP, TEAM_TYPE_ENTRA_OFFICE_GROUP),
+				},
+			},
+			"business_unit_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the business unit to which the team belongs. When not set, the team is created in the root business unit",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "business_unit_id must be a valid guid"),
+				},
+			},
+			"entra_group_id": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Object id of the Entra group bound to the team. Required for `%s` and `%s` teams", TEAM_TYPE_ENTRA_SECURITY_GROUP, TEAM_TYPE_ENTRA_OFFICE_GROUP),
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "entra_group_id must be a valid guid"),
+				},
+			},
+			"membership_type": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("Which members of the Entra group are members of the team. Valid values are `%s`, `%s`, `%s` and `%s`. Only applies to Entra group teams, where the default is `%s`", TEAM_MEMBERSHIP_TYPE_MEMBERS_AND_GUESTS, TEAM_MEMBERSHIP_TYPE_MEMBERS, TEAM_MEMBERSHIP_TYPE_OWNERS, TEAM_MEMBERSHIP_TYPE_GUESTS, TEAM_MEMBERSHIP_TYPE_MEMBERS_AND_GUESTS),
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(TEAM_MEMBERSHIP_TYPE_MEMBERS_AND_GUESTS, TEAM_MEMBERSHIP_TYPE_MEMBERS, TEAM_MEMBERSHIP_TYPE_OWNERS, TEAM_MEMBERSHIP_TYPE_GUESTS),
+				},
+			},
+			"security_roles": schema.SetAttribute{
+				MarkdownDescription: "Security roles Ids assigned to the team. Roles of another business unit are assigned as their copy in the business unit of the team",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+		},
+	}
+}
+
+func (r *TeamResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// the attributes are read one by one, as other attributes such as security_roles may not be known yet.
+	var teamTypeValue, entraGroupId, membershipType types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("team_type"), &teamTypeValue)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("entra_group_id"), &entraGroupId)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("membership_type"), &membershipType)...)
+	if resp.Diagnostics.HasError() || teamTypeValue.IsUnknown() {
+		return
+	}
+
+	teamType := teamTypeValue.ValueString()
+	if teamTypeValue.IsNull() {
+		teamType = TEAM_TYPE_OWNER
+	}
+	isEntraGroupTeam := teamType == TEAM_TYPE_ENTRA_SECURITY_GROUP || teamType == TEAM_TYPE_ENTRA_OFFICE_GROUP
+	if isEntraGroupTeam && entraGroupId.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("entra_group_id"), "Missing Entra group", fmt.Sprintf("entra_group_id is required for teams of type '%s'", teamType))
+	}
+	if !isEntraGroupTeam && !entraGroupId.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("entra_group_id"), "Invalid Entra group", fmt.Sprintf("entra_group_id can only be set for teams of type '%s' or '%s'", TEAM_TYPE_ENTRA_SECURITY_GROUP, TEAM_TYPE_ENTRA_OFFICE_GROUP))
+	}
+	if !isEntraGroupTeam && !membershipType.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("membership_type"), "Invalid membership type", fmt.Sprintf("membership_type can only be set for teams of type '%s' or '%s'", TEAM_TYPE_ENTRA_SECURITY_GROUP, TEAM_TYPE_ENTRA_OFFICE_GROUP))
+	}
+}
+
+func (r *TeamResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.TeamClient = newUserClient(client.Api)
+}
+
+func (r *TeamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *TeamResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	businessUnitId := plan.BusinessUnitId.ValueString()
+	if businessUnitId == "" {
+		var err error
+		businessUnitId, err = r.TeamClient.GetRootBusinessUnitId(ctx, plan.EnvironmentId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	teamToCreate := teamCreateDto{
+		Name:         plan.Name.ValueString(),
+		Description:  plan.Description.ValueString(),
+		TeamType:     teamTypes[plan.TeamType.ValueString()],
+		EntraGroupId: plan.EntraGroupId.ValueString(),
+		BusinessUnit: fmt.Sprintf("/businessunits(%s)", businessUnitId),
+	}
+	if membershipType, ok := teamMembershipTypes[plan.MembershipType.ValueString()]; ok {
+		teamToCreate.MembershipType = &membershipType
+	}
+
+	team, err := r.TeamClient.CreateTeam(ctx, plan.EnvironmentId.ValueString(), teamToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	// keep track of the team before assigning the roles, so that it gets destroyed if the assignment fails.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), team.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), plan.EnvironmentId.ValueString())...)
+
+	team, businessUnitRoleIds, err := r.assignSecurityRoles(ctx, plan.EnvironmentId.ValueString(), team, plan.SecurityRoles)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromTeamDto(plan, team, businessUnitRoleIds)
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", plan.Id.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TeamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *TeamResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := r.TeamClient.GetTeam(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	// roles of another business unit are assigned as their copy in the business unit of the team, look up these copies to report them by their configured id.
+	businessUnitRoleIds := map[string]string{}
+	if _, unassignedSecurityRoles := array.Diff(team.securityRolesArray(), state.SecurityRoles); len(unassignedSecurityRoles) > 0 && len(team.SecurityRoles) > 0 {
+		businessUnitRoleIds, err = r.TeamClient.GetBusinessUnitSecurityRoleIds(ctx, state.EnvironmentId.ValueString(), team.BusinessUnitId, unassignedSecurityRoles)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	convertFromTeamDto(state, team, businessUnitRoleIds)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s with id %s", r.FullTypeName(), state.Id.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TeamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *TeamResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *TeamResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var team *teamDto
+	var err error
+	if !plan.Name.Equal(state.Name) || !plan.Description.Equal(state.Description) {
+		team, err = r.TeamClient.UpdateTeam(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString(), teamUpdateDto{
+			Name:        plan.Name.ValueString(),
+			Description: plan.Description.ValueString(),
+		})
+	} else {
+		team, err = r.TeamClient.GetTeam(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	team, businessUnitRoleIds, err := r.assignSecurityRoles(ctx, state.EnvironmentId.ValueString(), team, plan.SecurityRoles)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating security roles %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromTeamDto(plan, team, businessUnitRoleIds)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// assignSecurityRoles assigns the given security roles to the team in its business unit, removing any other role.
+// It returns the updated team along with the ids of the roles in the business unit of the team.
+func (r *TeamResource) assignSecurityRoles(ctx context.Context, environmentId string, team *teamDto, securityRoles []string) (*teamDto, map[string]string, error) {
+	businessUnitRoleIds := map[string]string{}
+	if len(securityRoles) > 0 {
+		var err error
+		businessUnitRoleIds, err = r.TeamClient.GetBusinessUnitSecurityRoleIds(ctx, environmentId, team.BusinessUnitId, securityRoles)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	wantedSecurityRoles := make([]string, 0, len(securityRoles))
+	for _, role := range securityRoles {
+		wantedSecurityRoles = append(wantedSecurityRoles, businessUnitRoleIds[role])
+	}
+
+	addedSecurityRoles, removedSecurityRoles := array.Diff(wantedSecurityRoles, team.securityRolesArray())
+	if len(addedSecurityRoles) == 0 && len(removedSecurityRoles) == 0 {
+		return team, businessUnitRoleIds, nil
+	}
+	if err := r.TeamClient.AddTeamSecurityRoles(ctx, environmentId, team.Id, addedSecurityRoles); err != nil {
+		return nil, nil, err
+	}
+	if err := r.TeamClient.RemoveTeamSecurityRoles(ctx, environmentId, team.Id, removedSecurityRoles); err != nil {
+		return nil, nil, err
+	}
+
+	team, err := r.TeamClient.GetTeam(ctx, environmentId, team.Id)
+	if err != nil {
+		return nil, nil, err
+	}
+	return team, businessUnitRoleIds, nil
+}
+
+func (r *TeamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *TeamResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.TeamClient.DeleteTeam(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.FullTypeName()))
+}
+
+// ImportState imports a team by its environment and team id, separated by a slash: `<environment_id>/<team_id>`.
+func (r *TeamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	environmentId, teamId, found := strings.Cut(req.ID, "/")
+	if !found || environmentId == "" || teamId == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>/<team_id>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), teamId)...)
+}
+
+// convertFromTeamDto reports the assigned security roles by the configured role id that was used to assign them.
+func convertFromTeamDto(model *TeamResourceModel, team *teamDto, businessUnitRoleIds map[string]string) {
+	model.Id = types.StringValue(team.Id)
+	model.Name = types.StringValue(team.Name)
+	if team.Description != "" || !model.Description.IsNull() {
+		model.Description = types.StringValue(team.Description)
+	}
+	model.BusinessUnitId = types.StringValue(team.BusinessUnitId)
+
+	model.TeamType = types.StringValue(fmt.Sprintf("%d", team.TeamType))
+	for name, value := range teamTypes {
+		if value == team.TeamType {
+			model.TeamType = types.StringValue(name)
+		}
+	}
+
+	configuredEntraGroupId := model.EntraGroupId
+	model.EntraGroupId = types.StringNull()
+	model.MembershipType = types.StringNull()
+	if team.EntraGroupId != "" {
+		// Dataverse returns the object id in lower case, keep the configured casing if it is the same id.
+		model.EntraGroupId = types.StringValue(team.EntraGroupId)
+		if strings.EqualFold(configuredEntraGroupId.ValueString(), team.EntraGroupId) {
+			model.EntraGroupId = configuredEntraGroupId
+		}
+		if team.MembershipType != nil {
+			for name, value := range teamMembershipTypes {
+				if value == *team.MembershipType {
+					model.MembershipType = types.StringValue(name)
+				}
+			}
+		}
+	}
+
+	configuredRoles := model.SecurityRoles
+	model.SecurityRoles = []string{}
+	for _, role := range team.SecurityRoles {
+		assignedRole := role.RoleId
+		for _, configuredRole := range configuredRoles {
+			if strings.EqualFold(configuredRole, role.RoleId) || strings.EqualFold(businessUnitRoleIds[configuredRole], role.RoleId) {
+				assignedRole = configuredRole
+				break
+			}
+		}
+		model.SecurityRoles = append(model.SecurityRoles, assignedRole)
+	}
+}
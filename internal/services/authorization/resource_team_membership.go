@@ -0,0 +1,291 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &TeamMembershipResource{}
+var _ resource.ResourceWithImportState = &TeamMembershipResource{}
+
+func NewTeamMembershipResource() resource.Resource {
+	return &TeamMembershipResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "team_membership",
+		},
+	}
+}
+
+func (r *TeamMembershipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *TeamMembershipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource adds Dataverse users to an owner or access team. Only the configured users are managed, other members of the team, such as the ones added by Dataverse or by another configuration, are left untouched. The members of Entra group teams are managed in Entra instead.\n\n" +
+			"Additional Resources:\n\n" +
+			"* [Manage team members](https://learn.microsoft.com/power-platform/admin/manage-teams#manage-team-members)",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the team membership, same as `team_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Unique environment id (guid)",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the owner or access team",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "team_id must be a valid guid"),
+				},
+			},
+			"system_user_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids of the Dataverse systemusers that are members of the team, for example the `id` of a `powerplatform_user` or `powerplatform_application_user`",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "system_user_ids must be valid guids")),
+				},
+			},
+		},
+	}
+}
+
+func (r *TeamMembershipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.TeamClient = newUserClient(client.Api)
+}
+
+func (r *TeamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *TeamMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberIds, err := r.changeMembers(ctx, plan.EnvironmentId.ValueString(), plan.TeamId.ValueString(), plan.SystemUserIds, []string{})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromTeamMemberIds(plan, memberIds)
+
+	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", plan.Id.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TeamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *TeamMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	memberIds, err := r.TeamClient.GetTeamMemberIds(ctx, state.EnvironmentId.ValueString(), state.TeamId.ValueString())
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromTeamMemberIds(state, memberIds)
+
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s with id %s", r.FullTypeName(), state.Id.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *TeamMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *TeamMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *TeamMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	removedMemberIds := []string{}
+	for _, systemUserId := range state.SystemUserIds {
+		if !slices.ContainsFunc(plan.SystemUserIds, func(id string) bool { return strings.EqualFold(id, systemUserId) }) {
+			removedMemberIds = append(removedMemberIds, systemUserId)
+		}
+	}
+
+	memberIds, err := r.changeMembers(ctx, state.EnvironmentId.ValueString(), state.TeamId.ValueString(), plan.SystemUserIds, removedMemberIds)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromTeamMemberIds(plan, memberIds)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *TeamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *TeamMembershipResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.changeMembers(ctx, state.EnvironmentId.ValueString(), state.TeamId.ValueString(), []string{}, state.SystemUserIds)
+	if err != nil {
+		if customerrors.Code(err) == customerrors.ERROR_OBJECT_NOT_FOUND {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("DELETE RESOURCE END: %s", r.FullTypeName()))
+}
+
+// ImportState imports the members of a team by its environment and team id, separated by a slash: `<environment_id>/<team_id>`.
+// All the current members of the team are imported.
+func (r *TeamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	environmentId, teamId, found := strings.Cut(req.ID, "/")
+	if !found || environmentId == "" || teamId == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>/<team_id>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), teamId)...)
+}
+
+// changeMembers adds the wanted users that are not members of the team yet and removes the given users that are still members.
+// It returns the members of the team after the change.
+func (r *TeamMembershipResource) changeMembers(ctx context.Context, environmentId, teamId string, wantedMemberIds, removedMemberIds []string) ([]string, error) {
+	memberIds, err := r.TeamClient.GetTeamMemberIds(ctx, environmentId, teamId)
+	if err != nil {
+		return nil, err
+	}
+	isMember := func(systemUserId string) bool {
+		return slices.ContainsFunc(memberIds, func(id string) bool { return strings.EqualFold(id, systemUserId) })
+	}
+
+	addedMemberIds := []string{}
+	for _, systemUserId := range wantedMemberIds {
+		if !isMember(systemUserId) {
+			addedMemberIds = append(addedMemberIds, systemUserId)
+		}
+	}
+	leavingMemberIds := []string{}
+	for _, systemUserId := range removedMemberIds {
+		if isMember(systemUserId) {
+			leavingMemberIds = append(leavingMemberIds, systemUserId)
+		}
+	}
+	if len(addedMemberIds) == 0 && len(leavingMemberIds) == 0 {
+		return memberIds, nil
+	}
+
+	if err := r.TeamClient.AddTeamMembers(ctx, environmentId, teamId, addedMemberIds); err != nil {
+		return nil, err
+	}
+	if err := r.TeamClient.RemoveTeamMembers(ctx, environmentId, teamId, leavingMemberIds); err != nil {
+		return nil, err
+	}
+	return r.TeamClient.GetTeamMemberIds(ctx, environmentId, teamId)
+}
+
+// convertFromTeamMemberIds reports the configured users that are members of the team, or all the members when nothing is configured yet, such as after an import.
+func convertFromTeamMemberIds(model *TeamMembershipResourceModel, memberIds []string) {
+	model.Id = model.TeamId
+
+	if model.SystemUserIds == nil {
+		model.SystemUserIds = memberIds
+		return
+	}
+
+	configuredMemberIds := model.SystemUserIds
+	model.SystemUserIds = []string{}
+	for _, systemUserId := range configuredMemberIds {
+		if slices.ContainsFunc(memberIds, func(id string) bool { return strings.EqualFold(id, systemUserId) }) {
+			model.SystemUserIds = append(model.SystemUserIds, systemUserId)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccTeamMembershipResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_security_roles" "all" {
+					environment_id = powerplatform_environment.env.id
+				}
+
+				resource "powerplatform_application_user" "app_user" {
+					environment_id = powerplatform_environment.env.id
+					application_id = "00000007-0000-0000-c000-000000000000"
+					security_roles = [
+						one([for role in data.powerplatform_security_roles.all.security_roles : role.role_id if role.name == "Basic User"]),
+					]
+				}
+
+				resource "powerplatform_team" "team" {
+					environment_id = powerplatform_environment.env.id
+					name           = "` + mocks.TestName() + `"
+				}
+
+				resource "powerplatform_team_membership" "members" {
+					environment_id  = powerplatform_environment.env.id
+					team_id         = powerplatform_team.team.id
+					system_user_ids = [powerplatform_application_user.app_user.id]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("powerplatform_team_membership.members", "id", "powerplatform_team.team", "id"),
+					resource.TestCheckResourceAttr("powerplatform_team_membership.members", "system_user_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitTeamMembershipResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// the owner of the team is added by Dataverse and is not managed by the resource.
+	members := []string{"00000000-0000-0000-0000-000000000200"}
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/team/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000100%29/teammembership_association?%24select=systemuserid",
+		func(req *http.Request) (*http.Response, error) {
+			value := []map[string]string{}
+			for _, member := range members {
+				value = append(value, map[string]string{"systemuserid": member})
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"value": value})
+		})
+
+	changeMembers := func(add bool) httpmock.Responder {
+		return func(req *http.Request) (*http.Response, error) {
+			body := struct {
+				Members []map[string]string `json:"Members"`
+			}{}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			for _, member := range body.Members {
+				if add {
+					members = append(members, member["systemuserid"])
+				} else {
+					members = slices.DeleteFunc(members, func(id string) bool { return id == member["systemuserid"] })
+				}
+			}
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		}
+	}
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000100%29/Microsoft.Dynamics.CRM.AddMembersTeam", changeMembers(true))
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000100%29/Microsoft.Dynamics.CRM.RemoveMembersTeam", changeMembers(false))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_team_membership" "members" {
+					environment_id  = "00000000-0000-0000-0000-000000000001"
+					team_id         = "00000000-0000-0000-0000-000000000100"
+					system_user_ids = ["00000000-0000-0000-0000-000000000201", "00000000-0000-0000-0000-000000000202"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_team_membership.members", "id", "00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("powerplatform_team_membership.members", "system_user_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_team_membership.members", "system_user_ids.*", "00000000-0000-0000-0000-000000000201"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_team_membership.members", "system_user_ids.*", "00000000-0000-0000-0000-000000000202"),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_team_membership" "members" {
+					environment_id  = "00000000-0000-0000-0000-000000000001"
+					team_id         = "00000000-0000-0000-0000-000000000100"
+					system_user_ids = ["00000000-0000-0000-0000-000000000202"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_team_membership.members", "system_user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_team_membership.members", "system_user_ids.*", "00000000-0000-0000-0000-000000000202"),
+					func(s *terraform.State) error {
+						if !slices.Equal(members, []string{"00000000-0000-0000-0000-000000000200", "00000000-0000-0000-0000-000000000202"}) {
+							return fmt.Errorf("unexpected team members %v", members)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+
+	if !slices.Equal(members, []string{"00000000-0000-0000-0000-000000000200"}) {
+		t.Errorf("expected only the unmanaged member to remain, got %v", members)
+	}
+}
@@ -0,0 +1,221 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package authorization_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccTeamResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_security_roles" "all" {
+					environment_id = powerplatform_environment.env.id
+				}
+
+				resource "powerplatform_team" "team" {
+					environment_id = powerplatform_environment.env.id
+					name           = "` + mocks.TestName() + `"
+					security_roles = [
+						one([for role in data.powerplatform_security_roles.all.security_roles : role.role_id if role.name == "Basic User"]),
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_team.team", "id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_team.team", "business_unit_id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_team.team", "team_type", "Owner"),
+					resource.TestCheckResourceAttr("powerplatform_team.team", "security_roles.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitTeamResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var team map[string]any
+	roles := []string{}
+
+	getTeam := func() map[string]any {
+		securityRoles := []map[string]any{}
+		for _, role := range roles {
+			securityRoles = append(securityRoles, map[string]any{
+				"roleid":                role,
+				"name":                  "Role " + role,
+				"ismanaged":             true,
+				"_businessunitid_value": "00000000-0000-0000-0000-000000000020",
+			})
+		}
+		team["teamroles_association"] = securityRoles
+		return team
+	}
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/team/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/businessunits?%24filter=_parentbusinessunitid_value+eq+null&%24select=businessunitid",
+		httpmock.NewStringResponder(http.StatusOK, `{"value":[{"businessunitid":"00000000-0000-0000-0000-000000000020"}]}`))
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/team/Validate_Create_And_Update/get_security_roles.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams",
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string]any{}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			if body["businessunitid@odata.bind"] != "/businessunits(00000000-0000-0000-0000-000000000020)" || body["teamtype"] != float64(1) {
+				return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected team"), nil
+			}
+			team = map[string]any{
+				"teamid":                "00000000-0000-0000-0000-000000000100",
+				"name":                  body["name"],
+				"description":           body["description"],
+				"teamtype":              1,
+				"_businessunitid_value": "00000000-0000-0000-0000-000000000020",
+			}
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Add("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams(00000000-0000-0000-0000-000000000100)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000100%29?%24expand=teamroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24select=teamid%2Cname%2Cdescription%2Cteamtype%2Cmembershiptype%2Cazureactivedirectoryobjectid%2C_businessunitid_value",
+		func(req *http.Request) (*http.Response, error) {
+			if team == nil {
+				return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, getTeam())
+		})
+
+	httpmock.RegisterResponder("PATCH", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000100%29",
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string]any{}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			team["name"] = body["name"]
+			team["description"] = body["description"]
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000100%29/teamroles_association/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			role := map[string]string{}
+			_ = json.NewDecoder(req.Body).Decode(&role)
+			roleId := strings.TrimSuffix(strings.TrimPrefix(role["@odata.id"], "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/roles("), ")")
+			roles = append(roles, roleId)
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterRegexpResponder("DELETE", regexp.MustCompile(`^https://00000000-0000-0000-0000-000000000001\.crm4\.dynamics\.com/api/data/v9\.2/teams%2800000000-0000-0000-0000-000000000100%29/teamroles_association/\$ref\?%24id=.*roles%28(.+)%29$`),
+		func(req *http.Request) (*http.Response, error) {
+			roleId := httpmock.MustGetSubmatch(req, 1)
+			roles = slices.DeleteFunc(roles, func(role string) bool { return role == roleId })
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/teams%2800000000-0000-0000-0000-000000000100%29",
+		func(req *http.Request) (*http.Response, error) {
+			team = nil
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_team" "team" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Sales"
+					description    = "Sales people"
+					team_type      = "Access"
+					security_roles = ["00000000-0000-0000-0000-000000000030"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_team.team", "id", "00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("powerplatform_team.team", "name", "Sales"),
+					resource.TestCheckResourceAttr("powerplatform_team.team", "description", "Sales people"),
+					resource.TestCheckResourceAttr("powerplatform_team.team", "team_type", "Access"),
+					resource.TestCheckResourceAttr("powerplatform_team.team", "business_unit_id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckNoResourceAttr("powerplatform_team.team", "entra_group_id"),
+					resource.TestCheckNoResourceAttr("powerplatform_team.team", "membership_type"),
+					resource.TestCheckResourceAttr("powerplatform_team.team", "security_roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_team.team", "security_roles.*", "00000000-0000-0000-0000-000000000030"),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_team" "team" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Sales and Marketing"
+					team_type      = "Access"
+					security_roles = ["00000000-0000-0000-0000-000000000031"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_team.team", "id", "00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("powerplatform_team.team", "name", "Sales and Marketing"),
+					resource.TestCheckNoResourceAttr("powerplatform_team.team", "description"),
+					resource.TestCheckResourceAttr("powerplatform_team.team", "security_roles.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_team.team", "security_roles.*", "00000000-0000-0000-0000-000000000031"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitTeamResource_Validate_Entra_Group_Required(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_team" "team" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Sales"
+					team_type      = "EntraSecurityGroup"
+				}`,
+				ExpectError: regexp.MustCompile(`entra_group_id is required for teams of type\s+'EntraSecurityGroup'`),
+			},
+			{
+				Config: `
+				resource "powerplatform_team" "team" {
+					environment_id  = "00000000-0000-0000-0000-000000000001"
+					name            = "Sales"
+					membership_type = "Members"
+				}`,
+				ExpectError: regexp.MustCompile(`membership_type can only be set for teams of type`),
+			},
+		},
+	})
+}
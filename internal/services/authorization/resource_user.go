@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -17,13 +18,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/microsoft/terraform-provider-power-platform/internal/api"
-	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers/array"
+	"github.com/microsoft/terraform-provider-power-platform/internal/validators"
 )
 
 var _ resource.Resource = &UserResource{}
@@ -130,6 +132,13 @@ func (r *UserResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Computed: true,
 				Default:  booldefault.StaticBool(true),
 			},
+			"license_wait_timeout": schema.StringAttribute{
+				MarkdownDescription: "A duration, such as `15m`, for which the creation of a Dataverse user is retried while the Entra license assigned to the user has not reached the environment yet. Retries back off exponentially and also stop when the `create` timeout is reached. Defaults to `9m`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.DurationAtLeast(0, "Invalid license wait timeout"),
+				},
+			},
 		},
 	}
 }
@@ -172,7 +181,16 @@ func (r *UserResource) Create(ctx context.Context, req resource.CreateRequest, r
 
 	newUser := userDto{}
 	if hasEnvDataverse {
-		user, err := r.UserClient.CreateDataverseUser(ctx, plan.EnvironmentId.ValueString(), plan.AadId.ValueString())
+		maxLicenseWait := USER_LICENSE_WAIT_DEFAULT_TIMEOUT
+		if value := plan.LicenseWaitTimeout.ValueString(); value != "" {
+			maxLicenseWait, err = time.ParseDuration(value)
+			if err != nil || maxLicenseWait < 0 {
+				resp.Diagnostics.AddAttributeError(path.Root("license_wait_timeout"), "Invalid license wait timeout", fmt.Sprintf("The value '%s' is not a valid duration. Expected a duration such as `15m` or `0s` to not wait.", value))
+				return
+			}
+		}
+
+		user, err := r.UserClient.CreateDataverseUser(ctx, plan.EnvironmentId.ValueString(), plan.AadId.ValueString(), maxLicenseWait)
 		if err != nil {
 			resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
 			return
@@ -245,8 +263,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if hasEnvDataverse {
 		user, err := r.UserClient.GetDataverseUserByAadObjectId(ctx, state.EnvironmentId.ValueString(), state.AadId.ValueString())
 		if err != nil {
-			if customerrors.Code(err) == customerrors.ERROR_OBJECT_NOT_FOUND {
-				resp.State.RemoveResource(ctx)
+			if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
 				return
 			}
 			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
@@ -262,8 +279,7 @@ func (r *UserResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		}
 
 		if err != nil {
-			if customerrors.Code(err) == customerrors.ERROR_OBJECT_NOT_FOUND {
-				resp.State.RemoveResource(ctx)
+			if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
 				return
 			}
 			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
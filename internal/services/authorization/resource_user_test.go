@@ -3,12 +3,14 @@
 package authorization_test
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jarcoal/httpmock"
 	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
@@ -503,6 +505,153 @@ func TestUnitUserResource_Validate_Create_Dataverse_User(t *testing.T) {
 	})
 }
 
+// registerUserNotLicensedMocks registers the mocks of a Dataverse user whose license is picked up after the given number of attempts, and returns the attempt counter.
+func registerUserNotLicensedMocks(licensedAfter int) *int {
+	mocks.ActivateEnvironmentHttpMocks()
+
+	attempts := 0
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/addUser?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			attempts++
+			if licensedAfter < 0 || attempts <= licensedAfter {
+				return httpmock.NewStringResponse(http.StatusBadRequest, `{"error":{"code":"userNotLicensed","message":"The user is not licensed."}}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/user/Validate_Create/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29&%24filter=azureactivedirectoryobjectid+eq+00000000-0000-0000-0000-000000000002",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/user/Validate_Create/get_systemusers.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29/systemuserroles_association/$ref",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29?%24expand=systemuserroles_association%28%24select%3Droleid%2Cname%2Cismanaged%2C_businessunitid_value%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/user/Validate_Create/get_systemuser_00000000-0000-0000-0000-000000000002.json").String()), nil
+		})
+
+	return &attempts
+}
+
+func TestUnitUserResource_Validate_Create_Dataverse_User_Waits_For_License(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	attempts := registerUserNotLicensedMocks(2)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_user" "new_user" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					security_roles = [
+					  "d58407f2-48d5-e711-a82c-000d3a37c848",
+					]
+					aad_id               = "00000000-0000-0000-0000-000000000002"
+					disable_delete       = false
+					license_wait_timeout = "15m"
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("powerplatform_user.new_user", "license_wait_timeout", "15m"),
+				),
+			},
+		},
+	})
+
+	if *attempts != 3 {
+		t.Errorf("expected the user to be created on the third attempt, got %d attempts", *attempts)
+	}
+}
+
+func TestUnitUserResource_Validate_Create_Dataverse_User_License_Wait_Timeout(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	attempts := registerUserNotLicensedMocks(-1)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_user" "new_user" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					aad_id               = "00000000-0000-0000-0000-000000000002"
+					disable_delete       = false
+					license_wait_timeout = "30s"
+				}`,
+				ExpectError: regexp.MustCompile(`the user is still not licensed after waiting 30s`),
+			},
+		},
+	})
+
+	// the delays of 5s and 10s, each with up to 20% of jitter, leave room for one more attempt within 30s.
+	if *attempts != 4 {
+		t.Errorf("expected 4 attempts within the license wait timeout, got %d", *attempts)
+	}
+}
+
+func TestUnitUserResource_Validate_Invalid_License_Wait_Timeout(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	attempts := registerUserNotLicensedMocks(0)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_user" "new_user" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					aad_id               = "00000000-0000-0000-0000-000000000002"
+					license_wait_timeout = "nine minutes"
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid license wait timeout`),
+			},
+		},
+	})
+
+	if *attempts != 0 {
+		t.Errorf("expected no request to create the user, got %d", *attempts)
+	}
+}
+
+func TestUnitUserResource_Validate_Negative_License_Wait_Timeout_On_Plan(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_user" "new_user" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					aad_id               = "00000000-0000-0000-0000-000000000002"
+					license_wait_timeout = "-1m"
+				}`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile(`Invalid license wait timeout`),
+			},
+		},
+	})
+}
+
 func TestUnitUserResource_Validate_Create_And_Force_Recreate_Dataverse_User(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -701,15 +850,37 @@ func TestUnitUserResource_Validate_Disable_Delete(t *testing.T) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/user/Validate_Create/get_systemuser_00000000-0000-0000-0000-000000000002.json").String()), nil
 		})
 
+	deleted := false
 	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29",
 		func(req *http.Request) (*http.Response, error) {
+			deleted = true
 			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
 			return resp, nil
 		})
 
+	// the systemuser keeps resolving for a moment after the delete is accepted.
+	deleteVerificationCount := 0
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers%2800000000-0000-0000-0000-000000000002%29?%24select=systemuserid%2Cisdisabled",
+		func(req *http.Request) (*http.Response, error) {
+			deleteVerificationCount++
+			if deleteVerificationCount < 3 {
+				return httpmock.NewStringResponse(http.StatusOK, `{"systemuserid":"00000000-0000-0000-0000-000000000002","isdisabled":false}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+		})
+
 	resource.Test(t, resource.TestCase{
 		IsUnitTest:               true,
 		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if !deleted {
+				return errors.New("systemuser was not deleted")
+			}
+			if deleteVerificationCount != 3 {
+				return fmt.Errorf("expected delete to be verified 3 times, got %d", deleteVerificationCount)
+			}
+			return nil
+		},
 		Steps: []resource.TestStep{
 			{
 				Config: `
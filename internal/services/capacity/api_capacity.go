@@ -39,3 +39,24 @@ func (client *client) GetTenantCapacity(ctx context.Context, tenantId string) (*
 
 	return &dto, nil
 }
+
+func (client *client) GetEnvironmentCapacity(ctx context.Context, environmentId string) (*environmentCapacityDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.BapiUrl,
+		Path:   fmt.Sprintf("/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/%s", environmentId),
+	}
+	values := url.Values{}
+	values.Add("$expand", "properties.capacity")
+	values.Add("api-version", "2023-06-01")
+	apiUrl.RawQuery = values.Encode()
+
+	var dto environmentCapacityDto
+
+	_, err := client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &dto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto, nil
+}
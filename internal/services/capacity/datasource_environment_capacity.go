@@ -0,0 +1,138 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capacity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var (
+	_ datasource.DataSource              = &EnvironmentCapacityDataSource{}
+	_ datasource.DataSourceWithConfigure = &EnvironmentCapacityDataSource{}
+)
+
+func NewEnvironmentCapacityDataSource() datasource.DataSource {
+	return &EnvironmentCapacityDataSource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "environment_capacity",
+		},
+	}
+}
+
+func (d *EnvironmentCapacityDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *EnvironmentCapacityDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the database, file and log capacity consumed by a given environment. The capacity entitled to the tenant can be fetched with the `powerplatform_tenant_capacity` data source.",
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The environment ID for which the capacity information is to be fetched.",
+			},
+			"capacities": schema.ListNestedAttribute{
+				MarkdownDescription: "The list of capacities consumed by the given environment.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"capacity_type": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The type of the capacity, for example `Database`, `File` or `Log`.",
+						},
+						"capacity_units": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The units of the capacity.",
+						},
+						"actual_consumption": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The actual consumption.",
+						},
+						"rated_consumption": schema.Float64Attribute{
+							Computed:            true,
+							MarkdownDescription: "The rated consumption, which is the consumption counted against the capacity of the tenant.",
+						},
+						"updated_on": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The time the consumption was last updated on.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EnvironmentCapacityDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.CapacityClient = newCapacityClient(client.Api)
+}
+
+func (d *EnvironmentCapacityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var state EnvironmentCapacityDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentCapacityDto, err := d.CapacityClient.GetEnvironmentCapacity(ctx, state.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"error fetching environment capacity",
+			err.Error(),
+		)
+		return
+	}
+
+	state.Capacities = []EnvironmentConsumptionDataSourceModel{}
+	for _, capacity := range environmentCapacityDto.Properties.Capacity {
+		state.Capacities = append(state.Capacities, EnvironmentConsumptionDataSourceModel{
+			CapacityType:      types.StringValue(capacity.CapacityType),
+			CapacityUnits:     types.StringValue(capacity.CapacityUnit),
+			ActualConsumption: types.Float64Value(capacity.ActualConsumption),
+			RatedConsumption:  types.Float64Value(capacity.RatedConsumption),
+			UpdatedOn:         types.StringValue(capacity.UpdatedOn),
+		})
+	}
+
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
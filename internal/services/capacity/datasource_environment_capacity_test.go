@@ -0,0 +1,106 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package capacity_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestUnitEnvironmentCapacityDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=properties.capacity&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Environment_Capacity_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_environment_capacity" "capacity" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_environment_capacity.capacity", "environment_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_capacity.capacity", "capacities.#", "3"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_capacity.capacity", "capacities.0.capacity_type", "Database"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_capacity.capacity", "capacities.0.capacity_units", "MB"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_capacity.capacity", "capacities.0.actual_consumption", "885.0391"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_capacity.capacity", "capacities.0.rated_consumption", "1024"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_capacity.capacity", "capacities.0.updated_on", "2023-10-10T03:00:35Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_capacity.capacity", "capacities.1.capacity_type", "File"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_capacity.capacity", "capacities.2.capacity_type", "Log"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_capacity.capacity", "capacities.2.actual_consumption", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentCapacityDataSource_Validate_Environment_Not_Found(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=properties.capacity&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusNotFound, `{"error":{"code":"EnvironmentNotFound","message":"The environment was not found"}}`)
+			resp.Request = req
+			return resp, nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_environment_capacity" "capacity" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				ExpectError: regexp.MustCompile(`resource not found at`),
+			},
+		},
+	})
+}
+
+func TestAccEnvironmentCapacityDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_environment_capacity" "capacity" {
+					environment_id = powerplatform_environment.env.id
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.powerplatform_environment_capacity.capacity", "capacities.0.capacity_type"),
+					resource.TestCheckResourceAttrSet("data.powerplatform_environment_capacity.capacity", "capacities.0.actual_consumption"),
+				),
+			},
+		},
+	})
+}
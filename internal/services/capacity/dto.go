@@ -24,3 +24,20 @@ type consumptionDto struct {
 	ActualUpdatedOn string  `json:"actualUpdatedOn"`
 	RatedUpdatedOn  string  `json:"ratedUpdatedOn"`
 }
+
+type environmentCapacityDto struct {
+	Name       string                           `json:"name"`
+	Properties environmentCapacityPropertiesDto `json:"properties"`
+}
+
+type environmentCapacityPropertiesDto struct {
+	Capacity []environmentConsumptionDto `json:"capacity"`
+}
+
+type environmentConsumptionDto struct {
+	CapacityType      string  `json:"capacityType"`
+	ActualConsumption float64 `json:"actualConsumption"`
+	RatedConsumption  float64 `json:"ratedConsumption"`
+	CapacityUnit      string  `json:"capacityUnit"`
+	UpdatedOn         string  `json:"updatedOn"`
+}
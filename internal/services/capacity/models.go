@@ -34,3 +34,21 @@ type ConsumptionDataSourceModel struct {
 	ActualUpdatedOn types.String  `tfsdk:"actual_updated_on"`
 	RatedUpdatedOn  types.String  `tfsdk:"rated_updated_on"`
 }
+
+type EnvironmentCapacityDataSource struct {
+	helpers.TypeInfo
+	CapacityClient client
+}
+
+type EnvironmentCapacityDataSourceModel struct {
+	EnvironmentId types.String                            `tfsdk:"environment_id"`
+	Capacities    []EnvironmentConsumptionDataSourceModel `tfsdk:"capacities"`
+}
+
+type EnvironmentConsumptionDataSourceModel struct {
+	CapacityType      types.String  `tfsdk:"capacity_type"`
+	CapacityUnits     types.String  `tfsdk:"capacity_units"`
+	ActualConsumption types.Float64 `tfsdk:"actual_consumption"`
+	RatedConsumption  types.Float64 `tfsdk:"rated_consumption"`
+	UpdatedOn         types.String  `tfsdk:"updated_on"`
+}
@@ -91,7 +91,7 @@ func (client *client) GetConnection(ctx context.Context, environmentId, connecto
 	connection := connectionDto{}
 	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &connection)
 	if err != nil {
-		if strings.Contains(err.Error(), "ConnectionNotFound") {
+		if customerrors.ApiErrorCode(err) == "ConnectionNotFound" {
 			return nil, customerrors.WrapIntoProviderError(err, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("Connection '%s' not found", connectionId))
 		}
 		return nil, err
@@ -80,12 +80,14 @@ func (d *ConnectionsDataSource) Schema(ctx context.Context, req datasource.Schem
 							Computed:            true,
 						},
 						"connection_parameters": schema.StringAttribute{
-							MarkdownDescription: "Connection parameters. Json string containing the authentication connection parameters (if connection is interactive, leave blank), (for example)[https://learn.microsoft.com/en-us/power-automate/desktop-flows/alm/alm-connection#create-a-connection-using-your-service-principal]. Depending on required authentication parameters of a given connector, the connection parameters can vary.",
+							MarkdownDescription: "Connection parameters. Json string containing the authentication connection parameters (if connection is interactive, leave blank), (for example)[https://learn.microsoft.com/en-us/power-automate/desktop-flows/alm/alm-connection#create-a-connection-using-your-service-principal]. Depending on required authentication parameters of a given connector, the connection parameters can vary. The value is sensitive, as the parameters usually contain secrets such as passwords or client secrets.",
 							Computed:            true,
+							Sensitive:           true,
 						},
 						"connection_parameters_set": schema.StringAttribute{
-							MarkdownDescription: "Set of connection parameters. Json string containing the authentication connection parameters (if connection is interactive, leave blank), (for example)[https://learn.microsoft.com/en-us/power-automate/desktop-flows/alm/alm-connection#create-a-connection-using-your-service-principal]. Depending on required authentication parameters of a given connector, the connection parameters can vary.",
+							MarkdownDescription: "Set of connection parameters. Json string containing the authentication connection parameters (if connection is interactive, leave blank), (for example)[https://learn.microsoft.com/en-us/power-automate/desktop-flows/alm/alm-connection#create-a-connection-using-your-service-principal]. Depending on required authentication parameters of a given connector, the connection parameters can vary. The value is sensitive, as the parameters usually contain secrets such as passwords or client secrets.",
 							Computed:            true,
+							Sensitive:           true,
 						},
 					},
 				},
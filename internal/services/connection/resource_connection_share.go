@@ -86,7 +86,7 @@ func (r *ShareResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"role_name": schema.StringAttribute{
-				MarkdownDescription: "Name of the role to assign to the principal",
+				MarkdownDescription: "Name of the role to assign to the principal. `CanView` is shown as *Can use* in the maker portal, `CanViewWithShare` as *Can use + share* and `CanEdit` as *Can edit*",
 				Required:            true,
 				Computed:            false,
 				Validators: []validator.String{
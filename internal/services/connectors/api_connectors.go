@@ -5,12 +5,14 @@ package connectors
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strings"
 
 	"github.com/microsoft/terraform-provider-power-platform/internal/api"
 	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
 )
 
 func newConnectorsClient(apiClient *api.Client) client {
@@ -95,3 +97,66 @@ func (client *client) GetConnectors(ctx context.Context) ([]connectorDto, error)
 
 	return connectorArray.Value, nil
 }
+
+func (client *client) customConnectorUrl(environmentId, connectorName string) *url.URL {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.PowerAppsUrl,
+		Path:   "/providers/Microsoft.PowerApps/apis",
+	}
+	if connectorName != "" {
+		apiUrl.Path = fmt.Sprintf("%s/%s", apiUrl.Path, connectorName)
+	}
+	values := url.Values{}
+	values.Add("api-version", CUSTOM_CONNECTOR_API_VERSION)
+	values.Add("$filter", fmt.Sprintf("environment eq '%s'", environmentId))
+	apiUrl.RawQuery = values.Encode()
+	return apiUrl
+}
+
+func (client *client) CreateCustomConnector(ctx context.Context, environmentId string, connectorToCreate customConnectorDto) (*customConnectorDto, error) {
+	apiUrl := client.customConnectorUrl(environmentId, "")
+
+	connector := customConnectorDto{}
+	_, err := client.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, connectorToCreate, []int{http.StatusOK, http.StatusCreated}, &connector)
+	if err != nil {
+		return nil, err
+	}
+	return &connector, nil
+}
+
+func (client *client) UpdateCustomConnector(ctx context.Context, environmentId, connectorName string, connectorToUpdate customConnectorDto) (*customConnectorDto, error) {
+	apiUrl := client.customConnectorUrl(environmentId, connectorName)
+
+	connector := customConnectorDto{}
+	_, err := client.Api.Execute(ctx, nil, "PATCH", apiUrl.String(), nil, connectorToUpdate, []int{http.StatusOK}, &connector)
+	if err != nil {
+		return nil, err
+	}
+	return &connector, nil
+}
+
+// GetCustomConnector returns the custom connector together with the OpenAPI definition it was registered with.
+func (client *client) GetCustomConnector(ctx context.Context, environmentId, connectorName string) (*customConnectorDto, error) {
+	apiUrl := client.customConnectorUrl(environmentId, connectorName)
+	values := apiUrl.Query()
+	values.Add("export", "true")
+	apiUrl.RawQuery = values.Encode()
+
+	connector := customConnectorDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &connector)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("Custom connector '%s' not found", connectorName))
+	}
+	return &connector, nil
+}
+
+func (client *client) DeleteCustomConnector(ctx context.Context, environmentId, connectorName string) error {
+	apiUrl := client.customConnectorUrl(environmentId, connectorName)
+
+	_, err := client.Api.Execute(ctx, nil, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusNoContent, http.StatusNotFound}, nil)
+	return err
+}
@@ -86,6 +86,26 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 							MarkdownDescription: "Indicates if the connector can be blocked in a Data Loss Prevention policy. If true, the connector has to be in 'Non-Business' connectors group.",
 							Computed:            true,
 						},
+						"release_tag": schema.StringAttribute{
+							MarkdownDescription: "Release tier of the connector, for example `Production` or `Preview`",
+							Computed:            true,
+						},
+						"is_custom_api": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if the connector is a custom connector",
+							Computed:            true,
+						},
+						"source": schema.StringAttribute{
+							MarkdownDescription: "Source the connector is distributed from, for example `marketplace` for connectors published through the [connector certification](https://learn.microsoft.com/connectors/custom-connectors/submit-certification) program",
+							Computed:            true,
+						},
+						"certified": schema.BoolAttribute{
+							MarkdownDescription: "Indicates if the connector is a certified connector, that is a connector that is not custom and is distributed from the `marketplace` source. Certified connectors include both Microsoft and verified or independent publisher connectors, use `publisher` to tell them apart.",
+							Computed:            true,
+						},
+						"swagger_url": schema.StringAttribute{
+							MarkdownDescription: "Url of the OpenAPI (swagger) definition of the connector",
+							Computed:            true,
+						},
 					},
 				},
 			},
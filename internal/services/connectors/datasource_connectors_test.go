@@ -33,6 +33,7 @@ func TestAccConnectorsDataSource_Validate_Read(t *testing.T) {
 					resource.TestMatchResourceAttr("data.powerplatform_connectors.all", "connectors.0.publisher", regexp.MustCompile(helpers.StringRegex)),
 					resource.TestMatchResourceAttr("data.powerplatform_connectors.all", "connectors.0.tier", regexp.MustCompile(helpers.StringRegex)),
 					resource.TestMatchResourceAttr("data.powerplatform_connectors.all", "connectors.0.type", regexp.MustCompile(helpers.ApiIdRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_connectors.all", "connectors.0.release_tag", regexp.MustCompile(helpers.StringRegex)),
 				),
 			},
 		},
@@ -78,6 +79,11 @@ func TestUnitConnectorsDataSource_Validate_Read(t *testing.T) {
 					resource.TestCheckResourceAttr("data.powerplatform_connectors.all", "connectors.0.publisher", "Microsoft"),
 					resource.TestCheckResourceAttr("data.powerplatform_connectors.all", "connectors.0.tier", "Standard"),
 					resource.TestCheckResourceAttr("data.powerplatform_connectors.all", "connectors.0.type", "Microsoft.PowerApps/apis"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.all", "connectors.0.release_tag", "Production"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.all", "connectors.0.is_custom_api", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.all", "connectors.0.source", "marketplace"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.all", "connectors.0.certified", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_connectors.all", "connectors.0.swagger_url", "https://paeu2weu8.blob.core.windows.net/api-swagger-files/sharepointonline.json_original"),
 				),
 			},
 		},
@@ -11,11 +11,24 @@ type connectorDto struct {
 }
 
 type connectorPropertiesDto struct {
-	DisplayName string `json:"displayName"`
-	Description string `json:"description"`
-	Tier        string `json:"tier"`
-	Publisher   string `json:"publisher"`
-	Unblockable bool
+	DisplayName    string                     `json:"displayName"`
+	Description    string                     `json:"description"`
+	Tier           string                     `json:"tier"`
+	Publisher      string                     `json:"publisher"`
+	ReleaseTag     string                     `json:"releaseTag"`
+	IsCustomApi    bool                       `json:"isCustomApi"`
+	Metadata       connectorMetadataDto       `json:"metadata"`
+	ApiDefinitions connectorApiDefinitionsDto `json:"apiDefinitions"`
+	Unblockable    bool
+}
+
+type connectorMetadataDto struct {
+	Source string `json:"source"`
+}
+
+type connectorApiDefinitionsDto struct {
+	OriginalSwaggerUrl string `json:"originalSwaggerUrl"`
+	ModifiedSwaggerUrl string `json:"modifiedSwaggerUrl"`
 }
 
 type connectorArrayDto struct {
@@ -42,3 +55,41 @@ type virtualConnectorMetadataDto struct {
 	Type             string `json:"type"`
 	DisplayName      string `json:"displayName"`
 }
+
+type customConnectorDto struct {
+	Name       string                       `json:"name,omitempty"`
+	Id         string                       `json:"id,omitempty"`
+	Properties customConnectorPropertiesDto `json:"properties"`
+}
+
+type customConnectorPropertiesDto struct {
+	DisplayName             string                           `json:"displayName"`
+	Description             string                           `json:"description,omitempty"`
+	IconUri                 string                           `json:"iconUri,omitempty"`
+	IconBrandColor          string                           `json:"iconBrandColor,omitempty"`
+	Environment             *customConnectorEnvironmentDto   `json:"environment,omitempty"`
+	BackendService          customConnectorBackendServiceDto `json:"backendService"`
+	OpenApiDefinition       map[string]any                   `json:"openApiDefinition,omitempty"`
+	Swagger                 map[string]any                   `json:"swagger,omitempty"`
+	ConnectionParameters    map[string]any                   `json:"connectionParameters,omitempty"`
+	PolicyTemplateInstances []any                            `json:"policyTemplateInstances,omitempty"`
+	Capabilities            []any                            `json:"capabilities,omitempty"`
+}
+
+type customConnectorEnvironmentDto struct {
+	Name string `json:"name"`
+}
+
+type customConnectorBackendServiceDto struct {
+	ServiceUrl string `json:"serviceUrl"`
+}
+
+// customConnectorApiPropertiesDto is the content of the apiProperties.json file of a connector, as used by the paconn CLI.
+type customConnectorApiPropertiesDto struct {
+	Properties struct {
+		ConnectionParameters    map[string]any `json:"connectionParameters,omitempty"`
+		PolicyTemplateInstances []any          `json:"policyTemplateInstances,omitempty"`
+		Capabilities            []any          `json:"capabilities,omitempty"`
+		IconBrandColor          string         `json:"iconBrandColor,omitempty"`
+	} `json:"properties"`
+}
@@ -4,16 +4,41 @@
 package connectors
 
 import (
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customtypes"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 )
 
+const CONNECTOR_SOURCE_MARKETPLACE = "marketplace"
+
+const CUSTOM_CONNECTOR_API_VERSION = "2016-11-01"
+
 type DataSource struct {
 	helpers.TypeInfo
 	ConnectorsClient client
 }
 
+type Resource struct {
+	helpers.TypeInfo
+	ConnectorsClient client
+}
+
+type ResourceModel struct {
+	Timeouts          timeouts.Value   `tfsdk:"timeouts"`
+	Id                types.String     `tfsdk:"id"`
+	EnvironmentId     types.String     `tfsdk:"environment_id"`
+	DisplayName       types.String     `tfsdk:"display_name"`
+	Description       types.String     `tfsdk:"description"`
+	OpenApiDefinition customtypes.JSON `tfsdk:"openapi_definition"`
+	ApiProperties     customtypes.JSON `tfsdk:"api_properties"`
+	IconUri           types.String     `tfsdk:"icon_uri"`
+	IconBrandColor    types.String     `tfsdk:"icon_brand_color"`
+	BackendServiceUrl types.String     `tfsdk:"backend_service_url"`
+}
+
 type ListDataSourceModel struct {
 	Timeouts   timeouts.Value    `tfsdk:"timeouts"`
 	Connectors []DataSourceModel `tfsdk:"connectors"`
@@ -28,6 +53,11 @@ type DataSourceModel struct {
 	Tier        types.String `tfsdk:"tier"`
 	Publisher   types.String `tfsdk:"publisher"`
 	Unblockable types.Bool   `tfsdk:"unblockable"`
+	ReleaseTag  types.String `tfsdk:"release_tag"`
+	IsCustomApi types.Bool   `tfsdk:"is_custom_api"`
+	Source      types.String `tfsdk:"source"`
+	Certified   types.Bool   `tfsdk:"certified"`
+	SwaggerUrl  types.String `tfsdk:"swagger_url"`
 }
 
 func convertFromConnectorDto(connectorDto connectorDto) DataSourceModel {
@@ -40,5 +70,16 @@ func convertFromConnectorDto(connectorDto connectorDto) DataSourceModel {
 		Tier:        types.StringValue(connectorDto.Properties.Tier),
 		Publisher:   types.StringValue(connectorDto.Properties.Publisher),
 		Unblockable: types.BoolValue(connectorDto.Properties.Unblockable),
+		ReleaseTag:  types.StringValue(connectorDto.Properties.ReleaseTag),
+		IsCustomApi: types.BoolValue(connectorDto.Properties.IsCustomApi),
+		Source:      types.StringValue(connectorDto.Properties.Metadata.Source),
+		Certified:   types.BoolValue(isCertifiedConnector(connectorDto)),
+		SwaggerUrl:  types.StringValue(connectorDto.Properties.ApiDefinitions.OriginalSwaggerUrl),
 	}
 }
+
+// isCertifiedConnector reports whether the connector has been published through the connector certification program.
+// Certified connectors are distributed through the connector marketplace, while custom connectors are owned by an environment.
+func isCertifiedConnector(connectorDto connectorDto) bool {
+	return !connectorDto.Properties.IsCustomApi && strings.EqualFold(connectorDto.Properties.Metadata.Source, CONNECTOR_SOURCE_MARKETPLACE)
+}
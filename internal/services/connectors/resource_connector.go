@@ -0,0 +1,329 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package connectors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customtypes"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &Resource{}
+var _ resource.ResourceWithImportState = &Resource{}
+
+func NewConnectorResource() resource.Resource {
+	return &Resource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "connector",
+		},
+	}
+}
+
+func (r *Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a [custom connector](https://learn.microsoft.com/connectors/custom-connectors/) of an environment, created from an OpenAPI 2.0 (swagger) definition. The definition and the API properties use the same format as the `apiDefinition.swagger.json` and `apiProperties.json` files of the [paconn CLI](https://learn.microsoft.com/connectors/custom-connectors/paconn-cli). Changes made to the definition outside of Terraform are detected by comparing the normalized JSON documents, so that the definition returned by the service in a different format doesn't show as a change.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Name of the custom connector, for example `shared_contoso-20api-5f8d1a2b3c4d5e6f7a`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment the custom connector is created in",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the custom connector",
+				Required:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: "Description of the custom connector",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+			},
+			"openapi_definition": schema.StringAttribute{
+				MarkdownDescription: "OpenAPI 2.0 definition of the API, as a JSON string. The `host`, `basePath` and `schemes` of the definition give the url of the backend service",
+				Required:            true,
+				CustomType:          customtypes.JSONType{},
+			},
+			"api_properties": schema.StringAttribute{
+				MarkdownDescription: "API properties of the connector, as a JSON string with a top level `properties` object holding the `connectionParameters`, `policyTemplateInstances`, `capabilities` and `iconBrandColor` of the connector. The API properties are not read back, so changes made outside of Terraform are not detected. The attribute is sensitive because the connection parameters can hold OAuth client secrets",
+				Optional:            true,
+				Sensitive:           true,
+				CustomType:          customtypes.JSONType{},
+			},
+			"icon_uri": schema.StringAttribute{
+				MarkdownDescription: "Url of the icon of the custom connector. A default icon is used when it is not set",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"icon_brand_color": schema.StringAttribute{
+				MarkdownDescription: "Background color of the icon of the custom connector, for example `#007ee5`. Defaults to the `iconBrandColor` of `api_properties`",
+				Optional:            true,
+				Computed:            true,
+			},
+			"backend_service_url": schema.StringAttribute{
+				MarkdownDescription: "Url of the backend service, built from the `schemes`, `host` and `basePath` of the OpenAPI definition",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.ConnectorsClient = newConnectorsClient(client.Api)
+}
+
+func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectorToCreate, diags := convertToCustomConnectorDto(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	connectorToCreate.Properties.Environment = &customConnectorEnvironmentDto{
+		Name: plan.EnvironmentId.ValueString(),
+	}
+
+	connector, err := r.ConnectorsClient.CreateCustomConnector(ctx, plan.EnvironmentId.ValueString(), *connectorToCreate)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromCustomConnectorDto(connector, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connector, err := r.ConnectorsClient.GetCustomConnector(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromCustomConnectorDto(connector, state)
+
+	// the definition is compared semantically with the one in state, so only actual changes to the definition show as drift.
+	if connector.Properties.Swagger != nil {
+		definition, err := json.Marshal(connector.Properties.Swagger)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+			return
+		}
+		state.OpenApiDefinition = customtypes.NewJSONValue(string(definition))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *ResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectorToUpdate, diags := convertToCustomConnectorDto(plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connector, err := r.ConnectorsClient.UpdateCustomConnector(ctx, plan.EnvironmentId.ValueString(), plan.Id.ValueString(), *connectorToUpdate)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromCustomConnectorDto(connector, plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *ResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.ConnectorsClient.DeleteCustomConnector(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+}
+
+// ImportState imports a custom connector by its environment id and name, separated by a slash: `<environment_id>/<connector_name>`.
+func (r *Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	environmentId, connectorName, found := strings.Cut(req.ID, "/")
+	if !found || environmentId == "" || connectorName == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>/<connector_name>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), connectorName)...)
+}
+
+func convertToCustomConnectorDto(plan *ResourceModel) (*customConnectorDto, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	definition := map[string]any{}
+	diags.Append(plan.OpenApiDefinition.Unmarshal(&definition)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	serviceUrl, err := backendServiceUrl(definition)
+	if err != nil {
+		diags.AddAttributeError(path.Root("openapi_definition"), "Invalid OpenAPI definition", err.Error())
+		return nil, diags
+	}
+
+	connector := customConnectorDto{
+		Properties: customConnectorPropertiesDto{
+			DisplayName:       plan.DisplayName.ValueString(),
+			Description:       plan.Description.ValueString(),
+			IconUri:           plan.IconUri.ValueString(),
+			IconBrandColor:    plan.IconBrandColor.ValueString(),
+			OpenApiDefinition: definition,
+			BackendService: customConnectorBackendServiceDto{
+				ServiceUrl: serviceUrl,
+			},
+		},
+	}
+
+	if !plan.ApiProperties.IsNull() && !plan.ApiProperties.IsUnknown() {
+		apiProperties := customConnectorApiPropertiesDto{}
+		diags.Append(plan.ApiProperties.Unmarshal(&apiProperties)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+		connector.Properties.ConnectionParameters = apiProperties.Properties.ConnectionParameters
+		connector.Properties.PolicyTemplateInstances = apiProperties.Properties.PolicyTemplateInstances
+		connector.Properties.Capabilities = apiProperties.Properties.Capabilities
+		if connector.Properties.IconBrandColor == "" {
+			connector.Properties.IconBrandColor = apiProperties.Properties.IconBrandColor
+		}
+	}
+	return &connector, diags
+}
+
+func convertFromCustomConnectorDto(connector *customConnectorDto, model *ResourceModel) {
+	model.Id = types.StringValue(connector.Name)
+	model.DisplayName = types.StringValue(connector.Properties.DisplayName)
+	model.Description = types.StringValue(connector.Properties.Description)
+	model.IconUri = types.StringValue(connector.Properties.IconUri)
+	model.IconBrandColor = types.StringValue(connector.Properties.IconBrandColor)
+	model.BackendServiceUrl = types.StringValue(connector.Properties.BackendService.ServiceUrl)
+}
+
+// backendServiceUrl builds the url of the backend service from the first scheme, the host and the base path of the OpenAPI definition.
+func backendServiceUrl(definition map[string]any) (string, error) {
+	host, _ := definition["host"].(string)
+	if host == "" {
+		return "", errors.New("the OpenAPI definition must set the 'host' of the API")
+	}
+
+	scheme := "https"
+	if schemes, ok := definition["schemes"].([]any); ok && len(schemes) > 0 {
+		if s, ok := schemes[0].(string); ok && s != "" {
+			scheme = s
+		}
+	}
+
+	basePath, _ := definition["basePath"].(string)
+	return fmt.Sprintf("%s://%s%s", scheme, host, strings.TrimSuffix(basePath, "/")), nil
+}
@@ -0,0 +1,262 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package connectors_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+const customConnectorApisUrl = `https://api.powerapps.com/providers/Microsoft.PowerApps/apis`
+const customConnectorQuery = `?%24filter=environment+eq+%2700000000-0000-0000-0000-000000000001%27&api-version=2016-11-01`
+const customConnectorName = "shared_contoso-20api-5f8d1a2b3c4d5e6f7a"
+
+// registerCustomConnectorMocks stores the custom connector created or updated by the requests and returns it with its definition.
+func registerCustomConnectorMocks(connector map[string]any) {
+	properties := func() map[string]any {
+		return connector["properties"].(map[string]any)
+	}
+
+	httpmock.RegisterResponder("POST", customConnectorApisUrl+customConnectorQuery,
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string]any{}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			connector["name"] = customConnectorName
+			connector["properties"] = body["properties"]
+			if _, ok := properties()["iconUri"]; !ok {
+				properties()["iconUri"] = "https://connectoricons-prod.azureedge.net/default/icon.png"
+			}
+			return httpmock.NewJsonResponse(http.StatusCreated, connector)
+		})
+
+	httpmock.RegisterResponder("PATCH", customConnectorApisUrl+"/"+customConnectorName+customConnectorQuery,
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string]any{}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			iconUri := properties()["iconUri"]
+			connector["properties"] = body["properties"]
+			if _, ok := properties()["iconUri"]; !ok {
+				properties()["iconUri"] = iconUri
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, connector)
+		})
+
+	httpmock.RegisterResponder("GET", customConnectorApisUrl+"/"+customConnectorName+customConnectorQuery+"&export=true",
+		func(req *http.Request) (*http.Response, error) {
+			if connector["name"] == nil {
+				return httpmock.NewStringResponse(http.StatusNotFound, `{"error":{"code":"ApiNotFound","message":"Could not find api"}}`), nil
+			}
+			response := map[string]any{
+				"name": connector["name"],
+				"properties": map[string]any{
+					"displayName":    properties()["displayName"],
+					"description":    properties()["description"],
+					"iconUri":        properties()["iconUri"],
+					"iconBrandColor": properties()["iconBrandColor"],
+					"backendService": properties()["backendService"],
+					"swagger":        properties()["openApiDefinition"],
+				},
+			}
+			return httpmock.NewJsonResponse(http.StatusOK, response)
+		})
+
+	httpmock.RegisterResponder("DELETE", customConnectorApisUrl+"/"+customConnectorName+customConnectorQuery,
+		func(req *http.Request) (*http.Response, error) {
+			delete(connector, "name")
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+}
+
+const customConnectorDefinition = `
+					openapi_definition = jsonencode({
+						swagger  = "2.0"
+						info     = { title = "Contoso API", version = "1.0" }
+						host     = "api.contoso.com"
+						basePath = "/v1/"
+						schemes  = ["https"]
+						paths = {
+							"/orders" = {
+								get = { operationId = "ListOrders", responses = { "200" = { description = "OK" } } }
+							}
+						}
+					})`
+
+func TestUnitConnectorResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	connector := map[string]any{}
+	registerCustomConnectorMocks(connector)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_connector" "contoso" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					display_name   = "Contoso API"
+					` + customConnectorDefinition + `
+					api_properties = jsonencode({
+						properties = {
+							iconBrandColor = "#da3b01"
+							connectionParameters = {
+								api_key = { type = "securestring", uiDefinition = { displayName = "API Key" } }
+							}
+							policyTemplateInstances = []
+						}
+					})
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_connector.contoso", "id", customConnectorName),
+					resource.TestCheckResourceAttr("powerplatform_connector.contoso", "display_name", "Contoso API"),
+					resource.TestCheckResourceAttr("powerplatform_connector.contoso", "description", ""),
+					resource.TestCheckResourceAttr("powerplatform_connector.contoso", "backend_service_url", "https://api.contoso.com/v1"),
+					resource.TestCheckResourceAttr("powerplatform_connector.contoso", "icon_brand_color", "#da3b01"),
+					resource.TestCheckResourceAttr("powerplatform_connector.contoso", "icon_uri", "https://connectoricons-prod.azureedge.net/default/icon.png"),
+					func(s *terraform.State) error {
+						properties := connector["properties"].(map[string]any)
+						if properties["environment"].(map[string]any)["name"] != "00000000-0000-0000-0000-000000000001" {
+							return fmt.Errorf("expected the connector to be created in the environment, got %v", properties["environment"])
+						}
+						if _, ok := properties["connectionParameters"].(map[string]any)["api_key"]; !ok {
+							return fmt.Errorf("expected the connection parameters of the api properties, got %v", properties["connectionParameters"])
+						}
+						if properties["openApiDefinition"].(map[string]any)["host"] != "api.contoso.com" {
+							return fmt.Errorf("expected the OpenAPI definition, got %v", properties["openApiDefinition"])
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// the definition read back is compared with the one formatted differently in the configuration, the refresh after apply must not show a change.
+				Config: `
+				resource "powerplatform_connector" "contoso" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					display_name   = "Contoso API"
+					openapi_definition = <<-EOT
+						{
+							"schemes": ["https"],
+							"basePath": "/v1/",
+							"host": "api.contoso.com",
+							"info": { "version": "1.0", "title": "Contoso API" },
+							"paths": { "/orders": { "get": { "responses": { "200": { "description": "OK" } }, "operationId": "ListOrders" } } },
+							"swagger": "2.0"
+						}
+					EOT
+					api_properties = jsonencode({
+						properties = {
+							iconBrandColor = "#da3b01"
+							connectionParameters = {
+								api_key = { type = "securestring", uiDefinition = { displayName = "API Key" } }
+							}
+							policyTemplateInstances = []
+						}
+					})
+				}`,
+				Check: resource.TestCheckResourceAttr("powerplatform_connector.contoso", "backend_service_url", "https://api.contoso.com/v1"),
+			},
+			{
+				Config: `
+				resource "powerplatform_connector" "contoso" {
+					environment_id   = "00000000-0000-0000-0000-000000000001"
+					display_name     = "Contoso Orders"
+					description      = "Orders of Contoso"
+					icon_brand_color = "#007ee5"
+					` + customConnectorDefinition + `
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_connector.contoso", "id", customConnectorName),
+					resource.TestCheckResourceAttr("powerplatform_connector.contoso", "display_name", "Contoso Orders"),
+					resource.TestCheckResourceAttr("powerplatform_connector.contoso", "description", "Orders of Contoso"),
+					resource.TestCheckResourceAttr("powerplatform_connector.contoso", "icon_brand_color", "#007ee5"),
+					resource.TestCheckResourceAttr("powerplatform_connector.contoso", "icon_uri", "https://connectoricons-prod.azureedge.net/default/icon.png"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitConnectorResource_Validate_Definition_Drift(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	connector := map[string]any{}
+	registerCustomConnectorMocks(connector)
+
+	config := `
+	resource "powerplatform_connector" "contoso" {
+		environment_id = "00000000-0000-0000-0000-000000000001"
+		display_name   = "Contoso API"
+		` + customConnectorDefinition + `
+	}`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check:  resource.TestCheckResourceAttr("powerplatform_connector.contoso", "id", customConnectorName),
+			},
+			{
+				PreConfig: func() {
+					definition := connector["properties"].(map[string]any)["openApiDefinition"].(map[string]any)
+					definition["host"] = "api.fabrikam.com"
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestUnitConnectorResource_Validate_Missing_Host(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_connector" "contoso" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					display_name       = "Contoso API"
+					openapi_definition = jsonencode({ swagger = "2.0", paths = {} })
+				}`,
+				ExpectError: regexp.MustCompile(`the OpenAPI definition must set the 'host' of the API`),
+			},
+		},
+	})
+}
+
+func TestUnitConnectorResource_Validate_Invalid_Definition(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_connector" "contoso" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					display_name       = "Contoso API"
+					openapi_definition = "{ swagger: 2.0"
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid JSON String Value`),
+			},
+		},
+	})
+}
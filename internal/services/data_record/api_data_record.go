@@ -37,21 +37,15 @@ func getEntityDefinition(ctx context.Context, client *client, environmentId, ent
 	entityDefinitionApiUrl := &url.URL{
 		Scheme:   constants.HTTPS,
 		Host:     environmentHost,
-		Path:     fmt.Sprintf("/api/data/%s/EntityDefinitions(LogicalName='%s')", constants.DATAVERSE_API_VERSION, entityLogicalName),
+		Path:     fmt.Sprintf("/api/data/%s/EntityDefinitions(LogicalName='%s')", client.Api.GetConfig().GetDataverseApiVersion(), entityLogicalName),
 		Fragment: "$select=PrimaryIdAttribute,LogicalCollectionName",
 	}
 
 	entityDefinition := entityDefinitionsDto{}
-	resp, err := client.Api.Execute(ctx, nil, "GET", entityDefinitionApiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &entityDefinition)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", entityDefinitionApiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &entityDefinition)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 
 	return &entityDefinition, nil
 }
@@ -103,19 +97,13 @@ func (client *client) GetDataRecordsByODataQuery(ctx context.Context, environmen
 		h.Add(k, v)
 	}
 
-	apiUrl := fmt.Sprintf("https://%s/api/data/%s/%s", environmentHost, constants.DATAVERSE_API_VERSION, query)
+	apiUrl := fmt.Sprintf("https://%s/api/data/%s/%s", environmentHost, client.Api.GetConfig().GetDataverseApiVersion(), query)
 
 	response := map[string]any{}
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl, h, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &response)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl, h, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &response)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 
 	var totalRecords *int64
 	if response["@Microsoft.Dynamics.CRM.totalrecordcount"] != nil {
@@ -141,6 +129,17 @@ func (client *client) GetDataRecordsByODataQuery(ctx context.Context, environmen
 			}
 			records = append(records, value)
 		}
+
+		// the rows of a collection are returned in pages, the next page is requested until the response has no next link.
+		for nextLink, _ := response["@odata.nextLink"].(string); nextLink != ""; {
+			page := recordsPageDto{}
+			_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", nextLink, h, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &page)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, page.Value...)
+			nextLink = page.NextLink
+		}
 	} else {
 		records = append(records, response)
 	}
@@ -182,21 +181,15 @@ func (client *client) GetDataRecord(ctx context.Context, recordId, environmentId
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   fmt.Sprintf("/api/data/%s/%s(%s)", constants.DATAVERSE_API_VERSION, entityDefinition.LogicalCollectionName, recordId),
+		Path:   fmt.Sprintf("/api/data/%s/%s(%s)", client.Api.GetConfig().GetDataverseApiVersion(), entityDefinition.LogicalCollectionName, recordId),
 	}
 
 	result := make(map[string]any, 0)
 
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusNotFound, http.StatusForbidden}, &result)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &result)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 
 	return result, nil
 }
@@ -215,22 +208,16 @@ func (client *client) GetRelationData(ctx context.Context, environmentId, tableN
 	apiUrl := &url.URL{
 		Scheme:   constants.HTTPS,
 		Host:     environmentHost,
-		Path:     fmt.Sprintf("/api/data/%s/%s(%s)/%s", constants.DATAVERSE_API_VERSION, entityDefinition.LogicalCollectionName, recordId, relationName),
+		Path:     fmt.Sprintf("/api/data/%s/%s(%s)/%s", client.Api.GetConfig().GetDataverseApiVersion(), entityDefinition.LogicalCollectionName, recordId, relationName),
 		RawQuery: "$select=createdon",
 	}
 
 	result := make(map[string]any, 0)
 
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &result)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &result)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 
 	field, ok := result["value"]
 	if !ok {
@@ -254,23 +241,17 @@ func (client *client) GetTableSingularNameFromPlural(ctx context.Context, enviro
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   fmt.Sprintf("/api/data/%s/EntityDefinitions", constants.DATAVERSE_API_VERSION),
+		Path:   fmt.Sprintf("/api/data/%s/EntityDefinitions", client.Api.GetConfig().GetDataverseApiVersion()),
 	}
 	q := apiUrl.Query()
 	q.Add("$filter", fmt.Sprintf("LogicalCollectionName eq '%s'", logicalCollectionName))
 	q.Add("$select", "PrimaryIdAttribute,LogicalCollectionName,LogicalName")
 	apiUrl.RawQuery = q.Encode()
 
-	response, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, nil)
+	response, err := client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, nil)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(response); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(response); err != nil {
-		return nil, err
-	}
 
 	var mapResponse map[string]any
 	err = json.Unmarshal(response.BodyAsBytes, &mapResponse)
@@ -389,19 +370,13 @@ func (client *client) GetEntityAttributesDefinition(ctx context.Context, environ
 	if err != nil {
 		return nil, err
 	}
-	apiUrl := fmt.Sprintf("https://%s/api/data/%s/EntityDefinitions(LogicalName='%s')/Attributes?$select=LogicalName", environmentHost, constants.DATAVERSE_API_VERSION, entityLogicalName)
+	apiUrl := fmt.Sprintf("https://%s/api/data/%s/EntityDefinitions(LogicalName='%s')/Attributes?$select=LogicalName", environmentHost, client.Api.GetConfig().GetDataverseApiVersion(), entityLogicalName)
 
 	results := attributesApiResponseDto{}
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl, nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &results)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl, nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &results)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 	return results.Value, nil
 }
 
@@ -411,18 +386,12 @@ func (client *client) GetEntityRelationDefinitionInfo(ctx context.Context, envir
 		return "", err
 	}
 
-	apiUrl := fmt.Sprintf("https://%s/api/data/%s/EntityDefinitions(LogicalName='%s')?$expand=OneToManyRelationships,ManyToManyRelationships,ManyToOneRelationships", environmentHost, constants.DATAVERSE_API_VERSION, entityLogicalName)
+	apiUrl := fmt.Sprintf("https://%s/api/data/%s/EntityDefinitions(LogicalName='%s')?$expand=OneToManyRelationships,ManyToManyRelationships,ManyToOneRelationships", environmentHost, client.Api.GetConfig().GetDataverseApiVersion(), entityLogicalName)
 
-	response, err := client.Api.Execute(ctx, nil, "GET", apiUrl, nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, nil)
+	response, err := client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl, nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, nil)
 	if err != nil {
 		return "", err
 	}
-	if err := client.Api.HandleForbiddenResponse(response); err != nil {
-		return "", err
-	}
-	if err := client.Api.HandleNotFoundResponse(response); err != nil {
-		return "", err
-	}
 
 	var mapResponse map[string]any
 	err = json.Unmarshal(response.BodyAsBytes, &mapResponse)
@@ -493,7 +462,7 @@ func (client *client) ApplyDataRecord(ctx context.Context, recordId, environment
 
 	// we will send create operation as default.
 	method := "POST"
-	apiPath := fmt.Sprintf("/api/data/%s/%s", constants.DATAVERSE_API_VERSION, entityDefinition.LogicalCollectionName)
+	apiPath := fmt.Sprintf("/api/data/%s/%s", client.Api.GetConfig().GetDataverseApiVersion(), entityDefinition.LogicalCollectionName)
 
 	if val, ok := columns[entityDefinition.PrimaryIDAttribute]; ok {
 		// if one of the sent attributes is the primaryId then send an update.
@@ -511,7 +480,7 @@ func (client *client) ApplyDataRecord(ctx context.Context, recordId, environment
 		Path:   apiPath,
 	}
 
-	response, err := client.Api.Execute(ctx, nil, method, apiUrl.String(), nil, columns, []int{http.StatusOK, http.StatusNoContent, http.StatusPreconditionFailed, http.StatusForbidden, http.StatusNotFound}, nil)
+	response, err := client.Api.ExecuteExpecting(ctx, nil, method, apiUrl.String(), nil, columns, []int{http.StatusOK, http.StatusNoContent, http.StatusPreconditionFailed}, api.NotFoundOrForbiddenResponseErrors, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -526,12 +495,6 @@ func (client *client) ApplyDataRecord(ctx context.Context, recordId, environment
 			return nil, err
 		}
 	}
-	if err := client.Api.HandleForbiddenResponse(response); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(response); err != nil {
-		return nil, err
-	}
 
 	if len(response.BodyAsBytes) != 0 {
 		err = json.Unmarshal(response.BodyAsBytes, &result)
@@ -590,15 +553,12 @@ func (client *client) DeleteDataRecord(ctx context.Context, recordId string, env
 				apiUrl := &url.URL{
 					Scheme: constants.HTTPS,
 					Host:   environmentHost,
-					Path:   fmt.Sprintf("/api/data/%s/%s(%s)/%s(%s)/$ref", constants.DATAVERSE_API_VERSION, tableEntityDefinition.LogicalCollectionName, recordId, key, dataRecordId),
+					Path:   fmt.Sprintf("/api/data/%s/%s(%s)/%s(%s)/$ref", client.Api.GetConfig().GetDataverseApiVersion(), tableEntityDefinition.LogicalCollectionName, recordId, key, dataRecordId),
 				}
-				resp, err := client.Api.Execute(ctx, nil, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusNoContent, http.StatusNotFound, http.StatusForbidden}, nil)
+				_, err := client.Api.ExecuteExpecting(ctx, nil, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusNoContent, http.StatusNotFound}, api.ForbiddenResponseErrors, nil)
 				if err != nil {
 					return fmt.Errorf("error while deleting data record: %w", err)
 				}
-				if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-					return err
-				}
 			}
 		}
 	}
@@ -606,17 +566,12 @@ func (client *client) DeleteDataRecord(ctx context.Context, recordId string, env
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   fmt.Sprintf("/api/data/%s/%s(%s)", constants.DATAVERSE_API_VERSION, tableEntityDefinition.LogicalCollectionName, recordId),
+		Path:   fmt.Sprintf("/api/data/%s/%s(%s)", client.Api.GetConfig().GetDataverseApiVersion(), tableEntityDefinition.LogicalCollectionName, recordId),
 	}
 
 	// 200, 201, or 404 are acceptable status codes for delete and not error
-	resp, err := client.Api.Execute(ctx, nil, "DELETE", apiUrl.String(), nil, columns, []int{http.StatusOK, http.StatusNoContent, http.StatusNotFound, http.StatusForbidden}, nil)
-	if err != nil {
-		return err
-	} else if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return err
-	}
-	return nil
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "DELETE", apiUrl.String(), nil, columns, []int{http.StatusOK, http.StatusNoContent, http.StatusNotFound}, api.ForbiddenResponseErrors, nil)
+	return err
 }
 
 func getTableLogicalNameAndDataRecordIdFromMap(nestedMap map[string]any) (tableLogicalName string, dataRecordId string, err error) {
@@ -652,21 +607,15 @@ func applyRelation(ctx context.Context, environmentHost string, entityDefinition
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   fmt.Sprintf("/api/data/%s/%s(%s)/%s/$ref", constants.DATAVERSE_API_VERSION, entityDefinition.LogicalCollectionName, parentRecordId, key),
+		Path:   fmt.Sprintf("/api/data/%s/%s(%s)/%s/$ref", client.Api.GetConfig().GetDataverseApiVersion(), entityDefinition.LogicalCollectionName, parentRecordId, key),
 	}
 
 	existingRelationsResponse := relationApiResponseDto{}
 
-	apiResponse, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusNoContent, http.StatusForbidden, http.StatusNotFound}, nil)
+	apiResponse, err := client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusNoContent}, api.NotFoundOrForbiddenResponseErrors, nil)
 	if err != nil {
 		return err
 	}
-	if err := client.Api.HandleForbiddenResponse(apiResponse); err != nil {
-		return err
-	}
-	if err := client.Api.HandleNotFoundResponse(apiResponse); err != nil {
-		return err
-	}
 
 	// TODO: execute will unmarshal the response into the existingRelationsResponse use that instead
 	err = json.Unmarshal(apiResponse.BodyAsBytes, &existingRelationsResponse)
@@ -693,7 +642,7 @@ func applyRelation(ctx context.Context, environmentHost string, entityDefinition
 			if err != nil {
 				return err
 			}
-			if existingRelation.OdataID == fmt.Sprintf("https://%s/api/data/%s/%s(%s)", environmentHost, constants.DATAVERSE_API_VERSION, relationEntityDefinition.LogicalCollectionName, dataRecordId) {
+			if existingRelation.OdataID == fmt.Sprintf("https://%s/api/data/%s/%s(%s)", environmentHost, client.Api.GetConfig().GetDataverseApiVersion(), relationEntityDefinition.LogicalCollectionName, dataRecordId) {
 				shouldDelete = false
 				break
 			}
@@ -727,17 +676,209 @@ func applyRelation(ctx context.Context, environmentHost string, entityDefinition
 		}
 
 		relation := relationApiBodyDto{
-			OdataID: fmt.Sprintf("https://%s/api/data/%s/%s(%s)", environmentHost, constants.DATAVERSE_API_VERSION, entityDefinition.LogicalCollectionName, dataRecordId),
+			OdataID: fmt.Sprintf("https://%s/api/data/%s/%s(%s)", environmentHost, client.Api.GetConfig().GetDataverseApiVersion(), entityDefinition.LogicalCollectionName, dataRecordId),
 		}
-		resp, err := client.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, relation, []int{http.StatusOK, http.StatusNoContent, http.StatusForbidden, http.StatusNotFound}, nil)
+		_, err = client.Api.ExecuteExpecting(ctx, nil, "POST", apiUrl.String(), nil, relation, []int{http.StatusOK, http.StatusNoContent}, api.NotFoundOrForbiddenResponseErrors, nil)
 		if err != nil {
 			return err
 		}
-		if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-			return err
+	}
+	return nil
+}
+
+func (client *client) shareTarget(ctx context.Context, environmentId, tableLogicalName, recordId string) (map[string]any, error) {
+	entityDefinition, err := getEntityDefinition(ctx, client, environmentId, tableLogicalName)
+	if err != nil {
+		return nil, err
+	}
+
+	target := map[string]any{
+		"@odata.type":                       fmt.Sprintf("Microsoft.Dynamics.CRM.%s", tableLogicalName),
+		entityDefinition.PrimaryIDAttribute: recordId,
+	}
+	return target, nil
+}
+
+func sharePrincipal(principalType, principalId string) map[string]any {
+	return map[string]any{
+		"@odata.type":                      fmt.Sprintf("Microsoft.Dynamics.CRM.%s", principalType),
+		fmt.Sprintf("%sid", principalType): principalId,
+	}
+}
+
+// GrantDataRecordAccess shares a record with a principal. When modify is true the existing share is updated with ModifyAccess instead.
+func (client *client) GrantDataRecordAccess(ctx context.Context, environmentId, tableLogicalName, recordId, principalType, principalId string, accessRights []string, modify bool) error {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	target, err := client.shareTarget(ctx, environmentId, tableLogicalName, recordId)
+	if err != nil {
+		return err
+	}
+
+	action := "GrantAccess"
+	if modify {
+		action = "ModifyAccess"
+	}
+
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/%s", client.Api.GetConfig().GetDataverseApiVersion(), action),
+	}
+
+	request := grantAccessRequestDto{
+		Target: target,
+		PrincipalAccess: principalAccessDto{
+			Principal:  sharePrincipal(principalType, principalId),
+			AccessMask: strings.Join(accessRights, ", "),
+		},
+	}
+
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "POST", apiUrl.String(), nil, request, []int{http.StatusOK, http.StatusNoContent}, api.NotFoundOrForbiddenResponseErrors, nil)
+	return err
+}
+
+// RevokeDataRecordAccess removes all access a principal was granted on a record through sharing.
+func (client *client) RevokeDataRecordAccess(ctx context.Context, environmentId, tableLogicalName, recordId, principalType, principalId string) error {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	target, err := client.shareTarget(ctx, environmentId, tableLogicalName, recordId)
+	if err != nil {
+		return err
+	}
+
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/RevokeAccess", client.Api.GetConfig().GetDataverseApiVersion()),
+	}
+
+	request := revokeAccessRequestDto{
+		Target:  target,
+		Revokee: sharePrincipal(principalType, principalId),
+	}
+
+	// 404 is acceptable because the record may already be deleted.
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "POST", apiUrl.String(), nil, request, []int{http.StatusOK, http.StatusNoContent, http.StatusNotFound}, api.ForbiddenResponseErrors, nil)
+	return err
+}
+
+// GetDataRecordAccess returns the access rights a principal was granted on a record through sharing,
+// or nil when the record is not shared with the principal.
+func (client *client) GetDataRecordAccess(ctx context.Context, environmentId, tableLogicalName, recordId, principalId string) ([]string, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	entityDefinition, err := getEntityDefinition(ctx, client, environmentId, tableLogicalName)
+	if err != nil {
+		return nil, err
+	}
+
+	apiUrl := &url.URL{
+		Scheme:   constants.HTTPS,
+		Host:     environmentHost,
+		Path:     fmt.Sprintf("/api/data/%s/RetrieveSharedPrincipalsAndAccess(Target=@tid)", client.Api.GetConfig().GetDataverseApiVersion()),
+		RawQuery: "@tid=" + url.QueryEscape(fmt.Sprintf("{'@odata.id':'%s(%s)'}", entityDefinition.LogicalCollectionName, recordId)),
+	}
+
+	response := retrieveSharedPrincipalsAndAccessResponseDto{}
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, principalAccess := range response.PrincipalAccesses {
+		if id, ok := principalAccess.Principal["ownerid"].(string); ok && strings.EqualFold(id, principalId) {
+			return parseAccessMask(principalAccess.AccessMask), nil
 		}
-		if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-			return err
+	}
+	return nil, nil
+}
+
+func parseAccessMask(accessMask string) []string {
+	accessRights := []string{}
+	for _, accessRight := range strings.Split(accessMask, ",") {
+		accessRight = strings.TrimSpace(accessRight)
+		if accessRight != "" && accessRight != "None" {
+			accessRights = append(accessRights, accessRight)
+		}
+	}
+	return accessRights
+}
+
+// GetTableRecordIds returns the ids of all the records of a table, following the pages of the response.
+func (client *client) GetTableRecordIds(ctx context.Context, environmentId, tableLogicalName string) ([]string, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	entityDefinition, err := getEntityDefinition(ctx, client, environmentId, tableLogicalName)
+	if err != nil {
+		return nil, err
+	}
+
+	apiUrl := &url.URL{
+		Scheme:   constants.HTTPS,
+		Host:     environmentHost,
+		Path:     fmt.Sprintf("/api/data/%s/%s", client.Api.GetConfig().GetDataverseApiVersion(), entityDefinition.LogicalCollectionName),
+		RawQuery: url.Values{"$select": []string{entityDefinition.PrimaryIDAttribute}}.Encode(),
+	}
+
+	headers := http.Header{}
+	headers.Set("Prefer", "odata.maxpagesize=5000")
+
+	recordIds := []string{}
+	for nextLink := apiUrl.String(); nextLink != ""; {
+		page := recordsPageDto{}
+		_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", nextLink, headers, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &page)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range page.Value {
+			if id, ok := record[entityDefinition.PrimaryIDAttribute].(string); ok {
+				recordIds = append(recordIds, id)
+			}
+		}
+		nextLink = page.NextLink
+	}
+	return recordIds, nil
+}
+
+// CalculateRollupFields recalculates the rollup columns of the records immediately, instead of waiting for the recurring system job.
+func (client *client) CalculateRollupFields(ctx context.Context, environmentId, tableLogicalName string, recordIds, columnLogicalNames []string) error {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	entityDefinition, err := getEntityDefinition(ctx, client, environmentId, tableLogicalName)
+	if err != nil {
+		return err
+	}
+
+	for _, recordId := range recordIds {
+		for _, columnLogicalName := range columnLogicalNames {
+			apiUrl := &url.URL{
+				Scheme: constants.HTTPS,
+				Host:   environmentHost,
+				Path:   fmt.Sprintf("/api/data/%s/CalculateRollupField(Target=@tid,FieldName=@fn)", client.Api.GetConfig().GetDataverseApiVersion()),
+				RawQuery: "@tid=" + url.QueryEscape(fmt.Sprintf("{'@odata.id':'%s(%s)'}", entityDefinition.LogicalCollectionName, recordId)) +
+					"&@fn=" + url.QueryEscape(fmt.Sprintf("'%s'", columnLogicalName)),
+			}
+
+			_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, nil)
+			if err != nil {
+				return fmt.Errorf("failed to calculate column '%s' of record %s: %w", columnLogicalName, recordId, err)
+			}
 		}
 	}
 	return nil
@@ -0,0 +1,171 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package data_record
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+)
+
+// batchOperation is a single request sent as part of a Dataverse $batch request.
+type batchOperation struct {
+	Method string
+	Path   string
+	Body   map[string]any
+}
+
+// batchOperationResult is the outcome of a batchOperation, in the same order as the operations.
+type batchOperationResult struct {
+	StatusCode int
+	RecordId   string
+	Error      string
+}
+
+func (r batchOperationResult) succeeded() bool {
+	return r.StatusCode >= 200 && r.StatusCode < 300
+}
+
+var recordIdRegex = regexp.MustCompile("[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}")
+
+// ExecuteBatch sends the operations as one Dataverse $batch request. The operations are not part of a change set,
+// and Dataverse is asked to continue on errors, so that the result of every operation is reported.
+func (client *client) ExecuteBatch(ctx context.Context, environmentId string, operations []batchOperation) ([]batchOperationResult, error) {
+	if len(operations) == 0 {
+		return []batchOperationResult{}, nil
+	}
+
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/$batch", client.Api.GetConfig().GetDataverseApiVersion()),
+	}
+
+	boundary := fmt.Sprintf("batch_%s", uuid.New().String())
+	body, err := buildBatchRequestBody(boundary, apiUrl.Scheme+"://"+apiUrl.Host, operations)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := http.Header{}
+	headers.Set("Content-Type", fmt.Sprintf("multipart/mixed; boundary=%s", boundary))
+	headers.Set("Accept", "application/json")
+	headers.Set("OData-Version", "4.0")
+	headers.Set("OData-MaxVersion", "4.0")
+	headers.Set("Prefer", "odata.continue-on-error")
+
+	resp, err := client.Api.ExecuteExpecting(ctx, nil, "POST", apiUrl.String(), headers, &body, []int{http.StatusOK}, api.ForbiddenResponseErrors, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := parseBatchResponseBody(resp.HttpResponse.Header.Get("Content-Type"), resp.BodyAsBytes)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != len(operations) {
+		return nil, fmt.Errorf("batch request returned %d responses for %d operations", len(results), len(operations))
+	}
+	return results, nil
+}
+
+func buildBatchRequestBody(boundary, serviceRoot string, operations []batchOperation) (string, error) {
+	var builder strings.Builder
+	for index, operation := range operations {
+		builder.WriteString(fmt.Sprintf("--%s\r\n", boundary))
+		builder.WriteString("Content-Type: application/http\r\n")
+		builder.WriteString("Content-Transfer-Encoding: binary\r\n")
+		builder.WriteString(fmt.Sprintf("Content-ID: %d\r\n\r\n", index+1))
+		builder.WriteString(fmt.Sprintf("%s %s%s HTTP/1.1\r\n", operation.Method, serviceRoot, operation.Path))
+		if operation.Body != nil {
+			body, err := json.Marshal(operation.Body)
+			if err != nil {
+				return "", err
+			}
+			builder.WriteString("Content-Type: application/json\r\n\r\n")
+			builder.Write(body)
+			builder.WriteString("\r\n")
+		} else {
+			// the line break before the boundary belongs to the boundary, so an empty line is needed to end the headers.
+			builder.WriteString("\r\n\r\n")
+		}
+	}
+	builder.WriteString(fmt.Sprintf("--%s--\r\n", boundary))
+	return builder.String(), nil
+}
+
+func parseBatchResponseBody(contentType string, body []byte) ([]batchOperationResult, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("unexpected content type of the batch response '%s': %w", contentType, err)
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") || params["boundary"] == "" {
+		return nil, fmt.Errorf("unexpected content type of the batch response '%s'", contentType)
+	}
+
+	results := []batchOperationResult{}
+	reader := multipart.NewReader(bytes.NewReader(body), params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			return results, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		response, err := http.ReadResponse(bufio.NewReader(part), nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the response of batch operation %d: %w", len(results)+1, err)
+		}
+		responseBody, err := io.ReadAll(response.Body)
+		_ = response.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		result := batchOperationResult{
+			StatusCode: response.StatusCode,
+		}
+		if match := recordIdRegex.FindAllString(response.Header.Get(constants.HEADER_ODATA_ENTITY_ID), -1); len(match) > 0 {
+			result.RecordId = match[len(match)-1]
+		}
+		if !result.succeeded() {
+			result.Error = batchOperationErrorMessage(response.Status, responseBody)
+		}
+		results = append(results, result)
+	}
+}
+
+func batchOperationErrorMessage(status string, body []byte) string {
+	odataError := struct {
+		Error struct {
+			Code    string `json:"code"`
+			Message string `json:"message"`
+		} `json:"error"`
+	}{}
+	if err := json.Unmarshal(body, &odataError); err == nil && odataError.Error.Message != "" {
+		return fmt.Sprintf("%s: %s", status, odataError.Error.Message)
+	}
+	return status
+}
@@ -720,6 +720,52 @@ func TestUnitDataRecordDatasource_Validate_SavedQuery(t *testing.T) {
 	}
 }
 
+func TestUnitDataRecordDatasource_Validate_Pagination(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	const dataverseUrl = "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2"
+
+	httpmock.RegisterResponder("GET", dataverseUrl+"/contacts?$select=fullname",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, `{"@odata.context":"`+dataverseUrl+`/$metadata#contacts(fullname)","value":[{"contactid":"00000000-0000-0000-0000-000000000011","fullname":"Alice"},{"contactid":"00000000-0000-0000-0000-000000000012","fullname":"Bob"}],"@odata.nextLink":"`+dataverseUrl+`/contacts?$select=fullname&$skiptoken=page2"}`), nil
+		})
+
+	httpmock.RegisterResponder("GET", dataverseUrl+"/contacts?$select=fullname&$skiptoken=page2",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, `{"@odata.context":"`+dataverseUrl+`/$metadata#contacts(fullname)","value":[{"contactid":"00000000-0000-0000-0000-000000000013","fullname":"Carol"}]}`), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/EntityDefinitions?%24filter=LogicalCollectionName+eq+%27contacts%27&%24select=PrimaryIdAttribute%2CLogicalCollectionName%2CLogicalName`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/get_entitydefinition_contact.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "powerplatform_data_records" "data_query" {
+						environment_id    = "00000000-0000-0000-0000-000000000001"
+						entity_collection = "contacts"
+						select            = ["fullname"]
+					}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_data_records.data_query", "rows.#", "3"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_records.data_query", "rows.0.fullname", "Alice"),
+					resource.TestCheckResourceAttr("data.powerplatform_data_records.data_query", "rows.2.fullname", "Carol"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccDataRecordDatasource_Validate_UserQuery(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
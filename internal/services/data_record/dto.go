@@ -46,3 +46,27 @@ type attributesApiBodyDto struct {
 	LogicalName string `json:"LogicalName"`
 	MetadataId  string `json:"MetadataId"`
 }
+
+type grantAccessRequestDto struct {
+	Target          map[string]any     `json:"Target"`
+	PrincipalAccess principalAccessDto `json:"PrincipalAccess"`
+}
+
+type revokeAccessRequestDto struct {
+	Target  map[string]any `json:"Target"`
+	Revokee map[string]any `json:"Revokee"`
+}
+
+type principalAccessDto struct {
+	Principal  map[string]any `json:"Principal"`
+	AccessMask string         `json:"AccessMask"`
+}
+
+type retrieveSharedPrincipalsAndAccessResponseDto struct {
+	PrincipalAccesses []principalAccessDto `json:"PrincipalAccesses"`
+}
+
+type recordsPageDto struct {
+	Value    []map[string]any `json:"value"`
+	NextLink string           `json:"@odata.nextLink"`
+}
@@ -51,5 +51,54 @@ type DataRecordResourceModel struct {
 	Id               types.String   `tfsdk:"id"`
 	EnvironmentId    types.String   `tfsdk:"environment_id"`
 	TableLogicalName types.String   `tfsdk:"table_logical_name"`
+	AlternateKey     types.Dynamic  `tfsdk:"alternate_key"`
 	Columns          types.Dynamic  `tfsdk:"columns"`
 }
+
+type DataRecordShareResource struct {
+	helpers.TypeInfo
+	DataRecordClient client
+}
+
+type DataRecordShareResourceModel struct {
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+	Id               types.String   `tfsdk:"id"`
+	EnvironmentId    types.String   `tfsdk:"environment_id"`
+	TableLogicalName types.String   `tfsdk:"table_logical_name"`
+	RecordId         types.String   `tfsdk:"record_id"`
+	PrincipalType    types.String   `tfsdk:"principal_type"`
+	PrincipalId      types.String   `tfsdk:"principal_id"`
+	AccessRights     []string       `tfsdk:"access_rights"`
+}
+
+type DataRecordsBatchResource struct {
+	helpers.TypeInfo
+	DataRecordClient client
+}
+
+type DataRecordsBatchResourceModel struct {
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+	Id               types.String   `tfsdk:"id"`
+	EnvironmentId    types.String   `tfsdk:"environment_id"`
+	TableLogicalName types.String   `tfsdk:"table_logical_name"`
+	KeyColumns       []string       `tfsdk:"key_columns"`
+	Records          types.Dynamic  `tfsdk:"records"`
+	BatchSize        types.Int64    `tfsdk:"batch_size"`
+	RecordIds        types.Map      `tfsdk:"record_ids"`
+}
+
+type DataRecordRollupCalculationResource struct {
+	helpers.TypeInfo
+	DataRecordClient client
+}
+
+type DataRecordRollupCalculationResourceModel struct {
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Id                 types.String   `tfsdk:"id"`
+	EnvironmentId      types.String   `tfsdk:"environment_id"`
+	TableLogicalName   types.String   `tfsdk:"table_logical_name"`
+	ColumnLogicalNames []string       `tfsdk:"column_logical_names"`
+	RecordIds          types.Set      `tfsdk:"record_ids"`
+	Triggers           types.Map      `tfsdk:"triggers"`
+	RecordCount        types.Int64    `tfsdk:"record_count"`
+}
@@ -3,8 +3,10 @@
 package data_record
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 )
@@ -185,3 +187,31 @@ func buildOdataApplyPart(apply *string) *string {
 	}
 	return &resultQuery
 }
+
+// buildODataAlternateKey builds the `key1='value',key2=value` segment that addresses a record by its alternate key columns.
+func buildODataAlternateKey(alternateKey map[string]any) (string, error) {
+	if len(alternateKey) == 0 {
+		return "", errors.New("alternate key must contain at least one column")
+	}
+
+	names := make([]string, 0, len(alternateKey))
+	for name := range alternateKey {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		switch value := alternateKey[name].(type) {
+		case string:
+			parts = append(parts, fmt.Sprintf("%s='%s'", name, strings.ReplaceAll(value, "'", "''")))
+		case float64:
+			parts = append(parts, fmt.Sprintf("%s=%s", name, strconv.FormatFloat(value, 'f', -1, 64)))
+		case bool:
+			parts = append(parts, fmt.Sprintf("%s=%t", name, value))
+		default:
+			return "", fmt.Errorf("alternate key column '%s' must be a string, number or bool", name)
+		}
+	}
+	return strings.Join(parts, ","), nil
+}
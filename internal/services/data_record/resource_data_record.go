@@ -8,6 +8,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -17,13 +19,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/microsoft/terraform-provider-power-platform/internal/api"
-	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 )
 
@@ -55,6 +57,76 @@ func (d *DataRecordResource) ConfigValidators(ctx context.Context) []resource.Co
 	}
 }
 
+// ModifyPlan validates the table and the columns of the record against the $metadata document of the environment, when the provider
+// enables it with validate_dataverse_metadata, so that misspelled names fail the plan instead of failing the apply with a bad request.
+func (r *DataRecordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.Plan.Raw.IsNull() || r.DataRecordClient.Api == nil || !r.DataRecordClient.Api.GetConfig().ValidateDataverseMetadata {
+		return
+	}
+
+	var plan *DataRecordResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// values known only after apply, such as the id of an environment created in the same run, are validated by the next plan.
+	if plan.EnvironmentId.IsUnknown() || plan.TableLogicalName.IsUnknown() || plan.Columns.IsUnknown() {
+		return
+	}
+
+	environmentHost, err := r.DataRecordClient.GetEnvironmentHostById(ctx, plan.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when validating %s", r.FullTypeName()), err.Error())
+		return
+	}
+	metadata, err := r.DataRecordClient.Api.GetDataverseMetadata(ctx, environmentHost)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when validating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	tableLogicalName := plan.TableLogicalName.ValueString()
+	if !metadata.HasTable(tableLogicalName) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("table_logical_name"),
+			"Unknown Dataverse table",
+			fmt.Sprintf("The environment '%s' has no table with the logical name '%s'.", plan.EnvironmentId.ValueString(), tableLogicalName),
+		)
+		return
+	}
+
+	validateColumns := func(attributePath path.Path, value types.Dynamic) {
+		for _, name := range getDynamicAttributeNames(value) {
+			// annotated names, such as parentcustomerid_account@odata.bind, are validated by the name of their column.
+			columnLogicalName, _, _ := strings.Cut(name, "@")
+			if !metadata.HasColumn(tableLogicalName, columnLogicalName) {
+				resp.Diagnostics.AddAttributeError(
+					attributePath,
+					"Unknown Dataverse column",
+					fmt.Sprintf("The table '%s' has no column or relationship with the logical name '%s'.", tableLogicalName, columnLogicalName),
+				)
+			}
+		}
+	}
+	validateColumns(path.Root("columns"), plan.Columns)
+	validateColumns(path.Root("alternate_key"), plan.AlternateKey)
+}
+
+// getDynamicAttributeNames returns the sorted names of the attributes of an object or map value, such as the columns of a record.
+func getDynamicAttributeNames(value types.Dynamic) []string {
+	switch underlyingValue := value.UnderlyingValue().(type) {
+	case types.Object:
+		return slices.Sorted(maps.Keys(underlyingValue.Attributes()))
+	case types.Map:
+		return slices.Sorted(maps.Keys(underlyingValue.Elements()))
+	}
+	return nil
+}
+
 func (r *DataRecordResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
 	defer exitContext()
@@ -95,6 +167,14 @@ func (r *DataRecordResource) Schema(ctx context.Context, req resource.SchemaRequ
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"alternate_key": schema.DynamicAttribute{
+				MarkdownDescription: "Values of the [alternate key](https://learn.microsoft.com/power-apps/developer/data-platform/use-alternate-key-reference-record) columns that identify the record, for example `{ accountnumber = \"ACC-001\" }`. " +
+					"When set, the record is upserted by its alternate key instead of being created, so the same configuration can be applied to environments where the record has a different id",
+				Optional: true,
+				PlanModifiers: []planmodifier.Dynamic{
+					dynamicplanmodifier.RequiresReplace(),
+				},
+			},
 			"columns": schema.DynamicAttribute{
 				MarkdownDescription: "Columns of the data record table",
 				Required:            true,
@@ -147,7 +227,23 @@ func (r *DataRecordResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	dr, err := r.DataRecordClient.ApplyDataRecord(ctx, plan.Id.ValueString(), plan.EnvironmentId.ValueString(), plan.TableLogicalName.ValueString(), mapColumns)
+	recordId := plan.Id.ValueString()
+	if !plan.AlternateKey.IsNull() && !plan.AlternateKey.IsUnderlyingValueNull() {
+		alternateKeyColumns := plan.AlternateKey.String()
+		mapAlternateKey, err := convertResourceModelToMap(&alternateKeyColumns)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Error converting alternate key to map: %s", err.Error()), err.Error())
+			return
+		}
+		// upserting the record by its alternate key creates it or takes over the existing one.
+		recordId, err = buildODataAlternateKey(mapAlternateKey)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("alternate_key"), "Invalid alternate key", err.Error())
+			return
+		}
+	}
+
+	dr, err := r.DataRecordClient.ApplyDataRecord(ctx, recordId, plan.EnvironmentId.ValueString(), plan.TableLogicalName.ValueString(), mapColumns)
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
 		return
@@ -173,8 +269,7 @@ func (r *DataRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	newColumns, err := r.DataRecordClient.GetDataRecord(ctx, state.Id.ValueString(), state.EnvironmentId.ValueString(), state.TableLogicalName.ValueString())
 	if err != nil {
-		if customerrors.Code(err) == customerrors.ERROR_OBJECT_NOT_FOUND {
-			resp.State.RemoveResource(ctx)
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
 			return
 		}
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
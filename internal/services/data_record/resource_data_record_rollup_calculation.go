@@ -0,0 +1,215 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package data_record
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &DataRecordRollupCalculationResource{}
+
+func NewDataRecordRollupCalculationResource() resource.Resource {
+	return &DataRecordRollupCalculationResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "data_record_rollup_calculation",
+		},
+	}
+}
+
+func (r *DataRecordRollupCalculationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *DataRecordRollupCalculationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource recalculates [rollup columns](https://learn.microsoft.com/power-apps/maker/data-platform/define-rollup-fields) of a Dataverse table with the [CalculateRollupField](https://learn.microsoft.com/power-apps/developer/data-platform/webapi/reference/calculaterollupfield) function, " +
+			"for example once reference data is seeded, instead of waiting for the recurring system job. " +
+			"The columns are recalculated when the resource is created or replaced, change `triggers` to recalculate them again. Destroying the resource has no effect on the records.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the calculation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Dataverse environment",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table_logical_name": schema.StringAttribute{
+				MarkdownDescription: "Logical name of the table the rollup columns belong to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"column_logical_names": schema.SetAttribute{
+				MarkdownDescription: "Logical names of the rollup columns to recalculate",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"record_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids (guid) of the records to recalculate, for example the `record_ids` of a `powerplatform_data_records_batch`. When not set, all the records of the table are recalculated",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that recalculate the columns when they change",
+				ElementType:         types.StringType,
+				Optional:            true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"record_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of records that were recalculated",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DataRecordRollupCalculationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.DataRecordClient = newDataRecordClient(client.Api)
+}
+
+func (r *DataRecordRollupCalculationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *DataRecordRollupCalculationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	environmentId := plan.EnvironmentId.ValueString()
+	tableLogicalName := plan.TableLogicalName.ValueString()
+
+	recordIds := []string{}
+	if plan.RecordIds.IsNull() {
+		ids, err := r.DataRecordClient.GetTableRecordIds(ctx, environmentId, tableLogicalName)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+			return
+		}
+		recordIds = ids
+	} else {
+		resp.Diagnostics.Append(plan.RecordIds.ElementsAs(ctx, &recordIds, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	err := r.DataRecordClient.CalculateRollupFields(ctx, environmentId, tableLogicalName, recordIds, plan.ColumnLogicalNames)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s_%s", environmentId, tableLogicalName))
+	plan.RecordCount = types.Int64Value(int64(len(recordIds)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DataRecordRollupCalculationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *DataRecordRollupCalculationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the calculation runs once: the values calculated later by Dataverse are left as they are.
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s with table_name %s", r.FullTypeName(), state.TableLogicalName.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *DataRecordRollupCalculationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// every attribute requires a replacement, only the timeouts can be updated.
+	var plan *DataRecordRollupCalculationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DataRecordRollupCalculationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// the calculated values are kept in the records, there is nothing to delete.
+	tflog.Debug(ctx, fmt.Sprintf("DELETE: %s", r.FullTypeName()))
+}
@@ -0,0 +1,133 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package data_record_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+// registerRollupCalculationMocks returns the record and column of every CalculateRollupField request.
+func registerRollupCalculationMocks(calculations *[]string) {
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Rollup_Calculation/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/EntityDefinitions%28LogicalName=%27account%27%29#$select=PrimaryIdAttribute,LogicalCollectionName`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Rollup_Calculation/get_entitydefinition_account.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts?%24select=accountid`,
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Prefer") != "odata.maxpagesize=5000" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, "missing page size preference"), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{
+				"value": [{ "accountid": "00000000-0000-0000-0000-000000000100" }],
+				"@odata.nextLink": "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts?%24select=accountid&%24skiptoken=page2"
+			}`), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts?%24select=accountid&%24skiptoken=page2`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, `{ "value": [{ "accountid": "00000000-0000-0000-0000-000000000101" }] }`), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^https://00000000-0000-0000-0000-000000000001\.crm4\.dynamics\.com/api/data/v9\.2/CalculateRollupField%28Target=@tid,FieldName=@fn%29`,
+		func(req *http.Request) (*http.Response, error) {
+			query := req.URL.Query()
+			*calculations = append(*calculations, fmt.Sprintf("%s %s", query.Get("@tid"), query.Get("@fn")))
+			if query.Get("@fn") == "'description'" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, `{"error":{"code":"0x80040203","message":"The field description is not a rollup field"}}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"@odata.context":"https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/$metadata#accounts/$entity"}`), nil
+		})
+}
+
+func TestUnitDataRecordRollupCalculationResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	calculations := []string{}
+	registerRollupCalculationMocks(&calculations)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_data_record_rollup_calculation" "accounts" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					table_logical_name   = "account"
+					column_logical_names = ["new_opportunitycount"]
+					record_ids           = ["00000000-0000-0000-0000-000000000100"]
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_data_record_rollup_calculation.accounts", "id", "00000000-0000-0000-0000-000000000001_account"),
+					resource.TestCheckResourceAttr("powerplatform_data_record_rollup_calculation.accounts", "record_count", "1"),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_data_record_rollup_calculation" "accounts" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					table_logical_name   = "account"
+					column_logical_names = ["new_opportunitycount", "new_totalrevenue"]
+					triggers = {
+						seed = "2"
+					}
+				}`,
+
+				Check: resource.TestCheckResourceAttr("powerplatform_data_record_rollup_calculation.accounts", "record_count", "2"),
+			},
+		},
+	})
+
+	expected := []string{
+		"{'@odata.id':'accounts(00000000-0000-0000-0000-000000000100)'} 'new_opportunitycount'",
+		"{'@odata.id':'accounts(00000000-0000-0000-0000-000000000100)'} 'new_opportunitycount'",
+		"{'@odata.id':'accounts(00000000-0000-0000-0000-000000000100)'} 'new_totalrevenue'",
+		"{'@odata.id':'accounts(00000000-0000-0000-0000-000000000101)'} 'new_opportunitycount'",
+		"{'@odata.id':'accounts(00000000-0000-0000-0000-000000000101)'} 'new_totalrevenue'",
+	}
+	if !slices.Equal(calculations, expected) {
+		t.Errorf("expected the calculations %v, got %v", expected, calculations)
+	}
+}
+
+func TestUnitDataRecordRollupCalculationResource_Validate_Not_A_Rollup_Column(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	calculations := []string{}
+	registerRollupCalculationMocks(&calculations)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_data_record_rollup_calculation" "accounts" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					table_logical_name   = "account"
+					column_logical_names = ["description"]
+					record_ids           = ["00000000-0000-0000-0000-000000000100"]
+				}`,
+				ExpectError: regexp.MustCompile(`failed to calculate column 'description' of record\s+00000000-0000-0000-0000-000000000100`),
+			},
+		},
+	})
+}
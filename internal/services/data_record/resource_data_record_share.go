@@ -0,0 +1,243 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package data_record
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &DataRecordShareResource{}
+
+// accessRights are the values of the Dataverse AccessRights enum that can be granted when sharing a record.
+var accessRights = []string{
+	"ReadAccess",
+	"WriteAccess",
+	"AppendAccess",
+	"AppendToAccess",
+	"CreateAccess",
+	"DeleteAccess",
+	"ShareAccess",
+	"AssignAccess",
+}
+
+func NewDataRecordShareResource() resource.Resource {
+	return &DataRecordShareResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "data_record_share",
+		},
+	}
+}
+
+func (r *DataRecordShareResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *DataRecordShareResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource shares a single Dataverse record with a user or team using the [GrantAccess](https://learn.microsoft.com/power-apps/developer/data-platform/webapi/reference/grantaccess) and [ModifyAccess](https://learn.microsoft.com/power-apps/developer/data-platform/webapi/reference/modifyaccess) actions. Destroying the resource revokes the shared access.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the record share",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Dataverse environment",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table_logical_name": schema.StringAttribute{
+				MarkdownDescription: "Logical name of the table the record belongs to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"record_id": schema.StringAttribute{
+				MarkdownDescription: "Id (guid) of the record to share",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the principal the record is shared with. Valid values are `systemuser` and `team`",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("systemuser", "team"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_id": schema.StringAttribute{
+				MarkdownDescription: "Dataverse id (guid) of the user or team the record is shared with",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"access_rights": schema.SetAttribute{
+				MarkdownDescription: "Access rights granted to the principal. Valid values are `ReadAccess`, `WriteAccess`, `AppendAccess`, `AppendToAccess`, `CreateAccess`, `DeleteAccess`, `ShareAccess` and `AssignAccess`",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(accessRights...)),
+				},
+			},
+		},
+	}
+}
+
+func (r *DataRecordShareResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.DataRecordClient = newDataRecordClient(client.Api)
+}
+
+func (r *DataRecordShareResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *DataRecordShareResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.DataRecordClient.GrantDataRecordAccess(ctx, plan.EnvironmentId.ValueString(), plan.TableLogicalName.ValueString(), plan.RecordId.ValueString(), plan.PrincipalType.ValueString(), plan.PrincipalId.ValueString(), plan.AccessRights, false)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	// the planned access rights are kept: the rights the principal holds are only compared with them in Read, to detect drift.
+	plan.Id = types.StringValue(dataRecordShareId(plan))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DataRecordShareResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *DataRecordShareResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rights, err := r.DataRecordClient.GetDataRecordAccess(ctx, state.EnvironmentId.ValueString(), state.TableLogicalName.ValueString(), state.RecordId.ValueString(), state.PrincipalId.ValueString())
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+	if rights == nil {
+		// The share was revoked outside of Terraform.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertFromAccessRights(state, rights))...)
+}
+
+func (r *DataRecordShareResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *DataRecordShareResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.DataRecordClient.GrantDataRecordAccess(ctx, plan.EnvironmentId.ValueString(), plan.TableLogicalName.ValueString(), plan.RecordId.ValueString(), plan.PrincipalType.ValueString(), plan.PrincipalId.ValueString(), plan.AccessRights, true)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	// the planned access rights are kept: the rights the principal holds are only compared with them in Read, to detect drift.
+	plan.Id = types.StringValue(dataRecordShareId(plan))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DataRecordShareResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *DataRecordShareResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.DataRecordClient.RevokeDataRecordAccess(ctx, state.EnvironmentId.ValueString(), state.TableLogicalName.ValueString(), state.RecordId.ValueString(), state.PrincipalType.ValueString(), state.PrincipalId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+}
+
+func convertFromAccessRights(model *DataRecordShareResourceModel, rights []string) *DataRecordShareResourceModel {
+	model.Id = types.StringValue(dataRecordShareId(model))
+	model.AccessRights = rights
+	return model
+}
+
+func dataRecordShareId(model *DataRecordShareResourceModel) string {
+	return fmt.Sprintf("%s_%s", model.RecordId.ValueString(), model.PrincipalId.ValueString())
+}
@@ -0,0 +1,169 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package data_record_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccDataRecordShareResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "test_env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_data_records" "root_business_unit" {
+					environment_id    = powerplatform_environment.test_env.id
+					entity_collection = "businessunits"
+					filter            = "parentbusinessunitid eq null"
+					select            = ["businessunitid"]
+				}
+
+				resource "powerplatform_data_record" "team" {
+					environment_id     = powerplatform_environment.test_env.id
+					table_logical_name = "team"
+					columns = {
+						name = "` + mocks.TestName() + `"
+						businessunitid = {
+							table_logical_name = "businessunit"
+							data_record_id     = one(data.powerplatform_data_records.root_business_unit.rows).businessunitid
+						}
+					}
+				}
+
+				resource "powerplatform_data_record" "account" {
+					environment_id     = powerplatform_environment.test_env.id
+					table_logical_name = "account"
+					columns = {
+						name = "` + mocks.TestName() + `"
+					}
+				}
+
+				resource "powerplatform_data_record_share" "share" {
+					environment_id     = powerplatform_environment.test_env.id
+					table_logical_name = "account"
+					record_id          = powerplatform_data_record.account.id
+					principal_type     = "team"
+					principal_id       = powerplatform_data_record.team.id
+					access_rights      = ["ReadAccess", "WriteAccess"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_data_record_share.share", "access_rights.#", "2"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_data_record_share.share", "access_rights.*", "ReadAccess"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_data_record_share.share", "access_rights.*", "WriteAccess"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataRecordShareResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	accessMask := ""
+	revoked := false
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Share_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/EntityDefinitions%28LogicalName=%27account%27%29#$select=PrimaryIdAttribute,LogicalCollectionName`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Share_Create_And_Update/get_entitydefinition_account.json").String()), nil
+		})
+
+	shareResponder := func(req *http.Request) (*http.Response, error) {
+		body, _ := io.ReadAll(req.Body)
+		request := map[string]any{}
+		_ = json.Unmarshal(body, &request)
+
+		target := request["Target"].(map[string]any)
+		principalAccess := request["PrincipalAccess"].(map[string]any)
+		principal := principalAccess["Principal"].(map[string]any)
+		if target["accountid"] != "00000000-0000-0000-0000-000000000020" || principal["teamid"] != "00000000-0000-0000-0000-000000000030" {
+			return httpmock.NewStringResponse(http.StatusBadRequest, ""), nil
+		}
+		accessMask = principalAccess["AccessMask"].(string)
+		return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+	}
+
+	httpmock.RegisterResponder("POST", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/GrantAccess`, shareResponder)
+	httpmock.RegisterResponder("POST", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/ModifyAccess`, shareResponder)
+
+	httpmock.RegisterResponder("POST", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/RevokeAccess`,
+		func(req *http.Request) (*http.Response, error) {
+			revoked = true
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterRegexpResponder("GET", regexp.MustCompile(`^https://00000000-0000-0000-0000-000000000001\.crm4\.dynamics\.com/api/data/v9\.2/RetrieveSharedPrincipalsAndAccess%28Target=@tid%29\?@tid=`),
+		func(req *http.Request) (*http.Response, error) {
+			if revoked || accessMask == "" {
+				return httpmock.NewStringResponse(http.StatusOK, `{"PrincipalAccesses":[]}`), nil
+			}
+			response := strings.Replace(httpmock.File("tests/resource/Validate_Share_Create_And_Update/get_shared_principals.json").String(), "{{access_mask}}", accessMask, 1)
+			return httpmock.NewStringResponse(http.StatusOK, response), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_data_record_share" "share" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					table_logical_name = "account"
+					record_id          = "00000000-0000-0000-0000-000000000020"
+					principal_type     = "team"
+					principal_id       = "00000000-0000-0000-0000-000000000030"
+					access_rights      = ["ReadAccess", "WriteAccess"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_data_record_share.share", "id", "00000000-0000-0000-0000-000000000020_00000000-0000-0000-0000-000000000030"),
+					resource.TestCheckResourceAttr("powerplatform_data_record_share.share", "access_rights.#", "2"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_data_record_share.share", "access_rights.*", "ReadAccess"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_data_record_share.share", "access_rights.*", "WriteAccess"),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_data_record_share" "share" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					table_logical_name = "account"
+					record_id          = "00000000-0000-0000-0000-000000000020"
+					principal_type     = "team"
+					principal_id       = "00000000-0000-0000-0000-000000000030"
+					access_rights      = ["ReadAccess", "WriteAccess", "DeleteAccess"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_data_record_share.share", "access_rights.#", "3"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_data_record_share.share", "access_rights.*", "DeleteAccess"),
+				),
+			},
+		},
+	})
+}
@@ -281,6 +281,91 @@ func TestUnitDataRecordResource_Validate_Create(t *testing.T) {
 	})
 }
 
+func TestUnitDataRecordResource_Validate_Create_Alternate_Key(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Alternate_Key/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/EntityDefinitions%28LogicalName=%27account%27%29#$select=PrimaryIdAttribute,LogicalCollectionName`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Alternate_Key/get_entitydefinition_account.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts%28accountnumber=%27ACC-001%27,territorycode=1%29`,
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Set("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts(00000000-0000-0000-0000-000000000020)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts%2800000000-0000-0000-0000-000000000020%29`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Alternate_Key/get_account_00000000-0000-0000-0000-000000000020.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts%2800000000-0000-0000-0000-000000000020%29`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_data_record" "data_record_account" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					table_logical_name = "account"
+					alternate_key = {
+						accountnumber = "ACC-001"
+						territorycode = 1
+					}
+					columns = {
+						name    = "Sample Account"
+						revenue = 5000000
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_data_record.data_record_account", "id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_data_record.data_record_account", "alternate_key.accountnumber", "ACC-001"),
+					resource.TestCheckResourceAttr("powerplatform_data_record.data_record_account", "columns.name", "Sample Account"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataRecordResource_Validate_Create_Invalid_Alternate_Key(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_data_record" "data_record_account" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					table_logical_name = "account"
+					alternate_key = {
+						primarycontactid = {
+							table_logical_name = "contact"
+						}
+					}
+					columns = {
+						name = "Sample Account"
+					}
+				}`,
+				ExpectError: regexp.MustCompile(`alternate key column 'primarycontactid' must be a string, number or\s+bool`),
+			},
+		},
+	})
+}
+
 func TestAccDataRecordResource_Validate_Update(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
@@ -381,6 +466,127 @@ func TestAccDataRecordResource_Validate_Update(t *testing.T) {
 	})
 }
 
+func TestUnitDataRecordResource_Validate_Metadata(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Metadata/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/$metadata`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Metadata/get_metadata.xml").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/EntityDefinitions%28LogicalName=%27account%27%29#$select=PrimaryIdAttribute,LogicalCollectionName`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Metadata/get_entitydefinition_account.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts%28accountnumber=%27ACC-001%27,territorycode=1%29`,
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusNoContent, "")
+			resp.Header.Set("OData-EntityId", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts(00000000-0000-0000-0000-000000000020)")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts%2800000000-0000-0000-0000-000000000020%29`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Metadata/get_account_00000000-0000-0000-0000-000000000020.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts%2800000000-0000-0000-0000-000000000020%29`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				provider "powerplatform" {
+					use_cli                     = true
+					validate_dataverse_metadata = true
+				}
+
+				resource "powerplatform_data_record" "data_record_account" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					table_logical_name = "account"
+					alternate_key = {
+						accountnumber = "ACC-001"
+						territorycode = 1
+					}
+					columns = {
+						name    = "Sample Account"
+						revenue = 5000000
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_data_record.data_record_account", "id", "00000000-0000-0000-0000-000000000020"),
+					func(_ *terraform.State) error {
+						// the document is downloaded once by the provider, however many times the record is planned.
+						if count := httpmock.GetCallCountInfo()["GET https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/$metadata"]; count != 1 {
+							return fmt.Errorf("expected the $metadata document to be downloaded once, got %d downloads", count)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataRecordResource_Validate_Metadata_Unknown_Names(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Metadata/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/$metadata`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Metadata/get_metadata.xml").String()), nil
+		})
+
+	config := func(tableLogicalName, column string) string {
+		return fmt.Sprintf(`
+		provider "powerplatform" {
+			use_cli                     = true
+			validate_dataverse_metadata = true
+		}
+
+		resource "powerplatform_data_record" "data_record_account" {
+			environment_id     = "00000000-0000-0000-0000-000000000001"
+			table_logical_name = "%s"
+			columns = {
+				%s = "Sample Account"
+			}
+		}`, tableLogicalName, column)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("acount", "name"),
+				ExpectError: regexp.MustCompile(`has no table with the\s+logical name 'acount'`),
+			},
+			{
+				Config:      config("account", "nmae"),
+				ExpectError: regexp.MustCompile(`The table 'account' has no column or relationship with the logical name\s+'nmae'`),
+			},
+		},
+	})
+}
+
 func TestUnitDataRecordResource_Validate_Update(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -0,0 +1,481 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package data_record
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &DataRecordsBatchResource{}
+
+// maxBatchSize is the maximum number of requests Dataverse accepts in a single $batch request.
+const maxBatchSize = 1000
+
+func NewDataRecordsBatchResource() resource.Resource {
+	return &DataRecordsBatchResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "data_records_batch",
+		},
+	}
+}
+
+func (r *DataRecordsBatchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *DataRecordsBatchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource upserts a list of records into a Dataverse table, for example to seed reference data when an environment is provisioned. " +
+			"The records are identified by their [alternate key](https://learn.microsoft.com/power-apps/developer/data-platform/use-alternate-key-reference-record) and sent in [batch requests](https://learn.microsoft.com/power-apps/developer/data-platform/webapi/execute-batch-operations-using-web-api). " +
+			"Records removed from the list, or all the records when the resource is destroyed, are deleted. Changes made to the records in Dataverse are not detected.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the batch",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Dataverse environment",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"table_logical_name": schema.StringAttribute{
+				MarkdownDescription: "Logical name of the table the records belong to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"key_columns": schema.ListAttribute{
+				MarkdownDescription: "Columns of the alternate key of the table that identify each record, for example `[\"accountnumber\"]`. Every record must have a value for each of these columns",
+				ElementType:         types.StringType,
+				Required:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+			},
+			"records": schema.DynamicAttribute{
+				MarkdownDescription: "List of records, each one an object of column values, for example `jsondecode(file(\"accounts.json\"))` or `csvdecode(file(\"accounts.csv\"))`. " +
+					"Lookup columns are set with the `<navigation property>@odata.bind` column, for example `\"parentaccountid@odata.bind\" = \"/accounts(00000000-0000-0000-0000-000000000001)\"`",
+				Required: true,
+			},
+			"batch_size": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of records sent in each batch request, at most %d", maxBatchSize),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(100),
+				Validators: []validator.Int64{
+					int64validator.Between(1, maxBatchSize),
+				},
+			},
+			"record_ids": schema.MapAttribute{
+				MarkdownDescription: "Ids (guid) of the records, by alternate key, for example `accountnumber='ACC-001'`",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *DataRecordsBatchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.DataRecordClient = newDataRecordClient(client.Api)
+}
+
+func (r *DataRecordsBatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *DataRecordsBatchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyRecords(ctx, plan, map[string]string{}, &resp.Diagnostics)
+	if plan.RecordIds.IsUnknown() {
+		return
+	}
+
+	// the records that were applied are kept in state even when others failed, so that they are deleted once the resource is replaced.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DataRecordsBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *DataRecordsBatchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the records are seeded once: changes made to them in Dataverse are left as they are.
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s with table_name %s", r.FullTypeName(), state.TableLogicalName.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *DataRecordsBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *DataRecordsBatchResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *DataRecordsBatchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previousRecordIds := map[string]string{}
+	resp.Diagnostics.Append(state.RecordIds.ElementsAs(ctx, &previousRecordIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.applyRecords(ctx, plan, previousRecordIds, &resp.Diagnostics)
+	if plan.RecordIds.IsUnknown() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DataRecordsBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *DataRecordsBatchResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	recordIds := map[string]string{}
+	resp.Diagnostics.Append(state.RecordIds.ElementsAs(ctx, &recordIds, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entityDefinition, err := getEntityDefinition(ctx, &r.DataRecordClient, state.EnvironmentId.ValueString(), state.TableLogicalName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	keys := sortedKeys(recordIds)
+	operations := make([]batchOperation, 0, len(keys))
+	for _, key := range keys {
+		operations = append(operations, r.deleteOperation(entityDefinition, recordIds[key]))
+	}
+
+	results, err := r.executeBatches(ctx, state.EnvironmentId.ValueString(), operations, state.BatchSize.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+	for index, result := range results {
+		if !result.succeeded() && result.StatusCode != http.StatusNotFound {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), fmt.Sprintf("record %s: %s", keys[index], result.Error))
+		}
+	}
+}
+
+// applyRecords upserts the planned records and deletes the records that are no longer planned.
+// plan.RecordIds is left unknown when nothing could be applied, otherwise it holds the records that exist after the apply.
+func (r *DataRecordsBatchResource) applyRecords(ctx context.Context, plan *DataRecordsBatchResourceModel, previousRecordIds map[string]string, diags *diag.Diagnostics) {
+	environmentId := plan.EnvironmentId.ValueString()
+	plan.Id = types.StringValue(fmt.Sprintf("%s_%s", environmentId, plan.TableLogicalName.ValueString()))
+	plan.RecordIds = types.MapUnknown(types.StringType)
+
+	records, err := convertRecordsToMaps(plan.Records)
+	if err != nil {
+		diags.AddAttributeError(path.Root("records"), "Invalid records", err.Error())
+		return
+	}
+
+	entityDefinition, err := getEntityDefinition(ctx, &r.DataRecordClient, environmentId, plan.TableLogicalName.ValueString())
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Client error when applying %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	keys := make([]string, 0, len(records))
+	operations := make([]batchOperation, 0, len(records))
+	for index, record := range records {
+		key, columns, err := splitAlternateKey(record, plan.KeyColumns)
+		if err != nil {
+			diags.AddAttributeError(path.Root("records"), "Invalid record", fmt.Sprintf("record %d: %s", index, err.Error()))
+			continue
+		}
+		if slices.Contains(keys, key) {
+			diags.AddAttributeError(path.Root("records"), "Invalid record", fmt.Sprintf("record %d: another record has the same key %s", index, key))
+			continue
+		}
+		keys = append(keys, key)
+		operations = append(operations, batchOperation{
+			Method: "PATCH",
+			Path:   fmt.Sprintf("/api/data/%s/%s(%s)", r.DataRecordClient.Api.GetConfig().GetDataverseApiVersion(), entityDefinition.LogicalCollectionName, key),
+			Body:   columns,
+		})
+	}
+	if diags.HasError() {
+		return
+	}
+
+	removedKeys := []string{}
+	for _, key := range sortedKeys(previousRecordIds) {
+		if !slices.Contains(keys, key) {
+			removedKeys = append(removedKeys, key)
+			operations = append(operations, r.deleteOperation(entityDefinition, previousRecordIds[key]))
+		}
+	}
+
+	results, err := r.executeBatches(ctx, environmentId, operations, plan.BatchSize.ValueInt64())
+	if err != nil {
+		diags.AddError(fmt.Sprintf("Client error when applying %s", r.FullTypeName()), err.Error())
+		// the batches that were sent before the failure are applied.
+		if len(results) == 0 {
+			return
+		}
+	}
+
+	recordIds := map[string]attr.Value{}
+	for index, result := range results {
+		if index < len(keys) {
+			key := keys[index]
+			switch {
+			case result.succeeded() && result.RecordId != "":
+				recordIds[key] = types.StringValue(result.RecordId)
+			case result.succeeded():
+				diags.AddAttributeError(path.Root("records"), "Failed to upsert record", fmt.Sprintf("record %d (%s): no record id returned", index, key))
+			default:
+				diags.AddAttributeError(path.Root("records"), "Failed to upsert record", fmt.Sprintf("record %d (%s): %s", index, key, result.Error))
+			}
+			if _, ok := recordIds[key]; !ok && previousRecordIds[key] != "" {
+				recordIds[key] = types.StringValue(previousRecordIds[key])
+			}
+			continue
+		}
+
+		key := removedKeys[index-len(keys)]
+		if !result.succeeded() && result.StatusCode != http.StatusNotFound {
+			diags.AddError("Failed to delete record", fmt.Sprintf("record %s: %s", key, result.Error))
+			recordIds[key] = types.StringValue(previousRecordIds[key])
+		}
+	}
+	// the records of the batches that were not sent are unchanged.
+	for index := len(results); index < len(operations); index++ {
+		if index < len(keys) {
+			if id := previousRecordIds[keys[index]]; id != "" {
+				recordIds[keys[index]] = types.StringValue(id)
+			}
+		} else {
+			key := removedKeys[index-len(keys)]
+			recordIds[key] = types.StringValue(previousRecordIds[key])
+		}
+	}
+	plan.RecordIds = types.MapValueMust(types.StringType, recordIds)
+}
+
+func (r *DataRecordsBatchResource) deleteOperation(entityDefinition *entityDefinitionsDto, recordId string) batchOperation {
+	return batchOperation{
+		Method: "DELETE",
+		Path:   fmt.Sprintf("/api/data/%s/%s(%s)", r.DataRecordClient.Api.GetConfig().GetDataverseApiVersion(), entityDefinition.LogicalCollectionName, recordId),
+	}
+}
+
+// executeBatches sends the operations in batches of batchSize and returns the results of the batches that were sent.
+func (r *DataRecordsBatchResource) executeBatches(ctx context.Context, environmentId string, operations []batchOperation, batchSize int64) ([]batchOperationResult, error) {
+	results := make([]batchOperationResult, 0, len(operations))
+	for batch := range slices.Chunk(operations, int(batchSize)) {
+		batchResults, err := r.DataRecordClient.ExecuteBatch(ctx, environmentId, batch)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, batchResults...)
+	}
+	return results, nil
+}
+
+// splitAlternateKey returns the alternate key segment of the record and the other columns, which are sent in the body of the upsert.
+func splitAlternateKey(record map[string]any, keyColumns []string) (string, map[string]any, error) {
+	alternateKey := map[string]any{}
+	columns := map[string]any{}
+	for name, value := range record {
+		if slices.Contains(keyColumns, name) {
+			alternateKey[name] = value
+		} else {
+			columns[name] = value
+		}
+	}
+	for _, name := range keyColumns {
+		if alternateKey[name] == nil {
+			return "", nil, fmt.Errorf("key column '%s' is missing", name)
+		}
+	}
+	key, err := buildODataAlternateKey(alternateKey)
+	if err != nil {
+		return "", nil, err
+	}
+	return key, columns, nil
+}
+
+// convertRecordsToMaps converts the records, such as the result of jsondecode or csvdecode, to the column values of each record.
+func convertRecordsToMaps(records types.Dynamic) ([]map[string]any, error) {
+	value, err := convertAttrValueToAny(records)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := value.([]any)
+	if !ok {
+		return nil, errors.New("records must be a list of objects")
+	}
+
+	result := make([]map[string]any, 0, len(list))
+	for index, item := range list {
+		record, ok := item.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("record %d must be an object", index)
+		}
+		result = append(result, record)
+	}
+	return result, nil
+}
+
+func convertAttrValueToAny(value attr.Value) (any, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	if value.IsUnknown() {
+		return nil, errors.New("value is unknown")
+	}
+
+	switch v := value.(type) {
+	case basetypes.DynamicValue:
+		return convertAttrValueToAny(v.UnderlyingValue())
+	case basetypes.StringValue:
+		return v.ValueString(), nil
+	case basetypes.BoolValue:
+		return v.ValueBool(), nil
+	case basetypes.NumberValue:
+		number, _ := v.ValueBigFloat().Float64()
+		return number, nil
+	case basetypes.Int64Value:
+		return float64(v.ValueInt64()), nil
+	case basetypes.Float64Value:
+		return v.ValueFloat64(), nil
+	case basetypes.ListValue:
+		return convertAttrValuesToAny(v.Elements())
+	case basetypes.TupleValue:
+		return convertAttrValuesToAny(v.Elements())
+	case basetypes.SetValue:
+		return convertAttrValuesToAny(v.Elements())
+	case basetypes.ObjectValue:
+		return convertAttrValueMapToAny(v.Attributes())
+	case basetypes.MapValue:
+		return convertAttrValueMapToAny(v.Elements())
+	default:
+		return nil, fmt.Errorf("unsupported value type %T", value)
+	}
+}
+
+func convertAttrValuesToAny(elements []attr.Value) ([]any, error) {
+	result := make([]any, 0, len(elements))
+	for _, element := range elements {
+		value, err := convertAttrValueToAny(element)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, value)
+	}
+	return result, nil
+}
+
+func convertAttrValueMapToAny(elements map[string]attr.Value) (map[string]any, error) {
+	result := make(map[string]any, len(elements))
+	for name, element := range elements {
+		value, err := convertAttrValueToAny(element)
+		if err != nil {
+			return nil, err
+		}
+		result[name] = value
+	}
+	return result, nil
+}
+
+func sortedKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,272 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package data_record_test
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+// batchRecordsMock stores the accounts upserted by alternate key through $batch requests.
+type batchRecordsMock struct {
+	records    map[string]map[string]any
+	recordIds  map[string]string
+	operations []string
+	failures   map[string]string
+}
+
+func newBatchRecordsMock() *batchRecordsMock {
+	return &batchRecordsMock{
+		records:   map[string]map[string]any{},
+		recordIds: map[string]string{},
+		failures:  map[string]string{},
+	}
+}
+
+var accountsPathRegex = regexp.MustCompile(`/api/data/v9\.2/accounts\((.+)\)$`)
+
+func (m *batchRecordsMock) responder(req *http.Request) (*http.Response, error) {
+	_, params, err := mime.ParseMediaType(req.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+	if req.Header.Get("Prefer") != "odata.continue-on-error" {
+		return httpmock.NewStringResponse(http.StatusBadRequest, "missing continue-on-error preference"), nil
+	}
+
+	responseBody := strings.Builder{}
+	reader := multipart.NewReader(req.Body, params["boundary"])
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		partReader := bufio.NewReader(part)
+		operation, err := http.ReadRequest(partReader)
+		if err != nil {
+			return nil, err
+		}
+		segment := accountsPathRegex.FindStringSubmatch(operation.URL.Path)[1]
+		m.operations = append(m.operations, fmt.Sprintf("%s %s", operation.Method, segment))
+
+		status := "204 No Content"
+		headers := ""
+		body := ""
+		switch operation.Method {
+		case "PATCH":
+			if message, ok := m.failures[segment]; ok {
+				status = "400 Bad Request"
+				headers = "Content-Type: application/json; odata.metadata=minimal\r\n"
+				body = fmt.Sprintf(`{"error":{"code":"0x80040203","message":"%s"}}`, message)
+				break
+			}
+			columns := map[string]any{}
+			// the operations have no content length, so the body is what remains of the part.
+			_ = json.NewDecoder(partReader).Decode(&columns)
+			if _, ok := m.recordIds[segment]; !ok {
+				m.recordIds[segment] = fmt.Sprintf("00000000-0000-0000-0000-0000000001%02d", len(m.recordIds))
+			}
+			m.records[segment] = columns
+			headers = fmt.Sprintf("OData-EntityId: https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts(%s)\r\n", m.recordIds[segment])
+		case "DELETE":
+			found := false
+			for key, id := range m.recordIds {
+				if id == segment {
+					delete(m.recordIds, key)
+					delete(m.records, key)
+					found = true
+				}
+			}
+			if !found {
+				status = "404 Not Found"
+			}
+		}
+		responseBody.WriteString("--batchresponse_1\r\nContent-Type: application/http\r\nContent-Transfer-Encoding: binary\r\n\r\n")
+		responseBody.WriteString(fmt.Sprintf("HTTP/1.1 %s\r\nOData-Version: 4.0\r\n%s\r\n%s\r\n", status, headers, body))
+	}
+	responseBody.WriteString("--batchresponse_1--\r\n")
+
+	resp := httpmock.NewStringResponse(http.StatusOK, responseBody.String())
+	resp.Header.Set("Content-Type", "multipart/mixed; boundary=batchresponse_1")
+	return resp, nil
+}
+
+func registerBatchRecordsMocks(m *batchRecordsMock) {
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Records_Batch/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/EntityDefinitions%28LogicalName=%27account%27%29#$select=PrimaryIdAttribute,LogicalCollectionName`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Records_Batch/get_entitydefinition_account.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/$batch`, m.responder)
+}
+
+func TestUnitDataRecordsBatchResource_Validate_Create_Update_And_Delete(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	m := newBatchRecordsMock()
+	registerBatchRecordsMocks(m)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_data_records_batch" "accounts" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					table_logical_name = "account"
+					key_columns        = ["accountnumber"]
+					batch_size         = 1
+					records = jsondecode(<<-EOT
+						[
+							{ "accountnumber": "ACC-001", "name": "Contoso", "revenue": 5000000 },
+							{ "accountnumber": "ACC-002", "name": "Fabrikam", "revenue": null }
+						]
+					EOT
+					)
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_data_records_batch.accounts", "id", "00000000-0000-0000-0000-000000000001_account"),
+					resource.TestCheckResourceAttr("powerplatform_data_records_batch.accounts", "record_ids.%", "2"),
+					resource.TestCheckResourceAttr("powerplatform_data_records_batch.accounts", "record_ids.accountnumber='ACC-001'", "00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("powerplatform_data_records_batch.accounts", "record_ids.accountnumber='ACC-002'", "00000000-0000-0000-0000-000000000101"),
+					func(s *terraform.State) error {
+						if m.records["accountnumber='ACC-001'"]["name"] != "Contoso" || m.records["accountnumber='ACC-001'"]["revenue"] != float64(5000000) {
+							return fmt.Errorf("unexpected columns %v", m.records["accountnumber='ACC-001'"])
+						}
+						if _, ok := m.records["accountnumber='ACC-001'"]["accountnumber"]; ok {
+							return fmt.Errorf("key columns are expected in the url only, got %v", m.records["accountnumber='ACC-001'"])
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_data_records_batch" "accounts" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					table_logical_name = "account"
+					key_columns        = ["accountnumber"]
+					records = csvdecode(<<-EOT
+						accountnumber,name
+						ACC-001,Contoso Ltd
+						ACC-003,Northwind
+					EOT
+					)
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_data_records_batch.accounts", "record_ids.%", "2"),
+					resource.TestCheckResourceAttr("powerplatform_data_records_batch.accounts", "record_ids.accountnumber='ACC-001'", "00000000-0000-0000-0000-000000000100"),
+					resource.TestCheckResourceAttr("powerplatform_data_records_batch.accounts", "record_ids.accountnumber='ACC-003'", "00000000-0000-0000-0000-000000000102"),
+					func(s *terraform.State) error {
+						if m.records["accountnumber='ACC-001'"]["name"] != "Contoso Ltd" {
+							return fmt.Errorf("unexpected columns %v", m.records["accountnumber='ACC-001'"])
+						}
+						if _, ok := m.records["accountnumber='ACC-002'"]; ok {
+							return fmt.Errorf("expected the removed record to be deleted, got %v", m.records)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+
+	if len(m.records) != 0 {
+		t.Errorf("expected all the records to be deleted, got %v", m.records)
+	}
+	if !slices.Contains(m.operations, "DELETE 00000000-0000-0000-0000-000000000101") {
+		t.Errorf("expected the removed record to be deleted by id, got %v", m.operations)
+	}
+}
+
+func TestUnitDataRecordsBatchResource_Validate_Record_Errors(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	m := newBatchRecordsMock()
+	m.failures["accountnumber='ACC-002'"] = "A validation error occurred. The length of the 'name' attribute exceeded the maximum allowed length"
+	registerBatchRecordsMocks(m)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_data_records_batch" "accounts" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					table_logical_name = "account"
+					key_columns        = ["accountnumber"]
+					records = [
+						{ accountnumber = "ACC-001", name = "Contoso" },
+						{ accountnumber = "ACC-002", name = "Fabrikam" },
+					]
+				}`,
+				ExpectError: regexp.MustCompile(`record 1 \(accountnumber='ACC-002'\): 400 Bad Request: A validation\s+error\s+occurred`),
+			},
+		},
+	})
+
+	// the record that was applied is kept in the tainted state and deleted when the test is destroyed.
+	if len(m.records) != 0 {
+		t.Errorf("expected all the records to be deleted, got %v", m.records)
+	}
+}
+
+func TestUnitDataRecordsBatchResource_Validate_Missing_Key_Column(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	m := newBatchRecordsMock()
+	registerBatchRecordsMocks(m)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_data_records_batch" "accounts" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					table_logical_name = "account"
+					key_columns        = ["accountnumber"]
+					records = [
+						{ name = "Contoso" },
+					]
+				}`,
+				ExpectError: regexp.MustCompile(`record 0: key column 'accountnumber' is missing`),
+			},
+		},
+	})
+
+	if len(m.operations) != 0 {
+		t.Errorf("expected no batch request, got %v", m.operations)
+	}
+}
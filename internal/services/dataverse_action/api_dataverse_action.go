@@ -0,0 +1,62 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_action
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment"
+)
+
+func newDataverseActionClient(apiClient *api.Client) client {
+	return client{
+		Api:               apiClient,
+		environmentClient: environment.NewEnvironmentClient(apiClient),
+	}
+}
+
+type client struct {
+	Api               *api.Client
+	environmentClient environment.Client
+}
+
+func (client *client) buildDataverseUrl(environmentHost, path string) string {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/%s", client.Api.GetConfig().GetDataverseApiVersion(), path),
+	}
+	return apiUrl.String()
+}
+
+// InvokeAction calls a Dataverse action or Custom API with its parameters, as a JSON object, and returns the body of the response, which holds the output parameters of the action.
+// Bound actions are called on the record identified by the entity set name and the record id.
+func (client *client) InvokeAction(ctx context.Context, environmentId string, action actionInvocationDto) (string, error) {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return "", err
+	}
+
+	path := action.Name
+	if action.BoundEntitySetName != "" {
+		path = fmt.Sprintf("%s(%s)/Microsoft.Dynamics.CRM.%s", action.BoundEntitySetName, action.BoundRecordId, action.Name)
+	}
+
+	parameters := action.Parameters
+	if parameters == "" {
+		parameters = "{}"
+	}
+
+	// No client request id is set: Dataverse does not deduplicate on it, and the POST must not be retried once it may have reached the action.
+	resp, err := client.Api.Execute(ctx, nil, "POST", client.buildDataverseUrl(environmentHost, path), nil, &parameters, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return "", err
+	}
+	return string(resp.BodyAsBytes), nil
+}
@@ -0,0 +1,12 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_action
+
+type actionInvocationDto struct {
+	Name               string
+	BoundEntitySetName string
+	BoundRecordId      string
+	Parameters         string
+	IdempotencyKey     string
+}
@@ -0,0 +1,38 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_action
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customtypes"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+type DataverseActionResource struct {
+	helpers.TypeInfo
+	DataverseActionClient client
+}
+
+type DataverseActionResourceModel struct {
+	Timeouts           timeouts.Value   `tfsdk:"timeouts"`
+	Id                 types.String     `tfsdk:"id"`
+	EnvironmentId      types.String     `tfsdk:"environment_id"`
+	ActionName         types.String     `tfsdk:"action_name"`
+	BoundEntitySetName types.String     `tfsdk:"bound_entity_set_name"`
+	BoundRecordId      types.String     `tfsdk:"bound_record_id"`
+	Parameters         customtypes.JSON `tfsdk:"parameters"`
+	IdempotencyKey     types.String     `tfsdk:"idempotency_key"`
+	Output             customtypes.JSON `tfsdk:"output"`
+}
+
+func convertToActionInvocationDto(model *DataverseActionResourceModel) actionInvocationDto {
+	return actionInvocationDto{
+		Name:               model.ActionName.ValueString(),
+		BoundEntitySetName: model.BoundEntitySetName.ValueString(),
+		BoundRecordId:      model.BoundRecordId.ValueString(),
+		Parameters:         model.Parameters.ValueString(),
+		IdempotencyKey:     model.IdempotencyKey.ValueString(),
+	}
+}
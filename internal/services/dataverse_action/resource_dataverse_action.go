@@ -0,0 +1,217 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_action
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customtypes"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &DataverseActionResource{}
+
+func NewDataverseActionResource() resource.Resource {
+	return &DataverseActionResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "dataverse_action",
+		},
+	}
+}
+
+func (r *DataverseActionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *DataverseActionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Invokes a Dataverse [action](https://learn.microsoft.com/power-apps/developer/data-platform/webapi/use-web-api-actions) or [Custom API](https://learn.microsoft.com/power-apps/developer/data-platform/custom-api) during apply, for post-deployment steps such as data migrations. The action is invoked when the resource is created, and again when any of its arguments change, for example a new `idempotency_key`. Reading or destroying the resource doesn't invoke the action.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the invocation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Dataverse environment where the action is invoked",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "environment_id must be a valid environment id guid"),
+				},
+			},
+			"action_name": schema.StringAttribute{
+				MarkdownDescription: "Unique name of the action or Custom API, for example `contoso_MigrateAccounts`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`), "action_name must be the unique name of an action"),
+				},
+			},
+			"bound_entity_set_name": schema.StringAttribute{
+				MarkdownDescription: "Entity set name of the table that a bound action is invoked on, for example `accounts`. Must be set together with `bound_record_id`",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("bound_record_id")),
+				},
+			},
+			"bound_record_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the record that a bound action is invoked on. Must be set together with `bound_entity_set_name`",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "bound_record_id must be a valid guid"),
+					stringvalidator.AlsoRequires(path.MatchRoot("bound_entity_set_name")),
+				},
+			},
+			"parameters": schema.StringAttribute{
+				MarkdownDescription: "Input parameters of the action as a JSON object, for example built with `jsonencode`",
+				CustomType:          customtypes.JSONType{},
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"idempotency_key": schema.StringAttribute{
+				MarkdownDescription: "Key of the invocation, such as the version of a data migration. The action runs once per key: changing the key invokes the action again. A request that fails after it may have reached Dataverse, such as a gateway timeout, is not retried, so that the action is not invoked twice for a key",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"output": schema.StringAttribute{
+				MarkdownDescription: "Output parameters returned by the action as a JSON object, null when the action returns no output",
+				CustomType:          customtypes.JSONType{},
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *DataverseActionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.DataverseActionClient = newDataverseActionClient(client.Api)
+}
+
+func (r *DataverseActionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *DataverseActionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := r.DataverseActionClient.InvokeAction(ctx, plan.EnvironmentId.ValueString(), convertToActionInvocationDto(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(uuid.New().String())
+	plan.Output = customtypes.NewJSONNull()
+	if output != "" {
+		plan.Output = customtypes.NewJSONValue(output)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE: %s invoked action %s", r.FullTypeName(), plan.ActionName.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DataverseActionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *DataverseActionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An invocation has no remote state to refresh, invoking the action again is left to changes of its arguments.
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *DataverseActionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// All the arguments except the timeouts require a replacement, which invokes the action again.
+	var plan *DataverseActionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *DataverseActionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// The effects of an action can't be undone in general, so the invocation is only removed from the state.
+	tflog.Debug(ctx, fmt.Sprintf("%s removed from state, the action is not reverted", r.FullTypeName()))
+}
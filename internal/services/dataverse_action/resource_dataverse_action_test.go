@@ -0,0 +1,230 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_action_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccDataverseActionResource_Validate_Invoke(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_dataverse_action" "publish" {
+					environment_id  = powerplatform_environment.env.id
+					action_name     = "PublishAllXml"
+					idempotency_key = "v1"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_dataverse_action.publish", "id", regexp.MustCompile(helpers.GuidRegex)),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataverseActionResource_Validate_Invoke(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	const dataverseUrl = "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2"
+
+	invocations := []map[string]any{}
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Invoke/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", dataverseUrl+"/contoso_MigrateAccounts",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			parameters := map[string]any{}
+			if err := json.Unmarshal(body, &parameters); err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			invocations = append(invocations, parameters)
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"@odata.context":"%s/$metadata#Microsoft.Dynamics.CRM.contoso_MigrateAccountsResponse","MigratedCount":%d}`, dataverseUrl, 10*len(invocations))), nil
+		})
+
+	config := func(batchSize int, key string) string {
+		return fmt.Sprintf(`
+		resource "powerplatform_dataverse_action" "migration" {
+			environment_id  = "00000000-0000-0000-0000-000000000001"
+			action_name     = "contoso_MigrateAccounts"
+			idempotency_key = "%s"
+			parameters = jsonencode({
+				Source    = "legacy"
+				BatchSize = %d
+			})
+		}`, key, batchSize)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config(100, "v1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_dataverse_action.migration", "id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestCheckResourceAttrWith("powerplatform_dataverse_action.migration", "output", func(value string) error {
+						if !strings.Contains(value, `"MigratedCount":10`) {
+							return fmt.Errorf("unexpected output %s", value)
+						}
+						return nil
+					}),
+				),
+			},
+			{
+				// applying the same configuration again doesn't invoke the action.
+				Config: config(100, "v1"),
+				Check: func(_ *terraform.State) error {
+					if len(invocations) != 1 || invocations[0]["Source"] != "legacy" || invocations[0]["BatchSize"] != float64(100) {
+						return fmt.Errorf("unexpected invocations %v", invocations)
+					}
+					return nil
+				},
+			},
+			{
+				Config: config(100, "v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("powerplatform_dataverse_action.migration", "output", func(value string) error {
+						if !strings.Contains(value, `"MigratedCount":20`) {
+							return fmt.Errorf("unexpected output %s", value)
+						}
+						return nil
+					}),
+					func(_ *terraform.State) error {
+						if len(invocations) != 2 {
+							return fmt.Errorf("unexpected invocations %v", invocations)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataverseActionResource_Validate_Invoke_Not_Retried(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Invoke/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	invocations := 0
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/contoso_MigrateAccounts",
+		func(req *http.Request) (*http.Response, error) {
+			invocations++
+			return httpmock.NewStringResponse(http.StatusGatewayTimeout, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if invocations != 1 {
+				return fmt.Errorf("expected the action to be invoked once, got %d invocations", invocations)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_dataverse_action" "migration" {
+					environment_id  = "00000000-0000-0000-0000-000000000001"
+					action_name     = "contoso_MigrateAccounts"
+					idempotency_key = "v1"
+				}`,
+				ExpectError: regexp.MustCompile("504"),
+			},
+		},
+	})
+}
+
+func TestUnitDataverseActionResource_Validate_Invoke_Bound(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Invoke/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/accounts%2800000000-0000-0000-0000-000000000010%29/Microsoft.Dynamics.CRM.contoso_Recalculate",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if string(body) != "{}" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_dataverse_action" "recalculate" {
+					environment_id        = "00000000-0000-0000-0000-000000000001"
+					action_name           = "contoso_Recalculate"
+					bound_entity_set_name = "accounts"
+					bound_record_id       = "00000000-0000-0000-0000-000000000010"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_dataverse_action.recalculate", "id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestCheckNoResourceAttr("powerplatform_dataverse_action.recalculate", "output"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitDataverseActionResource_Validate_Bound_Record_Required(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_dataverse_action" "recalculate" {
+					environment_id        = "00000000-0000-0000-0000-000000000001"
+					action_name           = "contoso_Recalculate"
+					bound_entity_set_name = "accounts"
+				}`,
+				ExpectError: regexp.MustCompile(`Attribute "bound_record_id" must be specified`),
+			},
+		},
+	})
+}
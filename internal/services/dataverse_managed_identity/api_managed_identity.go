@@ -0,0 +1,159 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_managed_identity
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment"
+)
+
+const (
+	// CREDENTIAL_SOURCE_MANAGED_IDENTITY is the credentialsource value of identities backed by a federated credential.
+	CREDENTIAL_SOURCE_MANAGED_IDENTITY = 2
+	// SUBJECT_SCOPE_ENVIRONMENT is the subjectscope value of identities that are scoped to the environment.
+	SUBJECT_SCOPE_ENVIRONMENT = 1
+)
+
+func newManagedIdentityClient(apiClient *api.Client) client {
+	return client{
+		Api:               apiClient,
+		environmentClient: environment.NewEnvironmentClient(apiClient),
+	}
+}
+
+type client struct {
+	Api               *api.Client
+	environmentClient environment.Client
+}
+
+func (client *client) buildDataverseUrl(environmentHost, path string, values url.Values) string {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/%s", client.Api.GetConfig().GetDataverseApiVersion(), path),
+	}
+	if values != nil {
+		apiUrl.RawQuery = values.Encode()
+	}
+	return apiUrl.String()
+}
+
+func (client *client) CreateManagedIdentity(ctx context.Context, environmentId string, managedIdentity managedIdentityDto) (*managedIdentityDto, error) {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	managedIdentity.CredentialSource = CREDENTIAL_SOURCE_MANAGED_IDENTITY
+	managedIdentity.SubjectScope = SUBJECT_SCOPE_ENVIRONMENT
+
+	headers := http.Header{}
+	headers.Set("Prefer", "return=representation")
+
+	created := managedIdentityDto{}
+	_, err = client.Api.Execute(ctx, nil, "POST", client.buildDataverseUrl(environmentHost, "managedidentities", nil), headers, managedIdentity, []int{http.StatusCreated}, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client *client) GetManagedIdentity(ctx context.Context, environmentId, managedIdentityId string) (*managedIdentityDto, error) {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "managedidentityid,name,applicationid,tenantid,credentialsource,subjectscope")
+
+	managedIdentity := managedIdentityDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, fmt.Sprintf("managedidentities(%s)", managedIdentityId), values), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &managedIdentity)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("managed identity '%s' not found", managedIdentityId))
+	}
+	return &managedIdentity, nil
+}
+
+func (client *client) UpdateManagedIdentity(ctx context.Context, environmentId, managedIdentityId, name string) error {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	update := map[string]any{
+		"name": name,
+	}
+	_, err = client.Api.Execute(ctx, nil, "PATCH", client.buildDataverseUrl(environmentHost, fmt.Sprintf("managedidentities(%s)", managedIdentityId), nil), nil, update, []int{http.StatusNoContent}, nil)
+	return err
+}
+
+func (client *client) DeleteManagedIdentity(ctx context.Context, environmentId, managedIdentityId string) error {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Api.Execute(ctx, nil, "DELETE", client.buildDataverseUrl(environmentHost, fmt.Sprintf("managedidentities(%s)", managedIdentityId), nil), nil, nil, []int{http.StatusNoContent, http.StatusNotFound}, nil)
+	return err
+}
+
+// GetPluginAssemblyIds returns the ids of the plugin assemblies that use the managed identity.
+func (client *client) GetPluginAssemblyIds(ctx context.Context, environmentId, managedIdentityId string) ([]string, error) {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "pluginassemblyid")
+	values.Add("$filter", fmt.Sprintf("_managedidentityid_value eq %s", managedIdentityId))
+
+	assemblies := pluginAssemblyArrayDto{}
+	_, err = client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, "pluginassemblies", values), nil, nil, []int{http.StatusOK}, &assemblies)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(assemblies.Value))
+	for _, assembly := range assemblies.Value {
+		ids = append(ids, assembly.Id)
+	}
+	return ids, nil
+}
+
+// BindPluginAssembly configures a plugin assembly to acquire tokens using the managed identity.
+func (client *client) BindPluginAssembly(ctx context.Context, environmentId, managedIdentityId, pluginAssemblyId string) error {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	bind := map[string]any{
+		"managedidentityid@odata.bind": fmt.Sprintf("/managedidentities(%s)", managedIdentityId),
+	}
+	_, err = client.Api.Execute(ctx, nil, "PATCH", client.buildDataverseUrl(environmentHost, fmt.Sprintf("pluginassemblies(%s)", pluginAssemblyId), nil), nil, bind, []int{http.StatusNoContent}, nil)
+	return err
+}
+
+// UnbindPluginAssembly removes the managed identity from a plugin assembly.
+func (client *client) UnbindPluginAssembly(ctx context.Context, environmentId, pluginAssemblyId string) error {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Api.Execute(ctx, nil, "DELETE", client.buildDataverseUrl(environmentHost, fmt.Sprintf("pluginassemblies(%s)/managedidentityid/$ref", pluginAssemblyId), nil), nil, nil, []int{http.StatusNoContent, http.StatusNotFound}, nil)
+	return err
+}
@@ -0,0 +1,21 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_managed_identity
+
+type managedIdentityDto struct {
+	Id               string `json:"managedidentityid,omitempty"`
+	Name             string `json:"name,omitempty"`
+	ApplicationId    string `json:"applicationid"`
+	TenantId         string `json:"tenantid"`
+	CredentialSource int    `json:"credentialsource"`
+	SubjectScope     int    `json:"subjectscope"`
+}
+
+type pluginAssemblyDto struct {
+	Id string `json:"pluginassemblyid"`
+}
+
+type pluginAssemblyArrayDto struct {
+	Value []pluginAssemblyDto `json:"value"`
+}
@@ -0,0 +1,55 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_managed_identity
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+type ManagedIdentityResource struct {
+	helpers.TypeInfo
+	ManagedIdentityClient client
+}
+
+type ManagedIdentityResourceModel struct {
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	Id                types.String   `tfsdk:"id"`
+	EnvironmentId     types.String   `tfsdk:"environment_id"`
+	Name              types.String   `tfsdk:"name"`
+	ApplicationId     types.String   `tfsdk:"application_id"`
+	TenantId          types.String   `tfsdk:"tenant_id"`
+	PluginAssemblyIds types.Set      `tfsdk:"plugin_assembly_ids"`
+}
+
+func convertFromManagedIdentityDto(model *ManagedIdentityResourceModel, managedIdentity *managedIdentityDto, pluginAssemblyIds []string) error {
+	model.Id = types.StringValue(managedIdentity.Id)
+	model.Name = types.StringValue(managedIdentity.Name)
+	model.ApplicationId = types.StringValue(managedIdentity.ApplicationId)
+	model.TenantId = types.StringValue(managedIdentity.TenantId)
+
+	// guids are not case sensitive: the ids that are already in the model keep their spelling, so that a configured upper-case id does not produce a diff.
+	knownIds := make([]string, 0, len(model.PluginAssemblyIds.Elements()))
+	for _, element := range model.PluginAssemblyIds.Elements() {
+		if id, ok := element.(types.String); ok && !id.IsNull() && !id.IsUnknown() {
+			knownIds = append(knownIds, id.ValueString())
+		}
+	}
+	for i, pluginAssemblyId := range pluginAssemblyIds {
+		if index := slices.IndexFunc(knownIds, func(id string) bool { return strings.EqualFold(id, pluginAssemblyId) }); index >= 0 {
+			pluginAssemblyIds[i] = knownIds[index]
+		}
+	}
+
+	assemblyIds, err := helpers.StringSliceToSet(pluginAssemblyIds)
+	if err != nil {
+		return err
+	}
+	model.PluginAssemblyIds = assemblyIds
+	return nil
+}
@@ -0,0 +1,306 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_managed_identity
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &ManagedIdentityResource{}
+
+func NewManagedIdentityResource() resource.Resource {
+	return &ManagedIdentityResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "dataverse_managed_identity",
+		},
+	}
+}
+
+func (r *ManagedIdentityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *ManagedIdentityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a [Power Platform managed identity](https://learn.microsoft.com/power-platform/admin/set-up-managed-identity) in a Dataverse environment. Plugin assemblies bound to the managed identity acquire tokens for Azure resources using the federated credential of the referenced app registration or user-assigned managed identity, instead of a secret.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier (guid) of the managed identity record",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Dataverse environment",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the managed identity record",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"application_id": schema.StringAttribute{
+				MarkdownDescription: "Application (client) id of the app registration or user-assigned managed identity that holds the federated credential",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tenant_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Entra tenant of the app registration or user-assigned managed identity",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"plugin_assembly_ids": schema.SetAttribute{
+				MarkdownDescription: "Ids of the plugin assemblies that use the managed identity. When not set, the plugin assemblies bound to the managed identity are not managed",
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ManagedIdentityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.ManagedIdentityClient = newManagedIdentityClient(client.Api)
+}
+
+func (r *ManagedIdentityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *ManagedIdentityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managedIdentity, err := r.ManagedIdentityClient.CreateManagedIdentity(ctx, plan.EnvironmentId.ValueString(), managedIdentityDto{
+		Name:          plan.Name.ValueString(),
+		ApplicationId: plan.ApplicationId.ValueString(),
+		TenantId:      plan.TenantId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	// save the identity right away so it is not orphaned when binding the plugin assemblies fails.
+	plan.Id = types.StringValue(managedIdentity.Id)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), plan.EnvironmentId)...)
+
+	if !plan.PluginAssemblyIds.IsUnknown() && !plan.PluginAssemblyIds.IsNull() {
+		err = r.applyPluginAssemblies(ctx, plan, []string{})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	err = r.readManagedIdentity(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ManagedIdentityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *ManagedIdentityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.readManagedIdentity(ctx, state)
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *ManagedIdentityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *ManagedIdentityResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *ManagedIdentityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Name.IsUnknown() && !plan.Name.Equal(state.Name) {
+		err := r.ManagedIdentityClient.UpdateManagedIdentity(ctx, plan.EnvironmentId.ValueString(), plan.Id.ValueString(), plan.Name.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	if !plan.PluginAssemblyIds.IsUnknown() && !plan.PluginAssemblyIds.Equal(state.PluginAssemblyIds) {
+		var current []string
+		resp.Diagnostics.Append(state.PluginAssemblyIds.ElementsAs(ctx, &current, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := r.applyPluginAssemblies(ctx, plan, current)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	err := r.readManagedIdentity(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *ManagedIdentityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *ManagedIdentityResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// plugin assemblies that still reference the managed identity prevent its deletion.
+	pluginAssemblyIds, err := r.ManagedIdentityClient.GetPluginAssemblyIds(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+	for _, pluginAssemblyId := range pluginAssemblyIds {
+		err := r.ManagedIdentityClient.UnbindPluginAssembly(ctx, state.EnvironmentId.ValueString(), pluginAssemblyId)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	err = r.ManagedIdentityClient.DeleteManagedIdentity(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+}
+
+// applyPluginAssemblies binds the plugin assemblies of the plan to the managed identity and unbinds the ones that are no longer in the plan.
+func (r *ManagedIdentityResource) applyPluginAssemblies(ctx context.Context, plan *ManagedIdentityResourceModel, current []string) error {
+	var desired []string
+	if diags := plan.PluginAssemblyIds.ElementsAs(ctx, &desired, false); diags.HasError() {
+		return fmt.Errorf("failed to read plugin_assembly_ids: %v", diags)
+	}
+
+	for _, pluginAssemblyId := range current {
+		if !slices.ContainsFunc(desired, func(id string) bool { return strings.EqualFold(id, pluginAssemblyId) }) {
+			err := r.ManagedIdentityClient.UnbindPluginAssembly(ctx, plan.EnvironmentId.ValueString(), pluginAssemblyId)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, pluginAssemblyId := range desired {
+		if !slices.ContainsFunc(current, func(id string) bool { return strings.EqualFold(id, pluginAssemblyId) }) {
+			err := r.ManagedIdentityClient.BindPluginAssembly(ctx, plan.EnvironmentId.ValueString(), plan.Id.ValueString(), pluginAssemblyId)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readManagedIdentity refreshes the model with the managed identity record and the plugin assemblies bound to it.
+func (r *ManagedIdentityResource) readManagedIdentity(ctx context.Context, model *ManagedIdentityResourceModel) error {
+	managedIdentity, err := r.ManagedIdentityClient.GetManagedIdentity(ctx, model.EnvironmentId.ValueString(), model.Id.ValueString())
+	if err != nil {
+		return err
+	}
+
+	pluginAssemblyIds, err := r.ManagedIdentityClient.GetPluginAssemblyIds(ctx, model.EnvironmentId.ValueString(), model.Id.ValueString())
+	if err != nil {
+		return err
+	}
+
+	return convertFromManagedIdentityDto(model, managedIdentity, pluginAssemblyIds)
+}
@@ -0,0 +1,198 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_managed_identity_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccManagedIdentityResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_tenant" "tenant" {}
+
+				resource "powerplatform_dataverse_managed_identity" "identity" {
+					environment_id = powerplatform_environment.env.id
+					name           = "` + mocks.TestName() + `"
+					application_id = "00000000-0000-0000-0000-000000000001"
+					tenant_id      = data.powerplatform_tenant.tenant.tenant_id
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_dataverse_managed_identity.identity", "id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_dataverse_managed_identity.identity", "plugin_assembly_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitManagedIdentityResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	const dataverseUrl = "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2"
+
+	name := ""
+	deleted := false
+	boundAssemblies := map[string]bool{}
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", dataverseUrl+"/managedidentities",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			identity := map[string]any{}
+			_ = json.Unmarshal(body, &identity)
+			if req.Header.Get("Prefer") != "return=representation" || identity["applicationid"] != "00000000-0000-0000-0000-000000000002" ||
+				identity["tenantid"] != "00000000-0000-0000-0000-000000000003" || identity["credentialsource"] != float64(2) || identity["subjectscope"] != float64(1) {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			name = identity["name"].(string)
+			return httpmock.NewStringResponse(http.StatusCreated, managedIdentityResponse(name)), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^`+regexp.QuoteMeta(dataverseUrl+"/managedidentities%2800000000-0000-0000-0000-000000000010%29?"),
+		func(req *http.Request) (*http.Response, error) {
+			if deleted {
+				return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, managedIdentityResponse(name)), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", dataverseUrl+"/managedidentities%2800000000-0000-0000-0000-000000000010%29",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			identity := map[string]any{}
+			_ = json.Unmarshal(body, &identity)
+			name = identity["name"].(string)
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", dataverseUrl+"/managedidentities%2800000000-0000-0000-0000-000000000010%29",
+		func(req *http.Request) (*http.Response, error) {
+			if len(boundAssemblies) > 0 {
+				return httpmock.NewStringResponse(http.StatusBadRequest, "managed identity is still referenced by plugin assemblies"), nil
+			}
+			deleted = true
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^`+regexp.QuoteMeta(dataverseUrl+"/pluginassemblies?")+`.*_managedidentityid_value`,
+		func(req *http.Request) (*http.Response, error) {
+			ids := []string{}
+			for id := range boundAssemblies {
+				ids = append(ids, fmt.Sprintf(`{"pluginassemblyid":"%s"}`, id))
+			}
+			sort.Strings(ids)
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"value":[%s]}`, strings.Join(ids, ","))), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", `=~^`+regexp.QuoteMeta(dataverseUrl+"/pluginassemblies%28")+`([\da-fA-F-]+)%29$`,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			if !strings.Contains(string(body), `"managedidentityid@odata.bind":"/managedidentities(00000000-0000-0000-0000-000000000010)"`) {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			// Dataverse returns the ids in lower case, whatever their case in the request.
+			boundAssemblies[strings.ToLower(httpmock.MustGetSubmatch(req, 1))] = true
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `=~^`+regexp.QuoteMeta(dataverseUrl+"/pluginassemblies%28")+`([\da-fA-F-]+)%29/managedidentityid/\$ref$`,
+		func(req *http.Request) (*http.Response, error) {
+			delete(boundAssemblies, strings.ToLower(httpmock.MustGetSubmatch(req, 1)))
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if !deleted {
+				return errors.New("managed identity was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_dataverse_managed_identity" "identity" {
+					environment_id      = "00000000-0000-0000-0000-000000000001"
+					name                = "Plugin identity"
+					application_id      = "00000000-0000-0000-0000-000000000002"
+					tenant_id           = "00000000-0000-0000-0000-000000000003"
+					plugin_assembly_ids = ["00000000-0000-0000-0000-000000000020", "00000000-0000-0000-0000-000000000021"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_dataverse_managed_identity.identity", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_dataverse_managed_identity.identity", "name", "Plugin identity"),
+					resource.TestCheckResourceAttr("powerplatform_dataverse_managed_identity.identity", "application_id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("powerplatform_dataverse_managed_identity.identity", "tenant_id", "00000000-0000-0000-0000-000000000003"),
+					resource.TestCheckResourceAttr("powerplatform_dataverse_managed_identity.identity", "plugin_assembly_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_dataverse_managed_identity.identity", "plugin_assembly_ids.*", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_dataverse_managed_identity.identity", "plugin_assembly_ids.*", "00000000-0000-0000-0000-000000000021"),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_dataverse_managed_identity" "identity" {
+					environment_id      = "00000000-0000-0000-0000-000000000001"
+					name                = "Plugin identity (renamed)"
+					application_id      = "00000000-0000-0000-0000-000000000002"
+					tenant_id           = "00000000-0000-0000-0000-000000000003"
+					plugin_assembly_ids = ["00000000-0000-0000-0000-000000000021", "00000000-0000-0000-0000-0000000000AA"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_dataverse_managed_identity.identity", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_dataverse_managed_identity.identity", "name", "Plugin identity (renamed)"),
+					resource.TestCheckResourceAttr("powerplatform_dataverse_managed_identity.identity", "plugin_assembly_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_dataverse_managed_identity.identity", "plugin_assembly_ids.*", "00000000-0000-0000-0000-000000000021"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_dataverse_managed_identity.identity", "plugin_assembly_ids.*", "00000000-0000-0000-0000-0000000000AA"),
+				),
+			},
+		},
+	})
+}
+
+func managedIdentityResponse(name string) string {
+	return fmt.Sprintf(`{
+		"managedidentityid": "00000000-0000-0000-0000-000000000010",
+		"name": "%s",
+		"applicationid": "00000000-0000-0000-0000-000000000002",
+		"tenantid": "00000000-0000-0000-0000-000000000003",
+		"credentialsource": 2,
+		"subjectscope": 1
+	}`, name)
+}
@@ -0,0 +1,171 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_workflow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment"
+)
+
+const (
+	WORKFLOW_STATE_DRAFT     = "Draft"
+	WORKFLOW_STATE_ACTIVATED = "Activated"
+
+	// WORKFLOW_TYPE_DEFINITION is the type of the workflow records that hold the process definitions, as opposed to their activation records.
+	WORKFLOW_TYPE_DEFINITION = 1
+
+	FLOW_RUN_STATUS_SUCCEEDED = "Succeeded"
+	FLOW_RUN_STATUS_FAILED    = "Failed"
+)
+
+// workflowStates maps the workflow states to their statecode and statuscode values.
+var workflowStates = map[string]workflowStateDto{
+	WORKFLOW_STATE_DRAFT:     {StateCode: 0, StatusCode: 1},
+	WORKFLOW_STATE_ACTIVATED: {StateCode: 1, StatusCode: 2},
+}
+
+// workflowCategories maps the category values of the workflow table to their names.
+var workflowCategories = map[int64]string{
+	0: "Workflow",
+	1: "Dialog",
+	2: "BusinessRule",
+	3: "Action",
+	4: "BusinessProcessFlow",
+	5: "ModernFlow",
+	6: "DesktopFlow",
+}
+
+func workflowStateName(stateCode int64) string {
+	for name, state := range workflowStates {
+		if state.StateCode == stateCode {
+			return name
+		}
+	}
+	return fmt.Sprintf("%d", stateCode)
+}
+
+func workflowCategoryName(category int64) string {
+	if name, ok := workflowCategories[category]; ok {
+		return name
+	}
+	return fmt.Sprintf("%d", category)
+}
+
+func newWorkflowClient(apiClient *api.Client) client {
+	return client{
+		Api:               apiClient,
+		environmentClient: environment.NewEnvironmentClient(apiClient),
+	}
+}
+
+type client struct {
+	Api               *api.Client
+	environmentClient environment.Client
+}
+
+func (client *client) buildDataverseUrl(environmentHost, path string, values url.Values) string {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/%s", client.Api.GetConfig().GetDataverseApiVersion(), path),
+	}
+	if values != nil {
+		apiUrl.RawQuery = values.Encode()
+	}
+	return apiUrl.String()
+}
+
+func (client *client) GetWorkflow(ctx context.Context, environmentId, workflowId string) (*workflowDto, error) {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "workflowid,name,uniquename,category,type,statecode,statuscode")
+
+	workflow := workflowDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, fmt.Sprintf("workflows(%s)", workflowId), values), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &workflow)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("workflow '%s' not found", workflowId))
+	}
+	return &workflow, nil
+}
+
+// GetWorkflowByName looks up the definition of a workflow by its name, failing when the name is missing or ambiguous.
+func (client *client) GetWorkflowByName(ctx context.Context, environmentId, name string) (*workflowDto, error) {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "workflowid,name,uniquename,category,type,statecode,statuscode")
+	values.Add("$filter", fmt.Sprintf("name eq '%s' and type eq %d", strings.ReplaceAll(name, "'", "''"), WORKFLOW_TYPE_DEFINITION))
+
+	workflows := workflowArrayDto{}
+	_, err = client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, "workflows", values), nil, nil, []int{http.StatusOK}, &workflows)
+	if err != nil {
+		return nil, err
+	}
+	switch len(workflows.Value) {
+	case 0:
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("workflow with name '%s' not found", name))
+	case 1:
+		return &workflows.Value[0], nil
+	default:
+		return nil, fmt.Errorf("found %d workflows with name '%s', use workflow_id instead", len(workflows.Value), name)
+	}
+}
+
+// SetWorkflowState activates or deactivates a workflow and returns it in its new state.
+func (client *client) SetWorkflowState(ctx context.Context, environmentId, workflowId, state string) (*workflowDto, error) {
+	workflowState, ok := workflowStates[state]
+	if !ok {
+		return nil, fmt.Errorf("invalid workflow state '%s'", state)
+	}
+
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = client.Api.Execute(ctx, nil, "PATCH", client.buildDataverseUrl(environmentHost, fmt.Sprintf("workflows(%s)", workflowId), nil), nil, workflowState, []int{http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetWorkflow(ctx, environmentId, workflowId)
+}
+
+// GetFlowRuns returns the most recent runs of a cloud flow, newest first, from the flow run history that Dataverse keeps for the flows of solutions.
+func (client *client) GetFlowRuns(ctx context.Context, environmentId, workflowId string, top int64) ([]flowRunDto, error) {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "flowrunid,name,status,triggertype,starttime,endtime,duration,errorcode,errormessage")
+	values.Add("$filter", fmt.Sprintf("_workflow_value eq %s", workflowId))
+	values.Add("$orderby", "starttime desc")
+	values.Add("$top", fmt.Sprintf("%d", top))
+
+	runs := flowRunArrayDto{}
+	_, err = client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, "flowruns", values), nil, nil, []int{http.StatusOK}, &runs)
+	if err != nil {
+		return nil, err
+	}
+	return runs.Value, nil
+}
@@ -0,0 +1,196 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_workflow
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+const (
+	WORKFLOW_RUNS_DEFAULT_TOP = 50
+	WORKFLOW_RUNS_MAX_TOP     = 5000
+)
+
+var (
+	_ datasource.DataSource              = &WorkflowRunsDataSource{}
+	_ datasource.DataSourceWithConfigure = &WorkflowRunsDataSource{}
+)
+
+func NewWorkflowRunsDataSource() datasource.DataSource {
+	return &WorkflowRunsDataSource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "dataverse_workflow_runs",
+		},
+	}
+}
+
+func (d *WorkflowRunsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *WorkflowRunsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the recent runs of a solution-aware [cloud flow](https://learn.microsoft.com/power-automate/overview-cloud) from its [run history in Dataverse](https://learn.microsoft.com/power-automate/dataverse/cloud-flow-run-metadata), to be used in checks or preconditions that validate a flow after it is activated, for example with `powerplatform_dataverse_workflow_state`.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Read: true,
+			}),
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Dataverse environment of the flow",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "environment_id must be a valid environment id guid"),
+				},
+			},
+			"workflow_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the flow in the workflow table of Dataverse",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "workflow_id must be a valid guid"),
+				},
+			},
+			"top": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of the most recent runs to fetch. Defaults to `%d`", WORKFLOW_RUNS_DEFAULT_TOP),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, WORKFLOW_RUNS_MAX_TOP),
+				},
+			},
+			"run_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of runs fetched",
+				Computed:            true,
+			},
+			"succeeded_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of the fetched runs that succeeded",
+				Computed:            true,
+			},
+			"failed_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of the fetched runs that failed",
+				Computed:            true,
+			},
+			"last_run_status": schema.StringAttribute{
+				MarkdownDescription: "Status of the most recent run, null when the flow has not run",
+				Computed:            true,
+			},
+			"last_run_time": schema.StringAttribute{
+				MarkdownDescription: "Start time of the most recent run, null when the flow has not run",
+				Computed:            true,
+			},
+			"runs": schema.ListNestedAttribute{
+				MarkdownDescription: "Runs of the flow, from the newest to the oldest",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the run record",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the run, as shown in the run history of Power Automate",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the run, for example `Succeeded`, `Failed`, `Cancelled` or `Running`",
+							Computed:            true,
+						},
+						"trigger_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the trigger that started the run",
+							Computed:            true,
+						},
+						"start_time": schema.StringAttribute{
+							MarkdownDescription: "Start time of the run",
+							Computed:            true,
+						},
+						"end_time": schema.StringAttribute{
+							MarkdownDescription: "End time of the run, null while the run is in progress",
+							Computed:            true,
+						},
+						"duration_in_seconds": schema.Int64Attribute{
+							MarkdownDescription: "Duration of the run in seconds",
+							Computed:            true,
+						},
+						"error_code": schema.StringAttribute{
+							MarkdownDescription: "Error code of a failed run",
+							Computed:            true,
+						},
+						"error_message": schema.StringAttribute{
+							MarkdownDescription: "Error message of a failed run",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WorkflowRunsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.WorkflowClient = newWorkflowClient(client.Api)
+}
+
+func (d *WorkflowRunsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var state WorkflowRunsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	top := int64(WORKFLOW_RUNS_DEFAULT_TOP)
+	if !state.Top.IsNull() {
+		top = state.Top.ValueInt64()
+	}
+
+	runs, err := d.WorkflowClient.GetFlowRuns(ctx, state.EnvironmentId.ValueString(), state.WorkflowId.ValueString(), top)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromFlowRunDtos(&state, runs)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
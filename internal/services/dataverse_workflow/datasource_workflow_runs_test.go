@@ -0,0 +1,72 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_workflow_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestUnitWorkflowRunsDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	const dataverseUrl = "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2"
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^`+regexp.QuoteMeta(dataverseUrl+"/flowruns?%24filter=_workflow_value+eq+00000000-0000-0000-0000-000000000010&%24orderby=starttime+desc&")+`.*%24top=10$`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_flow_runs.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^`+regexp.QuoteMeta(dataverseUrl+"/flowruns?%24filter=_workflow_value+eq+00000000-0000-0000-0000-000000000011&%24orderby=starttime+desc&")+`.*%24top=50$`,
+		httpmock.NewStringResponder(http.StatusOK, `{"value":[]}`))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_dataverse_workflow_runs" "flow" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					workflow_id    = "00000000-0000-0000-0000-000000000010"
+					top            = 10
+				}
+
+				data "powerplatform_dataverse_workflow_runs" "never_run" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					workflow_id    = "00000000-0000-0000-0000-000000000011"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_dataverse_workflow_runs.flow", "run_count", "3"),
+					resource.TestCheckResourceAttr("data.powerplatform_dataverse_workflow_runs.flow", "succeeded_count", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_dataverse_workflow_runs.flow", "failed_count", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_dataverse_workflow_runs.flow", "last_run_status", "Failed"),
+					resource.TestCheckResourceAttr("data.powerplatform_dataverse_workflow_runs.flow", "last_run_time", "2026-10-14T09:15:02Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_dataverse_workflow_runs.flow", "runs.#", "3"),
+					resource.TestCheckResourceAttr("data.powerplatform_dataverse_workflow_runs.flow", "runs.0.id", "00000000-0000-0000-0000-000000000103"),
+					resource.TestCheckResourceAttr("data.powerplatform_dataverse_workflow_runs.flow", "runs.0.error_code", "ActionFailed"),
+					resource.TestCheckResourceAttr("data.powerplatform_dataverse_workflow_runs.flow", "runs.0.duration_in_seconds", "7"),
+					resource.TestCheckResourceAttr("data.powerplatform_dataverse_workflow_runs.flow", "runs.2.trigger_type", "Manual"),
+					resource.TestCheckNoResourceAttr("data.powerplatform_dataverse_workflow_runs.flow", "runs.1.error_code"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_dataverse_workflow_runs.never_run", "run_count", "0"),
+					resource.TestCheckResourceAttr("data.powerplatform_dataverse_workflow_runs.never_run", "runs.#", "0"),
+					resource.TestCheckNoResourceAttr("data.powerplatform_dataverse_workflow_runs.never_run", "last_run_status"),
+					resource.TestCheckNoResourceAttr("data.powerplatform_dataverse_workflow_runs.never_run", "last_run_time"),
+				),
+			},
+		},
+	})
+}
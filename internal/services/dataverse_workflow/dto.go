@@ -0,0 +1,39 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_workflow
+
+type workflowDto struct {
+	Id         string `json:"workflowid"`
+	Name       string `json:"name"`
+	UniqueName string `json:"uniquename"`
+	Category   int64  `json:"category"`
+	Type       int64  `json:"type"`
+	StateCode  int64  `json:"statecode"`
+	StatusCode int64  `json:"statuscode"`
+}
+
+type workflowArrayDto struct {
+	Value []workflowDto `json:"value"`
+}
+
+type workflowStateDto struct {
+	StateCode  int64 `json:"statecode"`
+	StatusCode int64 `json:"statuscode"`
+}
+
+type flowRunDto struct {
+	Id           string `json:"flowrunid"`
+	Name         string `json:"name"`
+	Status       string `json:"status"`
+	TriggerType  string `json:"triggertype"`
+	StartTime    string `json:"starttime"`
+	EndTime      string `json:"endtime"`
+	Duration     int64  `json:"duration"`
+	ErrorCode    string `json:"errorcode"`
+	ErrorMessage string `json:"errormessage"`
+}
+
+type flowRunArrayDto struct {
+	Value []flowRunDto `json:"value"`
+}
@@ -0,0 +1,107 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_workflow
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+type WorkflowStateResource struct {
+	helpers.TypeInfo
+	WorkflowClient client
+}
+
+type WorkflowStateResourceModel struct {
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	Id            types.String   `tfsdk:"id"`
+	EnvironmentId types.String   `tfsdk:"environment_id"`
+	WorkflowId    types.String   `tfsdk:"workflow_id"`
+	Name          types.String   `tfsdk:"name"`
+	Category      types.String   `tfsdk:"category"`
+	State         types.String   `tfsdk:"state"`
+}
+
+func convertFromWorkflowDto(model *WorkflowStateResourceModel, workflow *workflowDto) {
+	model.Id = types.StringValue(workflow.Id)
+	model.WorkflowId = types.StringValue(workflow.Id)
+	model.Name = types.StringValue(workflow.Name)
+	model.Category = types.StringValue(workflowCategoryName(workflow.Category))
+	model.State = types.StringValue(workflowStateName(workflow.StateCode))
+}
+
+type WorkflowRunsDataSource struct {
+	helpers.TypeInfo
+	WorkflowClient client
+}
+
+type WorkflowRunsDataSourceModel struct {
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	EnvironmentId  types.String   `tfsdk:"environment_id"`
+	WorkflowId     types.String   `tfsdk:"workflow_id"`
+	Top            types.Int64    `tfsdk:"top"`
+	RunCount       types.Int64    `tfsdk:"run_count"`
+	SucceededCount types.Int64    `tfsdk:"succeeded_count"`
+	FailedCount    types.Int64    `tfsdk:"failed_count"`
+	LastRunStatus  types.String   `tfsdk:"last_run_status"`
+	LastRunTime    types.String   `tfsdk:"last_run_time"`
+	Runs           []FlowRunModel `tfsdk:"runs"`
+}
+
+type FlowRunModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Status            types.String `tfsdk:"status"`
+	TriggerType       types.String `tfsdk:"trigger_type"`
+	StartTime         types.String `tfsdk:"start_time"`
+	EndTime           types.String `tfsdk:"end_time"`
+	DurationInSeconds types.Int64  `tfsdk:"duration_in_seconds"`
+	ErrorCode         types.String `tfsdk:"error_code"`
+	ErrorMessage      types.String `tfsdk:"error_message"`
+}
+
+// convertFromFlowRunDtos summarizes the runs, which are ordered from the newest to the oldest.
+func convertFromFlowRunDtos(model *WorkflowRunsDataSourceModel, runs []flowRunDto) {
+	model.Runs = make([]FlowRunModel, 0, len(runs))
+	succeeded, failed := int64(0), int64(0)
+	for _, run := range runs {
+		switch {
+		case strings.EqualFold(run.Status, FLOW_RUN_STATUS_SUCCEEDED):
+			succeeded++
+		case strings.EqualFold(run.Status, FLOW_RUN_STATUS_FAILED):
+			failed++
+		}
+		model.Runs = append(model.Runs, FlowRunModel{
+			Id:                types.StringValue(run.Id),
+			Name:              types.StringValue(run.Name),
+			Status:            types.StringValue(run.Status),
+			TriggerType:       optionalString(run.TriggerType),
+			StartTime:         optionalString(run.StartTime),
+			EndTime:           optionalString(run.EndTime),
+			DurationInSeconds: types.Int64Value(run.Duration),
+			ErrorCode:         optionalString(run.ErrorCode),
+			ErrorMessage:      optionalString(run.ErrorMessage),
+		})
+	}
+
+	model.RunCount = types.Int64Value(int64(len(runs)))
+	model.SucceededCount = types.Int64Value(succeeded)
+	model.FailedCount = types.Int64Value(failed)
+	model.LastRunStatus = types.StringNull()
+	model.LastRunTime = types.StringNull()
+	if len(runs) > 0 {
+		model.LastRunStatus = types.StringValue(runs[0].Status)
+		model.LastRunTime = optionalString(runs[0].StartTime)
+	}
+}
+
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
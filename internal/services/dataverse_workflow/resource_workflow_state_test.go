@@ -0,0 +1,167 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dataverse_workflow_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestUnitWorkflowStateResource_Validate_Activate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	const dataverseUrl = "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2"
+
+	stateCode := 0
+	statusCode := 1
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Activate/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^`+regexp.QuoteMeta(dataverseUrl+"/workflows?%24filter=name+eq+%27Set+account+number%27+and+type+eq+1&"),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"value":[%s]}`, workflowResponse(stateCode, statusCode))), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^`+regexp.QuoteMeta(dataverseUrl+"/workflows%2800000000-0000-0000-0000-000000000010%29?"),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, workflowResponse(stateCode, statusCode)), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", dataverseUrl+"/workflows%2800000000-0000-0000-0000-000000000010%29",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			state := map[string]int{}
+			_ = json.Unmarshal(body, &state)
+			stateCode = state["statecode"]
+			statusCode = state["statuscode"]
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_dataverse_workflow_state" "rule" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Set account number"
+					state          = "Activated"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_dataverse_workflow_state.rule", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_dataverse_workflow_state.rule", "workflow_id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_dataverse_workflow_state.rule", "category", "BusinessRule"),
+					resource.TestCheckResourceAttr("powerplatform_dataverse_workflow_state.rule", "state", "Activated"),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_dataverse_workflow_state" "rule" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Set account number"
+					state          = "Draft"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_dataverse_workflow_state.rule", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_dataverse_workflow_state.rule", "state", "Draft"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitWorkflowStateResource_Validate_Activate_By_Id(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	const dataverseUrl = "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2"
+
+	patched := false
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Activate/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	// the process is already active, so no state change is expected.
+	httpmock.RegisterResponder("GET", `=~^`+regexp.QuoteMeta(dataverseUrl+"/workflows%2800000000-0000-0000-0000-000000000010%29?"),
+		httpmock.NewStringResponder(http.StatusOK, workflowResponse(1, 2)))
+
+	httpmock.RegisterResponder("PATCH", dataverseUrl+"/workflows%2800000000-0000-0000-0000-000000000010%29",
+		func(req *http.Request) (*http.Response, error) {
+			patched = true
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_dataverse_workflow_state" "rule" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					workflow_id    = "00000000-0000-0000-0000-000000000010"
+					state          = "Activated"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_dataverse_workflow_state.rule", "name", "Set account number"),
+					resource.TestCheckResourceAttr("powerplatform_dataverse_workflow_state.rule", "state", "Activated"),
+					func(_ *terraform.State) error {
+						if patched {
+							return errors.New("state of an already active process was changed")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitWorkflowStateResource_Validate_Id_Or_Name(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_dataverse_workflow_state" "rule" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					workflow_id    = "00000000-0000-0000-0000-000000000010"
+					name           = "Set account number"
+					state          = "Activated"
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func workflowResponse(stateCode, statusCode int) string {
+	return fmt.Sprintf(`{
+		"workflowid": "00000000-0000-0000-0000-000000000010",
+		"name": "Set account number",
+		"uniquename": "",
+		"category": 2,
+		"type": 1,
+		"statecode": %d,
+		"statuscode": %d
+	}`, stateCode, statusCode)
+}
@@ -14,16 +14,25 @@ import (
 	"github.com/microsoft/terraform-provider-power-platform/internal/api"
 	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
 	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/tenant"
+)
+
+// Types of the resources that can be exempted from a DLP policy.
+const (
+	EXEMPT_RESOURCE_TYPE_APP  = "Microsoft.PowerApps/apps"
+	EXEMPT_RESOURCE_TYPE_FLOW = "Microsoft.ProcessSimple/environments/flows"
 )
 
 func newDlpPolicyClient(apiClient *api.Client) client {
 	return client{
-		Api: apiClient,
+		Api:       apiClient,
+		TenantApi: tenant.NewTenantClient(apiClient),
 	}
 }
 
 type client struct {
-	Api *api.Client
+	Api       *api.Client
+	TenantApi tenant.Client
 }
 
 func (client *client) GetPolicies(ctx context.Context) ([]dlpPolicyModelDto, error) {
@@ -68,7 +77,7 @@ func (client *client) GetPolicy(ctx context.Context, name string) (*dlpPolicyMod
 	policy := dlpPolicyDto{}
 	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &policy)
 	if err != nil {
-		var httpError *customerrors.UnexpectedHttpStatusCodeError
+		var httpError customerrors.UnexpectedHttpStatusCodeError
 		if errors.As(err, &httpError) && httpError.StatusCode == http.StatusNotFound {
 			return nil, customerrors.WrapIntoProviderError(err, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("DLP Policy '%s' not found", name))
 		}
@@ -256,3 +265,61 @@ func (client *client) CreatePolicy(ctx context.Context, policy dlpPolicyModelDto
 	}
 	return covertDlpPolicyToPolicyModel(createdPolicy)
 }
+
+func (client *client) buildExemptResourcesUrl(ctx context.Context, policyName string) (string, error) {
+	tenantInfo, err := client.TenantApi.GetTenant(ctx)
+	if err != nil {
+		return "", err
+	}
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.BapiUrl,
+		Path:   fmt.Sprintf("providers/PowerPlatform.Governance/v1/tenants/%s/policies/%s/exempt", tenantInfo.TenantId, policyName),
+	}
+	return apiUrl.String(), nil
+}
+
+// GetPolicyExemptResources returns the apps and flows that are exempted from the policy.
+func (client *client) GetPolicyExemptResources(ctx context.Context, policyName string) ([]dlpExemptResourceDto, error) {
+	apiUrl, err := client.buildExemptResourcesUrl(ctx, policyName)
+	if err != nil {
+		return nil, err
+	}
+
+	exemptResources := dlpExemptResourcesDto{}
+	_, err = client.Api.Execute(ctx, nil, "GET", apiUrl, nil, nil, []int{http.StatusOK}, &exemptResources)
+	if err != nil {
+		var httpError customerrors.UnexpectedHttpStatusCodeError
+		if errors.As(err, &httpError) && httpError.StatusCode == http.StatusNotFound {
+			return nil, customerrors.WrapIntoProviderError(err, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("Exempt resources of DLP Policy '%s' not found", policyName))
+		}
+		return nil, err
+	}
+	return exemptResources.ExemptResources, nil
+}
+
+// SetPolicyExemptResources replaces the apps and flows that are exempted from the policy.
+func (client *client) SetPolicyExemptResources(ctx context.Context, policyName string, resources []dlpExemptResourceDto) ([]dlpExemptResourceDto, error) {
+	apiUrl, err := client.buildExemptResourcesUrl(ctx, policyName)
+	if err != nil {
+		return nil, err
+	}
+
+	exemptResources := dlpExemptResourcesDto{}
+	_, err = client.Api.Execute(ctx, nil, "PUT", apiUrl, nil, dlpExemptResourcesDto{ExemptResources: resources}, []int{http.StatusOK, http.StatusCreated}, &exemptResources)
+	if err != nil {
+		return nil, err
+	}
+	return exemptResources.ExemptResources, nil
+}
+
+// DeletePolicyExemptResources removes all the exemptions of the policy.
+func (client *client) DeletePolicyExemptResources(ctx context.Context, policyName string) error {
+	apiUrl, err := client.buildExemptResourcesUrl(ctx, policyName)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Api.Execute(ctx, nil, "DELETE", apiUrl, nil, nil, []int{http.StatusOK, http.StatusNoContent, http.StatusNotFound}, nil)
+	return err
+}
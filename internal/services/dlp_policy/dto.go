@@ -115,3 +115,12 @@ type dlpActionRuleDto struct {
 	ActionId string `json:"actionId"`
 	Behavior string `json:"behavior"`
 }
+
+type dlpExemptResourcesDto struct {
+	ExemptResources []dlpExemptResourceDto `json:"exemptResources"`
+}
+
+type dlpExemptResourceDto struct {
+	Id   string `json:"id"`   // "/providers/Microsoft.PowerApps/apps/{appName}" or "/providers/Microsoft.ProcessSimple/environments/{environmentName}/flows/{flowName}".
+	Type string `json:"type"` // "Microsoft.PowerApps/apps" or "Microsoft.ProcessSimple/environments/flows".
+}
@@ -313,3 +313,25 @@ func convertToAtrValueEndpointRule(connector dlpConnectorModelDto) []attr.Value
 	}
 	return endpointRules
 }
+
+func convertToDlpExemptResourcesDto(resources []dataLossPreventionPolicyExemptResourceModel) []dlpExemptResourceDto {
+	dtos := make([]dlpExemptResourceDto, 0, len(resources))
+	for _, resource := range resources {
+		dtos = append(dtos, dlpExemptResourceDto{
+			Id:   resource.Id.ValueString(),
+			Type: resource.Type.ValueString(),
+		})
+	}
+	return dtos
+}
+
+func convertFromDlpExemptResourcesDto(dtos []dlpExemptResourceDto) []dataLossPreventionPolicyExemptResourceModel {
+	resources := make([]dataLossPreventionPolicyExemptResourceModel, 0, len(dtos))
+	for _, dto := range dtos {
+		resources = append(resources, dataLossPreventionPolicyExemptResourceModel{
+			Id:   types.StringValue(dto.Id),
+			Type: types.StringValue(dto.Type),
+		})
+	}
+	return resources
+}
@@ -113,3 +113,20 @@ type DataLossPreventionPolicyDataSource struct {
 	helpers.TypeInfo
 	DlpPolicyClient client
 }
+
+type DataLossPreventionPolicyExemptionResource struct {
+	helpers.TypeInfo
+	DlpPolicyClient client
+}
+
+type dataLossPreventionPolicyExemptionResourceModel struct {
+	Timeouts        timeouts.Value                                `tfsdk:"timeouts"`
+	Id              types.String                                  `tfsdk:"id"`
+	PolicyId        types.String                                  `tfsdk:"policy_id"`
+	ExemptResources []dataLossPreventionPolicyExemptResourceModel `tfsdk:"exempt_resources"`
+}
+
+type dataLossPreventionPolicyExemptResourceModel struct {
+	Id   types.String `tfsdk:"id"`
+	Type types.String `tfsdk:"type"`
+}
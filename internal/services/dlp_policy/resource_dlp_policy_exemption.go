@@ -0,0 +1,219 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dlp_policy
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &DataLossPreventionPolicyExemptionResource{}
+var _ resource.ResourceWithImportState = &DataLossPreventionPolicyExemptionResource{}
+
+func NewDataLossPreventionPolicyExemptionResource() resource.Resource {
+	return &DataLossPreventionPolicyExemptionResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "data_loss_prevention_policy_exemption",
+		},
+	}
+}
+
+func (r *DataLossPreventionPolicyExemptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *DataLossPreventionPolicyExemptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "This resource manages the apps and flows that are exempted from a Data Loss Prevention Policy. Exempted resources keep using the connectors that the policy blocks or groups separately. The resource owns all the exemptions of the policy: exemptions added outside of Terraform are removed on the next apply, and destroying the resource removes all the exemptions of the policy. See [DLP resource exemption](https://learn.microsoft.com/power-platform/admin/dlp-resource-exemption) for more information.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique name of the policy",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				MarkdownDescription: "Unique name of the Data Loss Prevention Policy, such as the `id` of `powerplatform_data_loss_prevention_policy`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"exempt_resources": schema.SetNestedAttribute{
+				MarkdownDescription: "Apps and flows that are exempted from the policy",
+				Required:            true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Id of the exempted resource, `/providers/Microsoft.PowerApps/apps/<app_name>` for an app or `/providers/Microsoft.ProcessSimple/environments/<environment_id>/flows/<flow_name>` for a flow",
+							Required:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("Type of the exempted resource, `%s` or `%s`", EXEMPT_RESOURCE_TYPE_APP, EXEMPT_RESOURCE_TYPE_FLOW),
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(EXEMPT_RESOURCE_TYPE_APP, EXEMPT_RESOURCE_TYPE_FLOW),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *DataLossPreventionPolicyExemptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.DlpPolicyClient = newDlpPolicyClient(client.Api)
+}
+
+func (r *DataLossPreventionPolicyExemptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *dataLossPreventionPolicyExemptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exemptResources, err := r.DlpPolicyClient.GetPolicyExemptResources(ctx, state.PolicyId.ValueString())
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	if len(exemptResources) == 0 {
+		tflog.Debug(ctx, fmt.Sprintf("DLP Policy '%s' has no exempt resources, removing %s from state", state.PolicyId.ValueString(), r.FullTypeName()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Id = state.PolicyId
+	state.ExemptResources = convertFromDlpExemptResourcesDto(exemptResources)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *DataLossPreventionPolicyExemptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *dataLossPreventionPolicyExemptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exemptResources, err := r.DlpPolicyClient.SetPolicyExemptResources(ctx, plan.PolicyId.ValueString(), convertToDlpExemptResourcesDto(plan.ExemptResources))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	plan.Id = plan.PolicyId
+	if len(exemptResources) > 0 {
+		plan.ExemptResources = convertFromDlpExemptResourcesDto(exemptResources)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DataLossPreventionPolicyExemptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *dataLossPreventionPolicyExemptionResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	exemptResources, err := r.DlpPolicyClient.SetPolicyExemptResources(ctx, plan.PolicyId.ValueString(), convertToDlpExemptResourcesDto(plan.ExemptResources))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	plan.Id = plan.PolicyId
+	if len(exemptResources) > 0 {
+		plan.ExemptResources = convertFromDlpExemptResourcesDto(exemptResources)
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *DataLossPreventionPolicyExemptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *dataLossPreventionPolicyExemptionResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.DlpPolicyClient.DeletePolicyExemptResources(ctx, state.PolicyId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+}
+
+func (r *DataLossPreventionPolicyExemptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("policy_id"), types.StringValue(req.ID))...)
+}
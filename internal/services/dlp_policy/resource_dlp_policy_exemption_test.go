@@ -0,0 +1,143 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package dlp_policy_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestUnitDataLossPreventionPolicyExemptionResource_Validate_Create_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	const exemptUrl = "https://api.bap.microsoft.com/providers/PowerPlatform.Governance/v1/tenants/00000000-0000-0000-0000-000000000001/policies/00000000-0000-0000-0000-000000000002/exempt"
+
+	exemptResources := `{"exemptResources":[]}`
+	deleted := false
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/tenant?api-version=2021-04-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, `{"id":"/providers/Microsoft.BusinessAppPlatform/tenant","name":"default","tenantId":"00000000-0000-0000-0000-000000000001","state":"Enabled"}`), nil
+		})
+
+	httpmock.RegisterResponder("GET", exemptUrl,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, exemptResources), nil
+		})
+
+	httpmock.RegisterResponder("PUT", exemptUrl,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			dto := map[string]any{}
+			if err := json.Unmarshal(body, &dto); err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			exemptResources = string(body)
+			return httpmock.NewStringResponse(http.StatusOK, exemptResources), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", exemptUrl,
+		func(req *http.Request) (*http.Response, error) {
+			deleted = true
+			exemptResources = `{"exemptResources":[]}`
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if !deleted {
+				return fmt.Errorf("the exempt resources of the policy were not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_data_loss_prevention_policy_exemption" "exemption" {
+					policy_id = "00000000-0000-0000-0000-000000000002"
+					exempt_resources = [
+						{
+							id   = "/providers/Microsoft.PowerApps/apps/00000000-0000-0000-0000-000000000003"
+							type = "Microsoft.PowerApps/apps"
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy_exemption.exemption", "id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy_exemption.exemption", "exempt_resources.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_data_loss_prevention_policy_exemption.exemption", "exempt_resources.*", map[string]string{
+						"id":   "/providers/Microsoft.PowerApps/apps/00000000-0000-0000-0000-000000000003",
+						"type": "Microsoft.PowerApps/apps",
+					}),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_data_loss_prevention_policy_exemption" "exemption" {
+					policy_id = "00000000-0000-0000-0000-000000000002"
+					exempt_resources = [
+						{
+							id   = "/providers/Microsoft.PowerApps/apps/00000000-0000-0000-0000-000000000003"
+							type = "Microsoft.PowerApps/apps"
+						},
+						{
+							id   = "/providers/Microsoft.ProcessSimple/environments/00000000-0000-0000-0000-000000000004/flows/00000000-0000-0000-0000-000000000005"
+							type = "Microsoft.ProcessSimple/environments/flows"
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_data_loss_prevention_policy_exemption.exemption", "exempt_resources.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_data_loss_prevention_policy_exemption.exemption", "exempt_resources.*", map[string]string{
+						"id":   "/providers/Microsoft.ProcessSimple/environments/00000000-0000-0000-0000-000000000004/flows/00000000-0000-0000-0000-000000000005",
+						"type": "Microsoft.ProcessSimple/environments/flows",
+					}),
+				),
+			},
+			{
+				ResourceName:      "powerplatform_data_loss_prevention_policy_exemption.exemption",
+				ImportState:       true,
+				ImportStateId:     "00000000-0000-0000-0000-000000000002",
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"timeouts",
+				},
+			},
+		},
+	})
+}
+
+func TestUnitDataLossPreventionPolicyExemptionResource_Validate_Invalid_Type(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_data_loss_prevention_policy_exemption" "exemption" {
+					policy_id = "00000000-0000-0000-0000-000000000002"
+					exempt_resources = [
+						{
+							id   = "/providers/Microsoft.PowerApps/apis/shared_sql"
+							type = "Microsoft.PowerApps/apis"
+						}
+					]
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
@@ -122,7 +122,14 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		return
 	}
 
-	err := r.EnterprisePolicyClient.LinkEnterprisePolicy(ctx, plan.EnvironmentId.ValueString(), plan.PolicyType.ValueString(), plan.SystemId.ValueString())
+	unlock, err := r.EnterprisePolicyClient.Api.LockEnvironment(ctx, plan.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+	defer unlock()
+
+	err = r.EnterprisePolicyClient.LinkEnterprisePolicy(ctx, plan.EnvironmentId.ValueString(), plan.PolicyType.ValueString(), plan.SystemId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
 		return
@@ -197,7 +204,14 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
-	err := r.EnterprisePolicyClient.UnLinkEnterprisePolicy(ctx, state.EnvironmentId.ValueString(), state.PolicyType.ValueString(), state.SystemId.ValueString())
+	unlock, err := r.EnterprisePolicyClient.Api.LockEnvironment(ctx, state.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+	defer unlock()
+
+	err = r.EnterprisePolicyClient.UnLinkEnterprisePolicy(ctx, state.EnvironmentId.ValueString(), state.PolicyType.ValueString(), state.SystemId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
 		return
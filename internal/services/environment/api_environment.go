@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"slices"
 	"strings"
 	"time"
 
@@ -226,6 +227,68 @@ func languageCodeValidator(ctx context.Context, client *api.Client, location str
 	return nil
 }
 
+type templatesValidatorDto struct {
+	Name       string                          `json:"name"`
+	Properties templatesValidatorPropertiesDto `json:"properties"`
+}
+
+type templatesValidatorPropertiesDto struct {
+	IsDisabled     bool                                `json:"isDisabled"`
+	DisabledReason templatesValidatorDisabledReasonDto `json:"disabledReason"`
+}
+
+type templatesValidatorDisabledReasonDto struct {
+	Message string `json:"message"`
+}
+
+// templatesValidator checks that the templates are available for the environment type in the location,
+// as listed by the powerplatform_environment_templates data source, and that none of them is disabled for the tenant.
+func templatesValidator(ctx context.Context, client *api.Client, location, environmentType string, templates []string) error {
+	if len(templates) == 0 {
+		return nil
+	}
+
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.GetConfig().Urls.BapiUrl,
+		Path:   fmt.Sprintf("/providers/Microsoft.BusinessAppPlatform/locations/%s/templates", location),
+	}
+	values := url.Values{}
+	values.Add("api-version", "2023-06-01")
+	apiUrl.RawQuery = values.Encode()
+
+	// the templates are grouped by environment type, in camel case.
+	resp := map[string][]templatesValidatorDto{}
+	_, err := client.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &resp)
+	if err != nil {
+		return err
+	}
+
+	available := []templatesValidatorDto{}
+	for sku, items := range resp {
+		if strings.EqualFold(sku, environmentType) {
+			available = items
+		}
+	}
+
+	names := make([]string, len(available))
+	for i, item := range available {
+		names[i] = item.Name
+	}
+
+	for _, template := range templates {
+		index := slices.IndexFunc(available, func(item templatesValidatorDto) bool { return item.Name == template })
+		if index < 0 {
+			return fmt.Errorf("template %s is not available for %s environments in location %s. available templates are: %s", template, environmentType, location, strings.Join(names, ", "))
+		}
+		if available[index].Properties.IsDisabled {
+			return fmt.Errorf("template %s is disabled for %s environments in location %s: %s", template, environmentType, location, available[index].Properties.DisabledReason.Message)
+		}
+	}
+
+	return nil
+}
+
 func (client *Client) GetEnvironmentHostById(ctx context.Context, environmentId string) (string, error) {
 	env, err := client.GetEnvironment(ctx, environmentId)
 	if err != nil {
@@ -243,6 +306,46 @@ func (client *Client) GetEnvironmentHostById(ctx context.Context, environmentId
 	return envUrl.Host, nil
 }
 
+// WaitForDataverseReady probes the WhoAmI function of the Dataverse Web API of the environment until it responds successfully,
+// since the Dataverse endpoints of a new environment can fail for a while after the environment is provisioned.
+// Only transient failures are waited for: a caller without access to the environment (401, 403) fails immediately.
+func (client *Client) WaitForDataverseReady(ctx context.Context, environmentUrl string) error {
+	envUrl, err := url.Parse(strings.TrimSuffix(environmentUrl, "/"))
+	if err != nil {
+		return err
+	}
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   envUrl.Host,
+		Path:   fmt.Sprintf("/api/data/%s/WhoAmI", client.Api.GetConfig().GetDataverseApiVersion()),
+	}
+
+	for {
+		_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, nil)
+		if err == nil {
+			return nil
+		}
+		if !isDataverseNotReadyError(err) {
+			return fmt.Errorf("dataverse of environment '%s' can't be reached: %w", envUrl.Host, err)
+		}
+		tflog.Debug(ctx, fmt.Sprintf("Dataverse of environment '%s' is not ready yet: %s", envUrl.Host, err.Error()))
+		if err := client.Api.SleepWithContext(ctx, api.DefaultRetryAfter()); err != nil {
+			return fmt.Errorf("dataverse of environment '%s' was not ready before the timeout: %w", envUrl.Host, err)
+		}
+	}
+}
+
+// isDataverseNotReadyError tells whether a failed request to the Dataverse Web API of a new environment is worth retrying:
+// the host doesn't respond yet, returns a server error or doesn't know the endpoint while it is provisioned.
+func isDataverseNotReadyError(err error) bool {
+	statusCode := customerrors.StatusCode(err)
+	return statusCode == 0 ||
+		statusCode == http.StatusNotFound ||
+		statusCode == http.StatusRequestTimeout ||
+		statusCode == http.StatusTooManyRequests ||
+		statusCode >= http.StatusInternalServerError
+}
+
 func (client *Client) GetEnvironment(ctx context.Context, environmentId string) (*EnvironmentDto, error) {
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
@@ -257,7 +360,7 @@ func (client *Client) GetEnvironment(ctx context.Context, environmentId string)
 	env := EnvironmentDto{}
 	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &env)
 	if err != nil {
-		var httpError *customerrors.UnexpectedHttpStatusCodeError
+		var httpError customerrors.UnexpectedHttpStatusCodeError
 		if errors.As(err, &httpError) && httpError.StatusCode == http.StatusNotFound {
 			return nil, customerrors.WrapIntoProviderError(err, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("environment '%s' not found", environmentId))
 		}
@@ -298,6 +401,10 @@ func (client *Client) DeleteEnvironment(ctx context.Context, environmentId strin
 		return nil
 	}
 
+	if err != nil {
+		return err
+	}
+
 	if response.HttpResponse.StatusCode == http.StatusConflict {
 		// the is another operation in progress, let's wait for it to complete, and try again
 		tflog.Debug(ctx, "Another operation is in progress, waiting for it to complete")
@@ -308,11 +415,6 @@ func (client *Client) DeleteEnvironment(ctx context.Context, environmentId strin
 		return client.DeleteEnvironment(ctx, environmentId)
 	}
 
-	var httpError *customerrors.UnexpectedHttpStatusCodeError
-	if errors.As(err, &httpError) {
-		return fmt.Errorf("unexpected HTTP Status %s; Body: %s", httpError.StatusText, httpError.Body)
-	}
-
 	tflog.Debug(ctx, "Environment Deletion Operation HTTP Status: '"+response.HttpResponse.Status+"'")
 	tflog.Debug(ctx, "Waiting for environment deletion operation to complete")
 
@@ -364,6 +466,7 @@ func (client *Client) AddDataverseToEnvironment(ctx context.Context, environment
 	} else {
 		retryAfter = retryAfter * time.Second
 	}
+	progress := client.Api.NewOperationProgress("Dataverse creation")
 	for {
 		lifecycleEnv := &EnvironmentDto{}
 		lifecycleResponse, err := client.Api.Execute(ctx, nil, "GET", locationHeader, nil, nil, []int{http.StatusOK, http.StatusAccepted, http.StatusConflict}, &lifecycleEnv)
@@ -387,6 +490,7 @@ func (client *Client) AddDataverseToEnvironment(ctx context.Context, environment
 		}
 
 		tflog.Debug(ctx, fmt.Sprintf("Dataverse Creation Operation State: '%s'", lifecycleEnv.Properties.ProvisioningState))
+		progress.Report(ctx, lifecycleEnv.Properties.ProvisioningState, -1)
 
 		if lifecycleEnv.Properties.ProvisioningState == "Succeeded" {
 			return lifecycleEnv, nil
@@ -566,6 +670,7 @@ func (client *Client) UpdateEnvironment(ctx context.Context, environmentId strin
 	}
 
 	// despite lifecycle operation success, the environment may not be ready yet.
+	progress := client.Api.NewOperationProgress(fmt.Sprintf("Update of environment '%s'", environmentId))
 	for {
 		if err := client.Api.SleepWithContext(ctx, api.DefaultRetryAfter()); err != nil {
 			return nil, err
@@ -575,6 +680,7 @@ func (client *Client) UpdateEnvironment(ctx context.Context, environmentId strin
 			return nil, err
 		}
 		tflog.Info(ctx, "Environment State: '"+env.Properties.States.Management.Id+"'")
+		progress.Report(ctx, env.Properties.States.Management.Id, -1)
 		if env.Properties.States.Management.Id == "Ready" {
 			return env, nil
 		} else if env.Properties.States.Management.Id == "Running" {
@@ -595,13 +701,20 @@ func (client *Client) GetEnvironments(ctx context.Context) ([]EnvironmentDto, er
 	values.Add("api-version", "2023-06-01")
 	apiUrl.RawQuery = values.Encode()
 
-	envArray := environmentArrayDto{}
-	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &envArray)
-	if err != nil {
-		return nil, err
+	// large tenants return the environments in pages, the next page is requested with the skip token of the next link.
+	environments := []EnvironmentDto{}
+	nextLink := apiUrl.String()
+	for nextLink != "" {
+		envArray := environmentArrayDto{}
+		_, err := client.Api.Execute(ctx, nil, "GET", nextLink, nil, nil, []int{http.StatusOK}, &envArray)
+		if err != nil {
+			return nil, err
+		}
+		environments = append(environments, envArray.Value...)
+		nextLink = envArray.NextLink
 	}
 
-	return envArray.Value, nil
+	return environments, nil
 }
 
 func (client *Client) GetDefaultCurrencyForEnvironment(ctx context.Context, environmentId string) (*TransactionCurrencyDto, error) {
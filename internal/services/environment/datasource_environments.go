@@ -6,6 +6,7 @@ package environment
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/microsoft/terraform-provider-power-platform/internal/api"
 	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customtypes"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 )
 
@@ -77,6 +79,21 @@ func (d *EnvironmentsDataSource) Schema(ctx context.Context, req datasource.Sche
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Read: true,
 			}),
+			"environment_type": schema.StringAttribute{
+				MarkdownDescription: "Only return the environments of this type (Sandbox, Production etc.)",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(EnvironmentTypes...),
+				},
+			},
+			"azure_region": schema.StringAttribute{
+				MarkdownDescription: "Only return the environments in this Azure region (westeurope, eastus etc.)",
+				Optional:            true,
+			},
+			"display_name_contains": schema.StringAttribute{
+				MarkdownDescription: "Only return the environments whose display name contains this text, ignoring case",
+				Optional:            true,
+			},
 			"environments": schema.ListNestedAttribute{
 				MarkdownDescription: "List of environments",
 				Computed:            true,
@@ -150,6 +167,10 @@ func (d *EnvironmentsDataSource) Schema(ctx context.Context, req datasource.Sche
 							MarkdownDescription: "Allow moving data across regions",
 							Computed:            true,
 						},
+						"wait_for_dataverse_ready": schema.BoolAttribute{
+							MarkdownDescription: "Only set by the `powerplatform_environment` resource, always null for the data source",
+							Computed:            true,
+						},
 						"dataverse": schema.SingleNestedAttribute{
 							MarkdownDescription: "Dataverse environment details",
 							Computed:            true,
@@ -213,6 +234,7 @@ func (d *EnvironmentsDataSource) Schema(ctx context.Context, req datasource.Sche
 								},
 								"template_metadata": schema.StringAttribute{
 									MarkdownDescription: "Additional D365 environment template metadata (if any)",
+									CustomType:          customtypes.JSONType{},
 									Computed:            true,
 								},
 							},
@@ -263,6 +285,10 @@ func (d *EnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRe
 	}
 
 	for _, env := range envs {
+		if !matchesEnvironmentsFilters(state, env) {
+			continue
+		}
+
 		currencyCode := ""
 		defaultCurrency, err := d.EnvironmentClient.GetDefaultCurrencyForEnvironment(ctx, env.Name)
 		if err != nil {
@@ -287,3 +313,18 @@ func (d *EnvironmentsDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 }
+
+// matchesEnvironmentsFilters returns true if the environment matches all the filters set in the configuration.
+// The filters are applied before the environment details are read, to avoid requests for environments that are not returned.
+func matchesEnvironmentsFilters(state ListDataSourceModel, env EnvironmentDto) bool {
+	if state.EnvironmentType.ValueString() != "" && !strings.EqualFold(env.Properties.EnvironmentSku, state.EnvironmentType.ValueString()) {
+		return false
+	}
+	if state.AzureRegion.ValueString() != "" && !strings.EqualFold(env.Properties.AzureRegion, state.AzureRegion.ValueString()) {
+		return false
+	}
+	if state.DisplayNameContains.ValueString() != "" && !strings.Contains(strings.ToLower(env.Properties.DisplayName), strings.ToLower(state.DisplayNameContains.ValueString())) {
+		return false
+	}
+	return true
+}
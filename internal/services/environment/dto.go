@@ -137,16 +137,16 @@ type ExtendedSettingsDto struct {
 }
 
 type LinkedEnvironmentMetadataDto struct {
-	BackgroundOperationsState string                     `json:"backgroundOperationsState,omitempty"`
-	DomainName                string                     `json:"domainName,omitempty"`
-	InstanceURL               string                     `json:"instanceUrl,omitempty"`
-	BaseLanguage              int                        `json:"baseLanguage,omitempty"`
-	SecurityGroupId           string                     `json:"securityGroupId,omitempty"`
-	ResourceId                string                     `json:"resourceId,omitempty"`
-	Version                   string                     `json:"version,omitempty"`
-	Templates                 []string                   `json:"template,omitempty"`
-	TemplateMetadata          *createTemplateMetadataDto `json:"templateMetadata,omitempty"`
-	UniqueName                string                     `json:"uniqueName,omitempty"`
+	BackgroundOperationsState string                    `json:"backgroundOperationsState,omitempty"`
+	DomainName                string                    `json:"domainName,omitempty"`
+	InstanceURL               string                    `json:"instanceUrl,omitempty"`
+	BaseLanguage              int                       `json:"baseLanguage,omitempty"`
+	SecurityGroupId           string                    `json:"securityGroupId,omitempty"`
+	ResourceId                string                    `json:"resourceId,omitempty"`
+	Version                   string                    `json:"version,omitempty"`
+	Templates                 []string                  `json:"template,omitempty"`
+	TemplateMetadata          createTemplateMetadataDto `json:"templateMetadata,omitempty"`
+	UniqueName                string                    `json:"uniqueName,omitempty"`
 }
 
 type RuntimeEndpointsDto struct {
@@ -179,7 +179,8 @@ type StatesManagementEnvironmentDto struct {
 }
 
 type environmentArrayDto struct {
-	Value []EnvironmentDto `json:"value"`
+	Value    []EnvironmentDto `json:"value"`
+	NextLink string           `json:"nextLink,omitempty"`
 }
 
 type environmentCreateDto struct {
@@ -208,25 +209,20 @@ type environmentCreatePropertiesDto struct {
 }
 
 type createLinkEnvironmentMetadataDto struct {
-	BaseLanguage     int                        `json:"baseLanguage,omitempty"`
-	DomainName       string                     `json:"domainName,omitempty"`
-	Currency         *createCurrencyDto         `json:"currency,omitempty"`
-	SecurityGroupId  string                     `json:"securityGroupId,omitempty"`
-	Templates        []string                   `json:"templates,omitempty"`
-	TemplateMetadata *createTemplateMetadataDto `json:"templateMetadata,omitempty"`
+	BaseLanguage     int                       `json:"baseLanguage,omitempty"`
+	DomainName       string                    `json:"domainName,omitempty"`
+	Currency         *createCurrencyDto        `json:"currency,omitempty"`
+	SecurityGroupId  string                    `json:"securityGroupId,omitempty"`
+	Templates        []string                  `json:"templates,omitempty"`
+	TemplateMetadata createTemplateMetadataDto `json:"templateMetadata,omitempty"`
 }
 type createCurrencyDto struct {
 	Code string `json:"code,omitempty"`
 }
 
-type createTemplateMetadataDto struct {
-	PostProvisioningPackages []createPostProvisioningPackagesDto `json:"PostProvisioningPackages,omitempty"`
-}
-
-type createPostProvisioningPackagesDto struct {
-	ApplicationUniqueName string `json:"applicationUniqueName,omitempty"`
-	Parameters            string `json:"parameters,omitempty"`
-}
+// createTemplateMetadataDto is the free-form metadata of the templates, such as the PostProvisioningPackages of the ERP templates
+// or the extra parameters of the industry templates, sent as configured.
+type createTemplateMetadataDto map[string]any
 
 type enironmentDeleteDto struct {
 	Code    string `json:"code"`
@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/microsoft/terraform-provider-power-platform/internal/config"
 	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customtypes"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/licensing"
 )
@@ -31,8 +32,11 @@ type Resource struct {
 }
 
 type ListDataSourceModel struct {
-	Timeouts     timeouts.Value `tfsdk:"timeouts"`
-	Environments []SourceModel  `tfsdk:"environments"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+	EnvironmentType     types.String   `tfsdk:"environment_type"`
+	AzureRegion         types.String   `tfsdk:"azure_region"`
+	DisplayNameContains types.String   `tfsdk:"display_name_contains"`
+	Environments        []SourceModel  `tfsdk:"environments"`
 }
 
 type SourceModel struct {
@@ -51,6 +55,7 @@ type SourceModel struct {
 	AllowBingSearch              types.Bool         `tfsdk:"allow_bing_search"`
 	AllowMovingDataAcrossRegions types.Bool         `tfsdk:"allow_moving_data_across_regions"`
 	EnterprisePolicies           basetypes.SetValue `tfsdk:"enterprise_policies"`
+	WaitForDataverseReady        types.Bool         `tfsdk:"wait_for_dataverse_ready"`
 
 	Dataverse types.Object `tfsdk:"dataverse"`
 }
@@ -64,21 +69,21 @@ type EnterprisePoliciesModel struct {
 }
 
 type DataverseSourceModel struct {
-	Url                 types.String `tfsdk:"url"`
-	Domain              types.String `tfsdk:"domain"`
-	OrganizationId      types.String `tfsdk:"organization_id"`
-	SecurityGroupId     types.String `tfsdk:"security_group_id"`
-	LanguageName        types.Int64  `tfsdk:"language_code"`
-	Version             types.String `tfsdk:"version"`
-	LinkedAppType       types.String `tfsdk:"linked_app_type"`
-	LinkedAppId         types.String `tfsdk:"linked_app_id"`
-	LinkedAppURL        types.String `tfsdk:"linked_app_url"`
-	CurrencyCode        types.String `tfsdk:"currency_code"`
-	Templates           []string     `tfsdk:"templates"`
-	TemplateMetadata    types.String `tfsdk:"template_metadata"`
-	AdministrationMode  types.Bool   `tfsdk:"administration_mode_enabled"`
-	BackgroundOperation types.Bool   `tfsdk:"background_operation_enabled"`
-	UniqueName          types.String `tfsdk:"unique_name"`
+	Url                 types.String     `tfsdk:"url"`
+	Domain              types.String     `tfsdk:"domain"`
+	OrganizationId      types.String     `tfsdk:"organization_id"`
+	SecurityGroupId     types.String     `tfsdk:"security_group_id"`
+	LanguageName        types.Int64      `tfsdk:"language_code"`
+	Version             types.String     `tfsdk:"version"`
+	LinkedAppType       types.String     `tfsdk:"linked_app_type"`
+	LinkedAppId         types.String     `tfsdk:"linked_app_id"`
+	LinkedAppURL        types.String     `tfsdk:"linked_app_url"`
+	CurrencyCode        types.String     `tfsdk:"currency_code"`
+	Templates           []string         `tfsdk:"templates"`
+	TemplateMetadata    customtypes.JSON `tfsdk:"template_metadata"`
+	AdministrationMode  types.Bool       `tfsdk:"administration_mode_enabled"`
+	BackgroundOperation types.Bool       `tfsdk:"background_operation_enabled"`
+	UniqueName          types.String     `tfsdk:"unique_name"`
 }
 
 func isDataverseEnvironmentEmpty(ctx context.Context, environment *SourceModel) bool {
@@ -166,13 +171,13 @@ func convertEnvironmentCreateLinkEnvironmentMetadataDtoFromDataverseSourceModel(
 		var dataverseSourceModel DataverseSourceModel
 		dataverse.As(ctx, &dataverseSourceModel, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
 
-		var templateMetadataObject *createTemplateMetadataDto
+		var templateMetadataObject createTemplateMetadataDto
 		if dataverseSourceModel.TemplateMetadata.ValueString() != "" {
 			err := json.Unmarshal([]byte(dataverseSourceModel.TemplateMetadata.ValueString()), &templateMetadataObject)
 			if err != nil {
-				return nil, fmt.Errorf("error when unmarshalling template metadata %s; internal error: %v", dataverseSourceModel.TemplateMetadata.ValueString(), err)
+				return nil, fmt.Errorf("error when unmarshalling template metadata %s, it must be a JSON object; internal error: %v", dataverseSourceModel.TemplateMetadata.ValueString(), err)
 			}
-			if len(templateMetadataObject.PostProvisioningPackages) == 0 {
+			if len(templateMetadataObject) == 0 {
 				templateMetadataObject = nil
 			}
 		}
@@ -198,7 +203,7 @@ func convertEnvironmentCreateLinkEnvironmentMetadataDtoFromDataverseSourceModel(
 	return nil, errors.New("dataverse object is null or unknown")
 }
 
-func convertSourceModelFromEnvironmentDto(environmentDto EnvironmentDto, currencyCode, ownerId *string, templateMetadata *createTemplateMetadataDto, templates []string, timeout timeouts.Value, providerConfig config.ProviderConfig) (*SourceModel, error) {
+func convertSourceModelFromEnvironmentDto(environmentDto EnvironmentDto, currencyCode, ownerId *string, templateMetadata createTemplateMetadataDto, templates []string, timeout timeouts.Value, providerConfig config.ProviderConfig) (*SourceModel, error) {
 	model := &SourceModel{
 		Timeouts:        timeout,
 		Description:     types.StringValue(environmentDto.Properties.Description),
@@ -230,7 +235,7 @@ func convertSourceModelFromEnvironmentDto(environmentDto EnvironmentDto, currenc
 		"linked_app_url":               types.StringType,
 		"currency_code":                types.StringType,
 		"templates":                    types.ListType{ElemType: types.StringType},
-		"template_metadata":            types.StringType,
+		"template_metadata":            customtypes.JSONType{},
 		"administration_mode_enabled":  types.BoolType,
 		"background_operation_enabled": types.BoolType,
 		"unique_name":                  types.StringType,
@@ -296,20 +301,20 @@ func convertSourceModelFromEnvironmentDto(environmentDto EnvironmentDto, currenc
 			attrValuesProductProperties["templates"] = types.ListNull(types.StringType)
 		}
 
-		if environmentDto.Properties.LinkedEnvironmentMetadata.TemplateMetadata != nil && environmentDto.Properties.LinkedEnvironmentMetadata.TemplateMetadata.PostProvisioningPackages != nil {
+		if len(environmentDto.Properties.LinkedEnvironmentMetadata.TemplateMetadata) > 0 {
 			b, err := json.Marshal(environmentDto.Properties.LinkedEnvironmentMetadata.TemplateMetadata)
 			if err != nil {
 				return nil, err
 			}
-			attrValuesProductProperties["template_metadata"] = types.StringValue(string(b))
+			attrValuesProductProperties["template_metadata"] = customtypes.NewJSONValue(string(b))
 		} else if templateMetadata != nil {
 			b, err := json.Marshal(templateMetadata)
 			if err != nil {
 				return nil, err
 			}
-			attrValuesProductProperties["template_metadata"] = types.StringValue(string(b))
+			attrValuesProductProperties["template_metadata"] = customtypes.NewJSONValue(string(b))
 		} else {
-			attrValuesProductProperties["template_metadata"] = types.StringNull()
+			attrValuesProductProperties["template_metadata"] = customtypes.NewJSONNull()
 		}
 		model.Dataverse = types.ObjectValueMust(attrTypesDataverseObject, attrValuesProductProperties)
 	} else {
@@ -320,7 +325,7 @@ func convertSourceModelFromEnvironmentDto(environmentDto EnvironmentDto, currenc
 		attrValuesProductProperties["language_code"] = types.Int64Null()
 		attrValuesProductProperties["version"] = types.StringNull()
 		attrValuesProductProperties["currency_code"] = types.StringNull()
-		attrValuesProductProperties["template_metadata"] = types.StringNull()
+		attrValuesProductProperties["template_metadata"] = customtypes.NewJSONNull()
 		attrValuesProductProperties["templates"] = types.ListNull(types.StringType)
 		attrValuesProductProperties["background_operation_enabled"] = types.BoolNull()
 		attrValuesProductProperties["administration_mode_enabled"] = types.BoolNull()
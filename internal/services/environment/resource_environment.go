@@ -30,6 +30,7 @@ import (
 	"github.com/microsoft/terraform-provider-power-platform/internal/config"
 	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
 	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customtypes"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 	"github.com/microsoft/terraform-provider-power-platform/internal/modifiers"
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/licensing"
@@ -203,11 +204,23 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				MarkdownDescription: "Allow Bing search in the environment",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.UseStateForServerDefaultBool(),
+				},
 			},
 			"allow_moving_data_across_regions": schema.BoolAttribute{
 				MarkdownDescription: "Allow moving data across regions",
 				Optional:            true,
 				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					modifiers.UseStateForServerDefaultBool(),
+				},
+			},
+			"wait_for_dataverse_ready": schema.BoolAttribute{
+				MarkdownDescription: "Wait when the environment is created until the Dataverse Web API of the environment responds to the `WhoAmI` function, so that the resources depending on the environment don't fail while Dataverse is still starting. The wait is limited by the create timeout. It has no effect on environments without Dataverse",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
 			},
 			"display_name": schema.StringAttribute{
 				MarkdownDescription: "Display name",
@@ -309,6 +322,9 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 						MarkdownDescription: "Security group id (guid). For an empty security group, set this property to `0000000-0000-0000-0000-000000000000`",
 						Optional:            true,
 						Computed:            true,
+						PlanModifiers: []planmodifier.String{
+							modifiers.UseStateForServerDefaultString(),
+						},
 						Validators: []validator.String{
 							stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "security_group_id must be a valid security group guid id"),
 							validators.MakeFieldRequiredWhenOtherFieldDoesNotHaveValue(path.Root("environment_type").Expression(), regexp.MustCompile(EnvironmentTypesExceptDeveloperRegex), "dataverse.security_group_id is required for all environment_type values except `Developer`"),
@@ -335,7 +351,8 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 						ElementType:         types.StringType,
 					},
 					"template_metadata": schema.StringAttribute{
-						MarkdownDescription: "Additional D365 environment template metadata (if any)",
+						MarkdownDescription: "Additional D365 environment template metadata (if any), as a JSON object passed as is to the templates, for example the `PostProvisioningPackages` of the ERP-based templates or the parameters of the industry templates. The `templates` are validated against the templates available for the location and the environment type (see the `powerplatform_environment_templates` data source).",
+						CustomType:          customtypes.JSONType{},
 						Optional:            true,
 					},
 					"linked_app_type": schema.StringAttribute{
@@ -439,6 +456,12 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 			resp.Diagnostics.AddError(fmt.Sprintf("Currency code validation failed for %s", r.FullTypeName()), err.Error())
 			return
 		}
+
+		err = templatesValidator(ctx, r.EnvironmentClient.Api, envToCreate.Location, envToCreate.Properties.EnvironmentSku, envToCreate.Properties.LinkedEnvironmentMetadata.Templates)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Template validation failed for %s", r.FullTypeName()), err.Error())
+			return
+		}
 	}
 
 	envDto, err := r.EnvironmentClient.CreateEnvironment(ctx, *envToCreate)
@@ -456,8 +479,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 
 		envDto, err = r.EnvironmentClient.GetEnvironment(ctx, envDto.Name)
 		if err != nil {
-			if customerrors.Code(err) == customerrors.ERROR_OBJECT_NOT_FOUND {
-				resp.State.RemoveResource(ctx)
+			if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
 				return
 			}
 			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
@@ -465,8 +487,16 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		}
 	}
 
+	if plan.WaitForDataverseReady.ValueBool() && envDto.Properties.LinkedEnvironmentMetadata != nil && envDto.Properties.LinkedEnvironmentMetadata.InstanceURL != "" {
+		err := r.EnvironmentClient.WaitForDataverseReady(ctx, envDto.Properties.LinkedEnvironmentMetadata.InstanceURL)
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
 	var currencyCode string
-	var templateMetadata *createTemplateMetadataDto
+	var templateMetadata createTemplateMetadataDto
 	var templates []string
 	if envToCreate.Properties.LinkedEnvironmentMetadata != nil {
 		currencyCode = envToCreate.Properties.LinkedEnvironmentMetadata.Currency.Code
@@ -486,6 +516,7 @@ func (r *Resource) Create(ctx context.Context, req resource.CreateRequest, resp
 		resp.Diagnostics.AddError("Error when converting environment to source model", err.Error())
 		return
 	}
+	newState.WaitForDataverseReady = plan.WaitForDataverseReady
 
 	tflog.Trace(ctx, fmt.Sprintf("created a resource with ID %s", plan.Id.ValueString()))
 
@@ -506,8 +537,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 
 	envDto, err := r.EnvironmentClient.GetEnvironment(ctx, state.Id.ValueString())
 	if err != nil {
-		if customerrors.Code(err) == customerrors.ERROR_OBJECT_NOT_FOUND {
-			resp.State.RemoveResource(ctx)
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
 			return
 		}
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
@@ -531,7 +561,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		currencyCode = defaultCurrency.IsoCurrencyCode
 	}
 
-	var templateMetadata *createTemplateMetadataDto
+	var templateMetadata createTemplateMetadataDto
 	var templates []string
 	if !state.Dataverse.IsNull() && !state.Dataverse.IsUnknown() {
 		dv, err := convertEnvironmentCreateLinkEnvironmentMetadataDtoFromDataverseSourceModel(ctx, state.Dataverse)
@@ -550,6 +580,8 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 		resp.Diagnostics.AddError("Error when converting environment to source model", err.Error())
 		return
 	}
+	// the flag only applies to the creation, it is false after an import.
+	newState.WaitForDataverseReady = types.BoolValue(state.WaitForDataverseReady.ValueBool())
 
 	tflog.Debug(ctx, fmt.Sprintf("READ: %s with id %s", r.FullTypeName(), state.Id.ValueString()))
 
@@ -576,6 +608,13 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		return
 	}
 
+	unlock, err := r.EnvironmentClient.Api.LockEnvironment(ctx, plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+	defer unlock()
+
 	envProp := EnviromentPropertiesDto{
 		DisplayName:     plan.DisplayName.ValueString(),
 		EnvironmentSku:  plan.EnvironmentType.ValueString(),
@@ -633,7 +672,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		}
 	}
 
-	var templateMetadata *createTemplateMetadataDto
+	var templateMetadata createTemplateMetadataDto
 	var templates []string
 	if !state.Dataverse.IsNull() && !state.Dataverse.IsUnknown() {
 		dv, err := convertEnvironmentCreateLinkEnvironmentMetadataDtoFromDataverseSourceModel(ctx, state.Dataverse)
@@ -652,6 +691,7 @@ func (r *Resource) Update(ctx context.Context, req resource.UpdateRequest, resp
 		resp.Diagnostics.AddError("Error when converting environment to source model", err.Error())
 		return
 	}
+	newState.WaitForDataverseReady = plan.WaitForDataverseReady
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &newState)...)
 }
@@ -758,7 +798,14 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 		return
 	}
 
-	err := r.EnvironmentClient.DeleteEnvironment(ctx, state.Id.ValueString())
+	unlock, err := r.EnvironmentClient.Api.LockEnvironment(ctx, state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+	defer unlock()
+
+	err = r.EnvironmentClient.DeleteEnvironment(ctx, state.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
 	}
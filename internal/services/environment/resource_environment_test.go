@@ -4,6 +4,7 @@
 package environment_test
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/rand"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/jarcoal/httpmock"
 	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
@@ -1115,6 +1117,160 @@ func TestUnitEnvironmentsResource_Validate_Create(t *testing.T) {
 	})
 }
 
+func TestUnitEnvironmentsResource_Validate_Create_Wait_For_Dataverse_Ready(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mocks.ActivateEnvironmentHttpMocks()
+
+	whoAmICalls := 0
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/WhoAmI",
+		func(req *http.Request) (*http.Response, error) {
+			whoAmICalls++
+			if whoAmICalls < 3 {
+				return httpmock.NewStringResponse(http.StatusServiceUnavailable, `{"error":{"code":"0x80040216","message":"The organization is not ready yet"}}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"BusinessUnitId":"00000000-0000-0000-0000-000000000002","UserId":"00000000-0000-0000-0000-000000000001","OrganizationId":"00000000-0000-0000-0000-000000000003"}`), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://europe.api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/lifecycleOperations/00000000-0000-0000-0000-000000000001?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create/get_lifecycle_delete.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^https://api\.bap\.microsoft\.com/providers/Microsoft\.BusinessAppPlatform/scopes/admin/environments/([\d-]+)\z`,
+		func(req *http.Request) (*http.Response, error) {
+			id := httpmock.MustGetSubmatch(req, 1)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(fmt.Sprintf("tests/resource/Validate_Create/get_environment_%s.json", id)).String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `=~^https://api\.bap\.microsoft\.com/providers/Microsoft\.BusinessAppPlatform/scopes/admin/environments/([\d-]+)\z`,
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Location", "https://europe.api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/lifecycleOperations/00000000-0000-0000-0000-000000000001?api-version=2023-06-01")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://europe.api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/lifecycleOperations/b03e1e6d-73db-4367-90e1-2e378bf7e2fc?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create/get_lifecycle.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/environments?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Location", "https://europe.api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/lifecycleOperations/b03e1e6d-73db-4367-90e1-2e378bf7e2fc?api-version=2023-06-01")
+			return resp, nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "development" {
+					display_name             = "displayname"
+					location                 = "europe"
+					environment_type         = "Sandbox"
+					wait_for_dataverse_ready = true
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "PLN"
+						domain            = "00000000-0000-0000-0000-000000000001"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}`,
+
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_environment.development", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("powerplatform_environment.development", "wait_for_dataverse_ready", "true"),
+					func(_ *terraform.State) error {
+						if whoAmICalls < 3 {
+							return fmt.Errorf("expected the WhoAmI probe to be retried until it succeeds, got %d calls", whoAmICalls)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentsResource_Validate_Create_Wait_For_Dataverse_Ready_Forbidden(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mocks.ActivateEnvironmentHttpMocks()
+
+	whoAmICalls := 0
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/WhoAmI",
+		func(req *http.Request) (*http.Response, error) {
+			whoAmICalls++
+			return httpmock.NewStringResponse(http.StatusForbidden, `{"error":{"code":"0x80072560","message":"The user is not a member of the organization."}}`), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://europe.api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/lifecycleOperations/00000000-0000-0000-0000-000000000001?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create/get_lifecycle_delete.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^https://api\.bap\.microsoft\.com/providers/Microsoft\.BusinessAppPlatform/scopes/admin/environments/([\d-]+)\z`,
+		func(req *http.Request) (*http.Response, error) {
+			id := httpmock.MustGetSubmatch(req, 1)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(fmt.Sprintf("tests/resource/Validate_Create/get_environment_%s.json", id)).String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `=~^https://api\.bap\.microsoft\.com/providers/Microsoft\.BusinessAppPlatform/scopes/admin/environments/([\d-]+)\z`,
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Location", "https://europe.api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/lifecycleOperations/00000000-0000-0000-0000-000000000001?api-version=2023-06-01")
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://europe.api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/lifecycleOperations/b03e1e6d-73db-4367-90e1-2e378bf7e2fc?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create/get_lifecycle.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/environments?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Location", "https://europe.api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/lifecycleOperations/b03e1e6d-73db-4367-90e1-2e378bf7e2fc?api-version=2023-06-01")
+			return resp, nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			// the WhoAmI probe is not retried when the caller has no access to the environment.
+			if whoAmICalls != 1 {
+				return fmt.Errorf("expected a single WhoAmI call, got %d calls", whoAmICalls)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "development" {
+					display_name             = "displayname"
+					location                 = "europe"
+					environment_type         = "Sandbox"
+					wait_for_dataverse_ready = true
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "PLN"
+						domain            = "00000000-0000-0000-0000-000000000001"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}`,
+				ExpectError: regexp.MustCompile("can't be reached"),
+			},
+		},
+	})
+}
+
 func TestUnitEnvironmentsResource_Validate_Create_With_Billing_Policy(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -1351,10 +1507,7 @@ func TestUnitEnvironmentsResource_Validate_Update_With_Billing_Policy(t *testing
 		},
 	})
 }
-func TestUnitEnvironmentsResource_Validate_Create_With_D365_Template(t *testing.T) {
-	httpmock.Activate()
-	defer httpmock.DeactivateAndReset()
-
+func registerD365TemplateMocks() {
 	mocks.ActivateEnvironmentHttpMocks()
 
 	httpmock.RegisterResponder("DELETE", `=~^https://api\.bap\.microsoft\.com/providers/Microsoft\.BusinessAppPlatform/scopes/admin/environments/([\d-]+)\z`,
@@ -1397,6 +1550,18 @@ func TestUnitEnvironmentsResource_Validate_Create_With_D365_Template(t *testing.
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_D365_Template/get_environments.json").String()), nil
 		})
 
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/locations/europe/templates?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_D365_Template/get_environment_templates.json").String()), nil
+		})
+}
+
+func TestUnitEnvironmentsResource_Validate_Create_With_D365_Template(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerD365TemplateMocks()
+
 	resource.Test(t, resource.TestCase{
 		IsUnitTest:               true,
 		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
@@ -1447,6 +1612,107 @@ func TestUnitEnvironmentsResource_Validate_Create_With_D365_Template(t *testing.
 	})
 }
 
+func TestUnitEnvironmentsResource_Validate_Create_With_Industry_Template_Metadata(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerD365TemplateMocks()
+
+	templateMetadata := map[string]any{}
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/environments?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			body := map[string]any{}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			templateMetadata = body["properties"].(map[string]any)["linkedEnvironmentMetadata"].(map[string]any)["templateMetadata"].(map[string]any)
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Location", "https://europe.api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/lifecycleOperations/b03e1e6d-73db-4367-90e1-2e378bf7e2fc?api-version=2023-06-01")
+			return resp, nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// the metadata is formatted differently than it is read back, the refresh after apply must not show a change.
+				Config: `
+				resource "powerplatform_environment" "development" {
+					display_name                              = "displayname"
+					location                                  = "europe"
+					environment_type                          = "Sandbox"
+					dataverse = {
+						language_code                             = "1033"
+						currency_code                             = "PLN"
+						domain                                    = "00000000-0000-0000-0000-000000000001"
+						security_group_id                         = "00000000-0000-0000-0000-000000000000"
+						templates = ["D365_FinOps_Finance"]
+						template_metadata = <<-EOT
+							{
+								"PostProvisioningPackages": [
+									{ "parameters": "DevToolsEnabled=true", "applicationUniqueName": "msdyn_FinanceAndOperationsProvisioningAppAnchor" }
+								],
+								"IndustryParameters": { "region": "emea", "sampleData": false }
+							}
+						EOT
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("powerplatform_environment.development", "dataverse.templates.*", "D365_FinOps_Finance"),
+					func(s *terraform.State) error {
+						parameters, ok := templateMetadata["IndustryParameters"].(map[string]any)
+						if !ok || parameters["region"] != "emea" || parameters["sampleData"] != false {
+							return fmt.Errorf("expected the industry parameters to be sent with the template metadata, got %v", templateMetadata)
+						}
+						if _, ok := templateMetadata["PostProvisioningPackages"].([]any); !ok {
+							return fmt.Errorf("expected the post provisioning packages to be sent with the template metadata, got %v", templateMetadata)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentsResource_Validate_Create_With_Unavailable_Template(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerD365TemplateMocks()
+
+	config := func(template string) string {
+		return fmt.Sprintf(`
+		resource "powerplatform_environment" "development" {
+			display_name     = "displayname"
+			location         = "europe"
+			environment_type = "Sandbox"
+			dataverse = {
+				language_code     = "1033"
+				currency_code     = "PLN"
+				domain            = "00000000-0000-0000-0000-000000000001"
+				security_group_id = "00000000-0000-0000-0000-000000000000"
+				templates         = ["%s"]
+			}
+		}`, template)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config("D365_Retail"),
+				ExpectError: regexp.MustCompile(`template D365_Retail is not available for Sandbox environments in location\s+europe. available templates are: D365_FinOps_Finance, D365_Sales`),
+			},
+			{
+				Config:      config("D365_Sales"),
+				ExpectError: regexp.MustCompile(`template D365_Sales is disabled for Sandbox environments in location europe:\s+You\s+do\s+not\s+have\s+the\s+required\s+Dynamics\s+365\s+licenses`),
+			},
+		},
+	})
+}
+
 func TestUnitEnvironmentsResource_Validate_Taken_Domain_Name(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
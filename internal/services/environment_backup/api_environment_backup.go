@@ -0,0 +1,147 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package environment_backup
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+)
+
+const (
+	OPERATION_STATE_SUCCEEDED = "Succeeded"
+	OPERATION_STATE_FAILED    = "Failed"
+)
+
+const (
+	COPY_TYPE_MINIMAL = "MinimalCopy"
+	COPY_TYPE_FULL    = "FullCopy"
+)
+
+func newEnvironmentBackupClient(apiClient *api.Client) client {
+	return client{
+		Api: apiClient,
+	}
+}
+
+type client struct {
+	Api *api.Client
+}
+
+func (client *client) buildEnvironmentUrl(environmentId string, elements ...string) string {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.BapiUrl,
+		Path:   strings.Join(append([]string{fmt.Sprintf("/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/%s", environmentId)}, elements...), "/"),
+	}
+	values := url.Values{}
+	values.Add("api-version", "2021-04-01")
+	apiUrl.RawQuery = values.Encode()
+	return apiUrl.String()
+}
+
+func (client *client) GetBackups(ctx context.Context, environmentId string) ([]backupDto, error) {
+	backups := backupArrayDto{}
+	_, err := client.Api.Execute(ctx, nil, "GET", client.buildEnvironmentUrl(environmentId, "backups"), nil, nil, []int{http.StatusOK}, &backups)
+	if err != nil {
+		return nil, err
+	}
+	return backups.Value, nil
+}
+
+func (client *client) GetBackup(ctx context.Context, environmentId, backupId string) (*backupDto, error) {
+	backups, err := client.GetBackups(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	for _, backup := range backups {
+		if strings.EqualFold(backup.Id, backupId) {
+			return &backup, nil
+		}
+	}
+	return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("backup '%s' not found in environment '%s'", backupId, environmentId))
+}
+
+// CreateBackup takes a manual backup of the environment. The backup is taken asynchronously when the api accepts the request,
+// in which case the backup is looked up by its label once the operation has completed.
+func (client *client) CreateBackup(ctx context.Context, environmentId string, backupToCreate backupCreateDto) (*backupDto, error) {
+	backup := backupDto{}
+	response, err := client.Api.Execute(ctx, nil, "POST", client.buildEnvironmentUrl(environmentId, "backups"), nil, backupToCreate, []int{http.StatusOK, http.StatusCreated, http.StatusAccepted}, &backup)
+	if err != nil {
+		return nil, err
+	}
+
+	if response.HttpResponse.StatusCode == http.StatusAccepted {
+		lifecycleResponse, err := client.Api.DoWaitForLifecycleOperationStatus(ctx, response)
+		if err != nil {
+			return nil, err
+		}
+		if lifecycleResponse != nil && lifecycleResponse.State.Id == OPERATION_STATE_FAILED {
+			return nil, fmt.Errorf("backup of environment '%s' failed", environmentId)
+		}
+	}
+	if backup.Id != "" {
+		return &backup, nil
+	}
+
+	backups, err := client.GetBackups(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	var latest *backupDto
+	for _, b := range backups {
+		if b.Label == backupToCreate.Label && (latest == nil || b.BackupPointDateTime > latest.BackupPointDateTime) {
+			latest = &b
+		}
+	}
+	if latest == nil {
+		return nil, fmt.Errorf("backup with label '%s' not found in environment '%s' after it was taken", backupToCreate.Label, environmentId)
+	}
+	return latest, nil
+}
+
+func (client *client) DeleteBackup(ctx context.Context, environmentId, backupId string) error {
+	_, err := client.Api.Execute(ctx, nil, "DELETE", client.buildEnvironmentUrl(environmentId, "backups", backupId), nil, nil, []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent, http.StatusNotFound}, nil)
+	return err
+}
+
+// RestoreEnvironment restores the source environment at the restore point into the target environment and waits for the restore operation to complete.
+func (client *client) RestoreEnvironment(ctx context.Context, targetEnvironmentId string, restore restoreDto) (*api.LifecycleDto, error) {
+	response, err := client.Api.Execute(ctx, nil, "POST", client.buildEnvironmentUrl(targetEnvironmentId, "restore"), nil, restore, []int{http.StatusAccepted}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycleResponse, err := client.Api.DoWaitForLifecycleOperationStatus(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	if lifecycleResponse != nil && lifecycleResponse.State.Id == OPERATION_STATE_FAILED {
+		return nil, fmt.Errorf("restore of environment '%s' into environment '%s' failed", restore.SourceEnvironmentId, targetEnvironmentId)
+	}
+	return lifecycleResponse, nil
+}
+
+// CopyEnvironment copies the source environment into the target environment and waits for the copy operation to complete.
+func (client *client) CopyEnvironment(ctx context.Context, targetEnvironmentId string, environmentCopy copyDto) (*api.LifecycleDto, error) {
+	response, err := client.Api.Execute(ctx, nil, "POST", client.buildEnvironmentUrl(targetEnvironmentId, "copy"), nil, environmentCopy, []int{http.StatusAccepted}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	lifecycleResponse, err := client.Api.DoWaitForLifecycleOperationStatus(ctx, response)
+	if err != nil {
+		return nil, err
+	}
+	if lifecycleResponse != nil && lifecycleResponse.State.Id == OPERATION_STATE_FAILED {
+		return nil, fmt.Errorf("copy of environment '%s' into environment '%s' failed", environmentCopy.SourceEnvironmentId, targetEnvironmentId)
+	}
+	return lifecycleResponse, nil
+}
@@ -0,0 +1,43 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package environment_backup
+
+type backupDto struct {
+	Id                   string         `json:"id"`
+	Label                string         `json:"label"`
+	Notes                string         `json:"notes"`
+	BackupPointDateTime  string         `json:"backupPointDateTime"`
+	BackupExpiryDateTime string         `json:"backupExpiryDateTime"`
+	CreatedBy            backupActorDto `json:"createdBy"`
+}
+
+type backupActorDto struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Type        string `json:"type"`
+}
+
+type backupArrayDto struct {
+	Value []backupDto `json:"value"`
+}
+
+type backupCreateDto struct {
+	Label string `json:"label"`
+	Notes string `json:"notes,omitempty"`
+}
+
+type restoreDto struct {
+	RestorePointDateTime  string `json:"restorePointDateTime"`
+	SourceEnvironmentId   string `json:"sourceEnvironmentId"`
+	TargetSecurityGroupId string `json:"targetSecurityGroupId,omitempty"`
+	SkipAuditData         bool   `json:"skipAuditData"`
+}
+
+type copyDto struct {
+	SourceEnvironmentId   string `json:"sourceEnvironmentId"`
+	TargetEnvironmentName string `json:"targetEnvironmentName,omitempty"`
+	TargetSecurityGroupId string `json:"targetSecurityGroupId,omitempty"`
+	CopyType              string `json:"copyType"`
+	SkipAuditData         bool   `json:"skipAuditData"`
+}
@@ -0,0 +1,83 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package environment_backup
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+type BackupResource struct {
+	helpers.TypeInfo
+	BackupClient client
+}
+
+type BackupResourceModel struct {
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	Id                   types.String   `tfsdk:"id"`
+	EnvironmentId        types.String   `tfsdk:"environment_id"`
+	Label                types.String   `tfsdk:"label"`
+	Notes                types.String   `tfsdk:"notes"`
+	BackupPointDateTime  types.String   `tfsdk:"backup_point_date_time"`
+	BackupExpiryDateTime types.String   `tfsdk:"backup_expiry_date_time"`
+}
+
+type RestoreResource struct {
+	helpers.TypeInfo
+	BackupClient client
+}
+
+type RestoreResourceModel struct {
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+	Id                    types.String   `tfsdk:"id"`
+	SourceEnvironmentId   types.String   `tfsdk:"source_environment_id"`
+	TargetEnvironmentId   types.String   `tfsdk:"target_environment_id"`
+	RestorePointDateTime  types.String   `tfsdk:"restore_point_date_time"`
+	TargetSecurityGroupId types.String   `tfsdk:"target_security_group_id"`
+	SkipAuditData         types.Bool     `tfsdk:"skip_audit_data"`
+	State                 types.String   `tfsdk:"state"`
+}
+
+type CopyResource struct {
+	helpers.TypeInfo
+	BackupClient client
+}
+
+type CopyResourceModel struct {
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+	Id                    types.String   `tfsdk:"id"`
+	SourceEnvironmentId   types.String   `tfsdk:"source_environment_id"`
+	TargetEnvironmentId   types.String   `tfsdk:"target_environment_id"`
+	CopyType              types.String   `tfsdk:"copy_type"`
+	TargetEnvironmentName types.String   `tfsdk:"target_environment_name"`
+	TargetSecurityGroupId types.String   `tfsdk:"target_security_group_id"`
+	SkipAuditData         types.Bool     `tfsdk:"skip_audit_data"`
+	State                 types.String   `tfsdk:"state"`
+}
+
+func convertFromBackupDto(model *BackupResourceModel, backup *backupDto) {
+	model.Id = types.StringValue(backup.Id)
+	model.BackupPointDateTime = types.StringValue(backup.BackupPointDateTime)
+	model.BackupExpiryDateTime = types.StringValue(backup.BackupExpiryDateTime)
+}
+
+func convertToRestoreDto(model *RestoreResourceModel) restoreDto {
+	return restoreDto{
+		RestorePointDateTime:  model.RestorePointDateTime.ValueString(),
+		SourceEnvironmentId:   model.SourceEnvironmentId.ValueString(),
+		TargetSecurityGroupId: model.TargetSecurityGroupId.ValueString(),
+		SkipAuditData:         model.SkipAuditData.ValueBool(),
+	}
+}
+
+func convertToCopyDto(model *CopyResourceModel) copyDto {
+	return copyDto{
+		SourceEnvironmentId:   model.SourceEnvironmentId.ValueString(),
+		TargetEnvironmentName: model.TargetEnvironmentName.ValueString(),
+		TargetSecurityGroupId: model.TargetSecurityGroupId.ValueString(),
+		CopyType:              model.CopyType.ValueString(),
+		SkipAuditData:         model.SkipAuditData.ValueBool(),
+	}
+}
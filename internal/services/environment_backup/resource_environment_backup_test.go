@@ -0,0 +1,152 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package environment_backup_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+const backupsUrl = "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/backups?api-version=2021-04-01"
+
+const lifecycleUrl = "https://europe.api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/lifecycleOperations/b03e1e6d-73db-4367-90e1-2e378bf7e2fc?api-version=2023-06-01"
+
+func TestAccEnvironmentBackupResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_environment_backup" "backup" {
+					environment_id = powerplatform_environment.env.id
+					label          = "before sandbox refresh"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("powerplatform_environment_backup.backup", "id"),
+					resource.TestCheckResourceAttrSet("powerplatform_environment_backup.backup", "backup_point_date_time"),
+					resource.TestCheckResourceAttrSet("powerplatform_environment_backup.backup", "backup_expiry_date_time"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentBackupResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	backupRequests := []map[string]string{}
+	deleted := false
+
+	httpmock.RegisterResponder("POST", backupsUrl,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			backup := map[string]string{}
+			if err := json.Unmarshal(body, &backup); err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			backupRequests = append(backupRequests, backup)
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Location", lifecycleUrl)
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", lifecycleUrl,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create/get_lifecycle.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", backupsUrl,
+		func(req *http.Request) (*http.Response, error) {
+			if deleted {
+				return httpmock.NewStringResponse(http.StatusOK, `{"value":[]}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create/get_backups.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001/backups/00000000-0000-0000-0000-000000000003?api-version=2021-04-01",
+		func(req *http.Request) (*http.Response, error) {
+			deleted = true
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment_backup" "backup" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					label          = "before sandbox refresh"
+					notes          = "taken by terraform"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_environment_backup.backup", "id", "00000000-0000-0000-0000-000000000003"),
+					resource.TestCheckResourceAttr("powerplatform_environment_backup.backup", "backup_point_date_time", "2026-10-14T08:30:00Z"),
+					resource.TestCheckResourceAttr("powerplatform_environment_backup.backup", "backup_expiry_date_time", "2026-10-21T08:30:00Z"),
+					func(_ *terraform.State) error {
+						if len(backupRequests) != 1 || backupRequests[0]["label"] != "before sandbox refresh" || backupRequests[0]["notes"] != "taken by terraform" {
+							return fmt.Errorf("unexpected backup requests %v", backupRequests)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "powerplatform_environment_backup.backup",
+				ImportState:       true,
+				ImportStateId:     "00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000003",
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"timeouts",
+				},
+			},
+		},
+	})
+
+	if !deleted {
+		t.Error("the backup was not deleted")
+	}
+}
+
+func TestUnitEnvironmentBackupResource_Validate_Import_Invalid_Id(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment_backup" "backup" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					label          = "before sandbox refresh"
+				}`,
+				ResourceName:  "powerplatform_environment_backup.backup",
+				ImportState:   true,
+				ImportStateId: "00000000-0000-0000-0000-000000000001",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
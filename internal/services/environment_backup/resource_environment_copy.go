@@ -0,0 +1,224 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package environment_backup
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &CopyResource{}
+
+func NewEnvironmentCopyResource() resource.Resource {
+	return &CopyResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "environment_copy",
+		},
+	}
+}
+
+func (r *CopyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *CopyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Copies an environment into a target environment, such as to refresh a development or test sandbox from production. See [Copy an environment](https://learn.microsoft.com/power-platform/admin/copy-environment) for the difference between a minimal copy of the customizations and schemas and a full copy that includes the data. The copy is started when the resource is created and the resource waits for the copy operation to complete. Changing any argument copies the environment again. The target environment is overwritten by the copy, and destroying the resource doesn't revert it.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the copy",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"source_environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment that is copied",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "source_environment_id must be a valid environment id guid"),
+				},
+			},
+			"target_environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment that the source environment is copied into. It must be a sandbox environment",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "target_environment_id must be a valid environment id guid"),
+				},
+			},
+			"copy_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the copy, `MinimalCopy` to copy the customizations and schemas only or `FullCopy` to copy the data as well",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(COPY_TYPE_MINIMAL, COPY_TYPE_FULL),
+				},
+			},
+			"target_environment_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the target environment after the copy. The target environment keeps its name when it isn't set",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_security_group_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Entra security group that restricts the access to the target environment after the copy",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "target_security_group_id must be a valid security group id guid"),
+				},
+			},
+			"skip_audit_data": schema.BoolAttribute{
+				MarkdownDescription: "Skip the copy of the audit logs, which shortens a full copy",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Final state of the copy operation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CopyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.BackupClient = newEnvironmentBackupClient(client.Api)
+}
+
+func (r *CopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *CopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the target environment can't be changed by other resources while it is overwritten.
+	unlock, err := r.BackupClient.Api.LockEnvironment(ctx, plan.TargetEnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+	defer unlock()
+
+	lifecycle, err := r.BackupClient.CopyEnvironment(ctx, plan.TargetEnvironmentId.ValueString(), convertToCopyDto(plan))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	plan.Id = types.StringValue(uuid.New().String())
+	plan.State = types.StringValue(OPERATION_STATE_SUCCEEDED)
+	if lifecycle != nil && lifecycle.State.Id != "" {
+		plan.State = types.StringValue(lifecycle.State.Id)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE: %s copied environment %s into environment %s", r.FullTypeName(), plan.SourceEnvironmentId.ValueString(), plan.TargetEnvironmentId.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *CopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *CopyResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// A copy has no remote state to refresh, copying again is left to changes of its arguments.
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *CopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// All the arguments except the timeouts require a replacement, which copies the environment again.
+	var plan *CopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *CopyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// A copy can't be undone, so it is only removed from the state.
+	tflog.Debug(ctx, fmt.Sprintf("%s removed from state, the copy is not reverted", r.FullTypeName()))
+}
@@ -0,0 +1,108 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package environment_backup_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestUnitEnvironmentCopyResource_Validate_Copy(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	copyRequests := []map[string]any{}
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000002/copy?api-version=2021-04-01",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			environmentCopy := map[string]any{}
+			if err := json.Unmarshal(body, &environmentCopy); err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			copyRequests = append(copyRequests, environmentCopy)
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Location", lifecycleUrl)
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", lifecycleUrl,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Copy/get_lifecycle.json").String()), nil
+		})
+
+	config := func(copyType string) string {
+		return fmt.Sprintf(`
+		resource "powerplatform_environment_copy" "refresh" {
+			source_environment_id   = "00000000-0000-0000-0000-000000000001"
+			target_environment_id   = "00000000-0000-0000-0000-000000000002"
+			copy_type               = "%s"
+			target_environment_name = "Test"
+		}`, copyType)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("MinimalCopy"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_environment_copy.refresh", "id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_environment_copy.refresh", "state", "Succeeded"),
+					resource.TestCheckResourceAttr("powerplatform_environment_copy.refresh", "skip_audit_data", "false"),
+					func(_ *terraform.State) error {
+						if len(copyRequests) != 1 ||
+							copyRequests[0]["sourceEnvironmentId"] != "00000000-0000-0000-0000-000000000001" ||
+							copyRequests[0]["copyType"] != "MinimalCopy" ||
+							copyRequests[0]["targetEnvironmentName"] != "Test" {
+							return fmt.Errorf("unexpected copy requests %v", copyRequests)
+						}
+						if _, ok := copyRequests[0]["targetSecurityGroupId"]; ok {
+							return fmt.Errorf("unexpected security group in copy request %v", copyRequests[0])
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: config("FullCopy"),
+				Check: func(_ *terraform.State) error {
+					if len(copyRequests) != 2 || copyRequests[1]["copyType"] != "FullCopy" {
+						return fmt.Errorf("unexpected copy requests %v", copyRequests)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentCopyResource_Validate_Invalid_Copy_Type(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment_copy" "refresh" {
+					source_environment_id = "00000000-0000-0000-0000-000000000001"
+					target_environment_id = "00000000-0000-0000-0000-000000000002"
+					copy_type             = "PartialCopy"
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
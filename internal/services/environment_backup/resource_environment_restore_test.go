@@ -0,0 +1,133 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package environment_backup_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+const restoreUrl = "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000002/restore?api-version=2021-04-01"
+
+func TestUnitEnvironmentRestoreResource_Validate_Restore(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	restoreRequests := []map[string]any{}
+
+	httpmock.RegisterResponder("POST", restoreUrl,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			restore := map[string]any{}
+			if err := json.Unmarshal(body, &restore); err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			restoreRequests = append(restoreRequests, restore)
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Location", lifecycleUrl)
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", lifecycleUrl,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Restore/get_lifecycle.json").String()), nil
+		})
+
+	config := func(restorePoint string) string {
+		return fmt.Sprintf(`
+		resource "powerplatform_environment_restore" "refresh" {
+			source_environment_id    = "00000000-0000-0000-0000-000000000001"
+			target_environment_id    = "00000000-0000-0000-0000-000000000002"
+			restore_point_date_time  = "%s"
+			target_security_group_id = "00000000-0000-0000-0000-000000000020"
+			skip_audit_data          = true
+		}`, restorePoint)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config("2026-10-14T08:30:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_environment_restore.refresh", "id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_environment_restore.refresh", "state", "Succeeded"),
+					func(_ *terraform.State) error {
+						if len(restoreRequests) != 1 ||
+							restoreRequests[0]["sourceEnvironmentId"] != "00000000-0000-0000-0000-000000000001" ||
+							restoreRequests[0]["restorePointDateTime"] != "2026-10-14T08:30:00Z" ||
+							restoreRequests[0]["targetSecurityGroupId"] != "00000000-0000-0000-0000-000000000020" ||
+							restoreRequests[0]["skipAuditData"] != true {
+							return fmt.Errorf("unexpected restore requests %v", restoreRequests)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				// applying the same configuration again doesn't restore the backup again.
+				Config: config("2026-10-14T08:30:00Z"),
+				Check: func(_ *terraform.State) error {
+					if len(restoreRequests) != 1 {
+						return fmt.Errorf("unexpected restore requests %v", restoreRequests)
+					}
+					return nil
+				},
+			},
+			{
+				Config: config("2026-10-15T08:30:00Z"),
+				Check: func(_ *terraform.State) error {
+					if len(restoreRequests) != 2 || restoreRequests[1]["restorePointDateTime"] != "2026-10-15T08:30:00Z" {
+						return fmt.Errorf("unexpected restore requests %v", restoreRequests)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentRestoreResource_Validate_Restore_Failed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", restoreUrl,
+		func(req *http.Request) (*http.Response, error) {
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Location", lifecycleUrl)
+			return resp, nil
+		})
+
+	httpmock.RegisterResponder("GET", lifecycleUrl,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Restore/get_lifecycle_failed.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment_restore" "refresh" {
+					source_environment_id   = "00000000-0000-0000-0000-000000000001"
+					target_environment_id   = "00000000-0000-0000-0000-000000000002"
+					restore_point_date_time = "2026-10-14T08:30:00Z"
+				}`,
+				ExpectError: regexp.MustCompile("restore of environment '00000000-0000-0000-0000-000000000001' into\\s+environment '00000000-0000-0000-0000-000000000002' failed"),
+			},
+		},
+	})
+}
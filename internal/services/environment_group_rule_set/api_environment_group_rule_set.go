@@ -41,7 +41,7 @@ func (client *Client) GetEnvironmentGroupRuleSet(ctx context.Context, environmen
 	}
 
 	values := url.Values{}
-	values.Add("api-version", "2021-10-01-preview")
+	values.Add("api-version", client.Api.GetApiVersion(ctx, api.API_SERVICE_ENVIRONMENT_GROUP_RULE_SET))
 	apiUrl.RawQuery = values.Encode()
 
 	environmentGroupRuleSet := environmentGroupRuleSetDto{}
@@ -74,7 +74,7 @@ func (client *Client) CreateEnvironmentGroupRuleSet(ctx context.Context, environ
 	}
 
 	values := url.Values{}
-	values.Add("api-version", "2021-10-01-preview")
+	values.Add("api-version", client.Api.GetApiVersion(ctx, api.API_SERVICE_ENVIRONMENT_GROUP_RULE_SET))
 	apiUrl.RawQuery = values.Encode()
 
 	environmentGroupRuleSet := EnvironmentGroupRuleSetValueSetDto{}
@@ -104,7 +104,7 @@ func (client *Client) UpdateEnvironmentGroupRuleSet(ctx context.Context, environ
 	}
 
 	values := url.Values{}
-	values.Add("api-version", "2021-10-01-preview")
+	values.Add("api-version", client.Api.GetApiVersion(ctx, api.API_SERVICE_ENVIRONMENT_GROUP_RULE_SET))
 	apiUrl.RawQuery = values.Encode()
 
 	environmentGroupRuleSet := EnvironmentGroupRuleSetValueSetDto{}
@@ -130,7 +130,7 @@ func (client *Client) DeleteEnvironmentGroupRuleSet(ctx context.Context, ruleSet
 	}
 
 	values := url.Values{}
-	values.Add("api-version", "2021-10-01-preview")
+	values.Add("api-version", client.Api.GetApiVersion(ctx, api.API_SERVICE_ENVIRONMENT_GROUP_RULE_SET))
 	apiUrl.RawQuery = values.Encode()
 
 	_, err = client.Api.Execute(ctx, nil, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusOK}, nil)
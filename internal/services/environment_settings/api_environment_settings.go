@@ -42,20 +42,14 @@ func (client *client) GetEnvironmentSettings(ctx context.Context, environmentId
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.0/organizations",
+		Path:   fmt.Sprintf("/api/data/%s/organizations", client.Api.GetConfig().GetDataverseApiVersion()),
 	}
 
 	environmentSettings := environmentSettingsValueDto{}
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &environmentSettings)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &environmentSettings)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 	return &environmentSettings.Value[0], nil
 }
 
@@ -73,19 +67,13 @@ func (client *client) UpdateEnvironmentSettings(ctx context.Context, environment
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   fmt.Sprintf("/api/data/v9.0/organizations(%s)", *settings.OrganizationId),
+		Path:   fmt.Sprintf("/api/data/%s/organizations(%s)", client.Api.GetConfig().GetDataverseApiVersion(), *settings.OrganizationId),
 	}
 
-	resp, err := client.Api.Execute(ctx, nil, "PATCH", apiUrl.String(), nil, environmentSettings, []int{http.StatusNoContent, http.StatusInternalServerError, http.StatusForbidden, http.StatusNotFound}, nil)
-	if resp != nil && resp.HttpResponse.StatusCode == http.StatusInternalServerError {
-		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_ENVIRONMENT_SETTINGS_FAILED, string(resp.BodyAsBytes))
-	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
+	settingsFailedError := func(httpError customerrors.UnexpectedHttpStatusCodeError) error {
+		return customerrors.WrapIntoProviderError(httpError, customerrors.ERROR_ENVIRONMENT_SETTINGS_FAILED, "failed to update environment settings")
 	}
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "PATCH", apiUrl.String(), nil, environmentSettings, []int{http.StatusNoContent}, api.NotFoundOrForbiddenResponseErrors.With(http.StatusInternalServerError, settingsFailedError), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -230,6 +230,30 @@ func (d *EnvironmentSettingsDataSource) Schema(ctx context.Context, req datasour
 								ElementType:         types.StringType,
 								Optional:            true,
 							},
+							"enable_session_timeout": schema.BoolAttribute{
+								MarkdownDescription: "Enable a custom session timeout",
+								Optional:            true,
+							},
+							"session_timeout_in_minutes": schema.Int64Attribute{
+								MarkdownDescription: "Session timeout in minutes",
+								Optional:            true,
+							},
+							"session_timeout_reminder_in_minutes": schema.Int64Attribute{
+								MarkdownDescription: "Minutes before the session expires that the user is warned",
+								Optional:            true,
+							},
+							"enable_inactivity_timeout": schema.BoolAttribute{
+								MarkdownDescription: "Enable inactivity timeout",
+								Optional:            true,
+							},
+							"inactivity_timeout_in_minutes": schema.Int64Attribute{
+								MarkdownDescription: "Inactivity timeout in minutes",
+								Optional:            true,
+							},
+							"inactivity_timeout_reminder_in_minutes": schema.Int64Attribute{
+								MarkdownDescription: "Minutes before the inactivity timeout that the user is warned",
+								Optional:            true,
+							},
 						},
 					},
 				},
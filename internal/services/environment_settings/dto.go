@@ -26,6 +26,13 @@ type environmentSettingsDto struct {
 	AllowMicrosoftTrustedServiceTags     *bool   `json:"allowmicrosofttrustedservicetags,omitempty"`
 	EnableIpBasedFirewallRuleInAuditMode *bool   `json:"enableipbasedfirewallruleinauditmode,omitempty"`
 	ReverseProxyIpAddresses              *string `json:"reverseproxyipaddresses,omitempty"`
+
+	SessionTimeoutEnabled           *bool  `json:"sessiontimeoutenabled,omitempty"`
+	SessionTimeoutInMins            *int64 `json:"sessiontimeoutinmins,omitempty"`
+	SessionTimeoutReminderInMins    *int64 `json:"sessiontimeoutreminderinmins,omitempty"`
+	InactivityTimeoutEnabled        *bool  `json:"inactivitytimeoutenabled,omitempty"`
+	InactivityTimeoutInMins         *int64 `json:"inactivitytimeoutinmins,omitempty"`
+	InactivityTimeoutReminderInMins *int64 `json:"inactivitytimeoutreminderinmins,omitempty"`
 }
 
 type environmentIdDto struct {
@@ -78,14 +78,20 @@ type FeaturesSourceModel struct {
 }
 
 type SecuritySourceModel struct {
-	EnableIpBasedCookieBinding           types.Bool `tfsdk:"enable_ip_based_cookie_binding"`
-	EnableIpBasedFirewallRule            types.Bool `tfsdk:"enable_ip_based_firewall_rule"`
-	AllowedIpRangeForFirewall            types.Set  `tfsdk:"allowed_ip_range_for_firewall"`
-	AllowedServiceTagsForFirewall        types.Set  `tfsdk:"allowed_service_tags_for_firewall"`
-	AllowApplicationUserAccess           types.Bool `tfsdk:"allow_application_user_access"`
-	AllowMicrosoftTrustedServiceTags     types.Bool `tfsdk:"allow_microsoft_trusted_service_tags"`
-	EnableIpBasedFirewallRuleInAuditMode types.Bool `tfsdk:"enable_ip_based_firewall_rule_in_audit_mode"`
-	ReverseProxyIpAddresses              types.Set  `tfsdk:"reverse_proxy_ip_addresses"`
+	EnableIpBasedCookieBinding           types.Bool  `tfsdk:"enable_ip_based_cookie_binding"`
+	EnableIpBasedFirewallRule            types.Bool  `tfsdk:"enable_ip_based_firewall_rule"`
+	AllowedIpRangeForFirewall            types.Set   `tfsdk:"allowed_ip_range_for_firewall"`
+	AllowedServiceTagsForFirewall        types.Set   `tfsdk:"allowed_service_tags_for_firewall"`
+	AllowApplicationUserAccess           types.Bool  `tfsdk:"allow_application_user_access"`
+	AllowMicrosoftTrustedServiceTags     types.Bool  `tfsdk:"allow_microsoft_trusted_service_tags"`
+	EnableIpBasedFirewallRuleInAuditMode types.Bool  `tfsdk:"enable_ip_based_firewall_rule_in_audit_mode"`
+	ReverseProxyIpAddresses              types.Set   `tfsdk:"reverse_proxy_ip_addresses"`
+	EnableSessionTimeout                 types.Bool  `tfsdk:"enable_session_timeout"`
+	SessionTimeoutInMinutes              types.Int64 `tfsdk:"session_timeout_in_minutes"`
+	SessionTimeoutReminderInMinutes      types.Int64 `tfsdk:"session_timeout_reminder_in_minutes"`
+	EnableInactivityTimeout              types.Bool  `tfsdk:"enable_inactivity_timeout"`
+	InactivityTimeoutInMinutes           types.Int64 `tfsdk:"inactivity_timeout_in_minutes"`
+	InactivityTimeoutReminderInMinutes   types.Int64 `tfsdk:"inactivity_timeout_reminder_in_minutes"`
 }
 
 func convertFromEnvironmentSettingsModel(ctx context.Context, environmentSettings EnvironmentSettingsResourceModel) (*environmentSettingsDto, error) {
@@ -239,6 +245,24 @@ func convertFromEnvironmentSecuritySettings(ctx context.Context, environmentSett
 			value := strings.Join(helpers.SetToStringSlice(securitySourceModel.ReverseProxyIpAddresses), ",")
 			environmentSettingsDto.ReverseProxyIpAddresses = &value
 		}
+		if !securitySourceModel.EnableSessionTimeout.IsNull() && !securitySourceModel.EnableSessionTimeout.IsUnknown() {
+			environmentSettingsDto.SessionTimeoutEnabled = securitySourceModel.EnableSessionTimeout.ValueBoolPointer()
+		}
+		if !securitySourceModel.SessionTimeoutInMinutes.IsNull() && !securitySourceModel.SessionTimeoutInMinutes.IsUnknown() {
+			environmentSettingsDto.SessionTimeoutInMins = securitySourceModel.SessionTimeoutInMinutes.ValueInt64Pointer()
+		}
+		if !securitySourceModel.SessionTimeoutReminderInMinutes.IsNull() && !securitySourceModel.SessionTimeoutReminderInMinutes.IsUnknown() {
+			environmentSettingsDto.SessionTimeoutReminderInMins = securitySourceModel.SessionTimeoutReminderInMinutes.ValueInt64Pointer()
+		}
+		if !securitySourceModel.EnableInactivityTimeout.IsNull() && !securitySourceModel.EnableInactivityTimeout.IsUnknown() {
+			environmentSettingsDto.InactivityTimeoutEnabled = securitySourceModel.EnableInactivityTimeout.ValueBoolPointer()
+		}
+		if !securitySourceModel.InactivityTimeoutInMinutes.IsNull() && !securitySourceModel.InactivityTimeoutInMinutes.IsUnknown() {
+			environmentSettingsDto.InactivityTimeoutInMins = securitySourceModel.InactivityTimeoutInMinutes.ValueInt64Pointer()
+		}
+		if !securitySourceModel.InactivityTimeoutReminderInMinutes.IsNull() && !securitySourceModel.InactivityTimeoutReminderInMinutes.IsUnknown() {
+			environmentSettingsDto.InactivityTimeoutReminderInMins = securitySourceModel.InactivityTimeoutReminderInMinutes.ValueInt64Pointer()
+		}
 	}
 	return nil
 }
@@ -316,6 +340,12 @@ func convertFromEnvironmentSettingsDto[T EnvironmentSettingsResourceModel | Envi
 		"allow_microsoft_trusted_service_tags":        types.BoolType,
 		"enable_ip_based_firewall_rule_in_audit_mode": types.BoolType,
 		"reverse_proxy_ip_addresses":                  types.SetType{ElemType: types.StringType},
+		"enable_session_timeout":                      types.BoolType,
+		"session_timeout_in_minutes":                  types.Int64Type,
+		"session_timeout_reminder_in_minutes":         types.Int64Type,
+		"enable_inactivity_timeout":                   types.BoolType,
+		"inactivity_timeout_in_minutes":               types.Int64Type,
+		"inactivity_timeout_reminder_in_minutes":      types.Int64Type,
 	}
 
 	attrTypesProductObject := map[string]attr.Type{
@@ -361,6 +391,12 @@ func convertFromEnvironmentSettingsDto[T EnvironmentSettingsResourceModel | Envi
 			"allow_microsoft_trusted_service_tags":        types.BoolValue(*environmentSettingsDto.AllowMicrosoftTrustedServiceTags),
 			"enable_ip_based_firewall_rule_in_audit_mode": types.BoolValue(*environmentSettingsDto.EnableIpBasedFirewallRuleInAuditMode),
 			"reverse_proxy_ip_addresses":                  types.SetValueMust(types.StringType, reverseProxyAdresses),
+			"enable_session_timeout":                      types.BoolPointerValue(environmentSettingsDto.SessionTimeoutEnabled),
+			"session_timeout_in_minutes":                  types.Int64PointerValue(environmentSettingsDto.SessionTimeoutInMins),
+			"session_timeout_reminder_in_minutes":         types.Int64PointerValue(environmentSettingsDto.SessionTimeoutReminderInMins),
+			"enable_inactivity_timeout":                   types.BoolPointerValue(environmentSettingsDto.InactivityTimeoutEnabled),
+			"inactivity_timeout_in_minutes":               types.Int64PointerValue(environmentSettingsDto.InactivityTimeoutInMins),
+			"inactivity_timeout_reminder_in_minutes":      types.Int64PointerValue(environmentSettingsDto.InactivityTimeoutReminderInMins),
 		}),
 	}
 
@@ -26,13 +26,13 @@ func TestUnitTestEnvironmentSettingsResource_Validate_Create_Empty_Settings(t *t
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resources/Validate_Create_Empty_Settings/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
 		})
 
-	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/organizations`,
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/organizations`,
 		func(req *http.Request) (*http.Response, error) {
 			getOrgInx++
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(fmt.Sprintf("tests/resources/Validate_Create_Empty_Settings/get_organisations_%d.json", getOrgInx)).String()), nil
 		})
 
-	httpmock.RegisterResponder("PATCH", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/organizations%2843f51247-aee6-ee11-9048-000d3a688755%29`,
+	httpmock.RegisterResponder("PATCH", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/organizations%2843f51247-aee6-ee11-9048-000d3a688755%29`,
 		func(req *http.Request) (*http.Response, error) {
 			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
 		})
@@ -275,13 +275,13 @@ func TestUnitTestEnvironmentSettingsResource_Validate_Read(t *testing.T) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resources/Validate_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
 		})
 
-	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/organizations`,
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/organizations`,
 		func(req *http.Request) (*http.Response, error) {
 			getOrgInx++
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(fmt.Sprintf("tests/resources/Validate_Read/get_organisations_%d.json", getOrgInx)).String()), nil
 		})
 
-	httpmock.RegisterResponder("PATCH", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/organizations%2843f51247-aee6-ee11-9048-000d3a688755%29`,
+	httpmock.RegisterResponder("PATCH", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/organizations%2843f51247-aee6-ee11-9048-000d3a688755%29`,
 		func(req *http.Request) (*http.Response, error) {
 			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
 		})
@@ -323,6 +323,12 @@ func TestUnitTestEnvironmentSettingsResource_Validate_Read(t *testing.T) {
 						  enable_ip_based_firewall_rule_in_audit_mode = true
 						  reverse_proxy_ip_addresses                  = toset(["10.10.1.1", "192.168.1.1"])
 						  enable_ip_based_cookie_binding              = true
+						  enable_session_timeout                      = true
+						  session_timeout_in_minutes                  = 480
+						  session_timeout_reminder_in_minutes         = 20
+						  enable_inactivity_timeout                   = true
+						  inactivity_timeout_in_minutes               = 60
+						  inactivity_timeout_reminder_in_minutes      = 5
 						}
 					  }
 				  }`,
@@ -350,6 +356,12 @@ func TestUnitTestEnvironmentSettingsResource_Validate_Read(t *testing.T) {
 					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "product.security.reverse_proxy_ip_addresses.0", "10.10.1.1"),
 					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "product.security.reverse_proxy_ip_addresses.1", "192.168.1.1"),
 					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "product.security.enable_ip_based_cookie_binding", "true"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "product.security.enable_session_timeout", "true"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "product.security.session_timeout_in_minutes", "480"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "product.security.session_timeout_reminder_in_minutes", "20"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "product.security.enable_inactivity_timeout", "true"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "product.security.inactivity_timeout_in_minutes", "60"),
+					resource.TestCheckResourceAttr("powerplatform_environment_settings.settings", "product.security.inactivity_timeout_reminder_in_minutes", "5"),
 				),
 			},
 		},
@@ -369,13 +381,13 @@ func TestUnitTestEnvironmentSettingsResource_Validate_No_Dataverse(t *testing.T)
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resources/Validate_No_Dataverse/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
 		})
 
-	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/organizations`,
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/organizations`,
 		func(req *http.Request) (*http.Response, error) {
 			getOrgInx++
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File(fmt.Sprintf("tests/resources/Validate_No_Dataverse/get_organisations_%d.json", getOrgInx)).String()), nil
 		})
 
-	httpmock.RegisterResponder("PATCH", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/organizations%2843f51247-aee6-ee11-9048-000d3a688755%29`,
+	httpmock.RegisterResponder("PATCH", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/organizations%2843f51247-aee6-ee11-9048-000d3a688755%29`,
 		func(req *http.Request) (*http.Response, error) {
 			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
 		})
@@ -10,6 +10,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -282,6 +283,60 @@ func (r *EnvironmentSettingsResource) Schema(ctx context.Context, req resource.S
 									setplanmodifier.UseStateForUnknown(),
 								},
 							},
+							"enable_session_timeout": schema.BoolAttribute{
+								MarkdownDescription: "Enable a custom session timeout. See [Session timeout management](https://learn.microsoft.com/power-platform/admin/user-session-management#session-timeout-management) for more details.",
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Bool{
+									boolplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"session_timeout_in_minutes": schema.Int64Attribute{
+								MarkdownDescription: "Session timeout in minutes, between 60 and 1440. Used when `enable_session_timeout` is `true`",
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Int64{
+									int64planmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.Int64{
+									int64validator.Between(60, 1440),
+								},
+							},
+							"session_timeout_reminder_in_minutes": schema.Int64Attribute{
+								MarkdownDescription: "Minutes before the session expires that the user is warned. Used when `enable_session_timeout` is `true`",
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Int64{
+									int64planmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"enable_inactivity_timeout": schema.BoolAttribute{
+								MarkdownDescription: "Sign users out after a period of inactivity. See [Inactivity timeout](https://learn.microsoft.com/power-platform/admin/user-session-management#inactivity-timeout) for more details.",
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Bool{
+									boolplanmodifier.UseStateForUnknown(),
+								},
+							},
+							"inactivity_timeout_in_minutes": schema.Int64Attribute{
+								MarkdownDescription: "Inactivity timeout in minutes, at least 5. Used when `enable_inactivity_timeout` is `true`",
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Int64{
+									int64planmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.Int64{
+									int64validator.AtLeast(5),
+								},
+							},
+							"inactivity_timeout_reminder_in_minutes": schema.Int64Attribute{
+								MarkdownDescription: "Minutes before the inactivity timeout that the user is warned. Used when `enable_inactivity_timeout` is `true`",
+								Optional:            true, Computed: true,
+								PlanModifiers: []planmodifier.Int64{
+									int64planmodifier.UseStateForUnknown(),
+								},
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
 						},
 					},
 				},
@@ -77,6 +77,7 @@ func (client *environmentWaveClient) UpdateFeature(ctx context.Context, environm
 	}
 
 	retryAfter := api.DefaultRetryAfter()
+	progress := client.Api.NewOperationProgress(fmt.Sprintf("Update of feature '%s'", featureName))
 	for {
 		feature, err := client.GetFeature(ctx, environmentId, featureName)
 		if err != nil {
@@ -94,6 +95,9 @@ func (client *environmentWaveClient) UpdateFeature(ctx context.Context, environm
 		}
 
 		tflog.Debug(ctx, fmt.Sprintf("Feature %s not yet enabled, polling...", featureName))
+		if feature != nil {
+			progress.Report(ctx, feature.AppsUpgradeState, -1)
+		}
 	}
 }
 
@@ -0,0 +1,133 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package import_blocks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment"
+)
+
+const (
+	RESOURCE_TYPE_ENVIRONMENT        = "powerplatform_environment"
+	RESOURCE_TYPE_APPLICATION_USER   = "powerplatform_application_user"
+	RESOURCE_TYPE_POWERPAGES_WEBSITE = "powerplatform_powerpages_website"
+)
+
+var resourceTypes = []string{RESOURCE_TYPE_ENVIRONMENT, RESOURCE_TYPE_APPLICATION_USER, RESOURCE_TYPE_POWERPAGES_WEBSITE}
+
+func newImportBlocksClient(apiClient *api.Client) client {
+	return client{
+		Api:               apiClient,
+		environmentClient: environment.NewEnvironmentClient(apiClient),
+	}
+}
+
+type client struct {
+	Api               *api.Client
+	environmentClient environment.Client
+}
+
+// GetImports lists the objects of the given resource types, in a single environment or in every environment of the tenant when environmentId is empty.
+func (client *client) GetImports(ctx context.Context, environmentId string, typesToImport []string) ([]importDto, error) {
+	var envs []environment.EnvironmentDto
+	if environmentId != "" {
+		env, err := client.environmentClient.GetEnvironment(ctx, environmentId)
+		if err != nil {
+			return nil, err
+		}
+		envs = []environment.EnvironmentDto{*env}
+	} else {
+		var err error
+		envs, err = client.environmentClient.GetEnvironments(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	imports := make([]importDto, 0)
+	for _, env := range envs {
+		if slices.Contains(typesToImport, RESOURCE_TYPE_ENVIRONMENT) {
+			displayName := env.Name
+			if env.Properties != nil && env.Properties.DisplayName != "" {
+				displayName = env.Properties.DisplayName
+			}
+			imports = append(imports, importDto{ResourceType: RESOURCE_TYPE_ENVIRONMENT, Name: displayName, Id: env.Name})
+		}
+
+		if env.Properties == nil || env.Properties.LinkedEnvironmentMetadata == nil || env.Properties.LinkedEnvironmentMetadata.InstanceURL == "" {
+			tflog.Debug(ctx, fmt.Sprintf("Environment '%s' has no Dataverse, skipping application users and websites", env.Name))
+			continue
+		}
+
+		if slices.Contains(typesToImport, RESOURCE_TYPE_APPLICATION_USER) {
+			instanceUrl, err := url.Parse(env.Properties.LinkedEnvironmentMetadata.InstanceURL)
+			if err != nil {
+				return nil, err
+			}
+			users, err := client.GetApplicationUsers(ctx, instanceUrl.Host)
+			if err != nil {
+				return nil, err
+			}
+			for _, user := range users {
+				imports = append(imports, importDto{ResourceType: RESOURCE_TYPE_APPLICATION_USER, Name: user.FullName, Id: fmt.Sprintf("%s/%s", env.Name, user.Id)})
+			}
+		}
+
+		if slices.Contains(typesToImport, RESOURCE_TYPE_POWERPAGES_WEBSITE) {
+			websites, err := client.GetWebsites(ctx, env.Name)
+			if err != nil {
+				return nil, err
+			}
+			for _, website := range websites {
+				imports = append(imports, importDto{ResourceType: RESOURCE_TYPE_POWERPAGES_WEBSITE, Name: website.Name, Id: fmt.Sprintf("%s/%s", env.Name, website.Id)})
+			}
+		}
+	}
+	return imports, nil
+}
+
+func (client *client) GetApplicationUsers(ctx context.Context, environmentHost string) ([]applicationUserDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/systemusers", client.Api.GetConfig().GetDataverseApiVersion()),
+	}
+	values := url.Values{}
+	values.Add("$select", "systemuserid,fullname,applicationid")
+	values.Add("$filter", "applicationid ne null")
+	apiUrl.RawQuery = values.Encode()
+
+	users := applicationUserArrayDto{}
+	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &users)
+	if err != nil {
+		return nil, err
+	}
+	return users.Value, nil
+}
+
+func (client *client) GetWebsites(ctx context.Context, environmentId string) ([]websiteDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.PowerPlatformUrl,
+		Path:   fmt.Sprintf("/powerpages/environments/%s/websites", environmentId),
+	}
+	values := url.Values{}
+	values.Add(constants.API_VERSION_PARAM, client.Api.GetApiVersion(ctx, api.API_SERVICE_POWER_PAGES))
+	apiUrl.RawQuery = values.Encode()
+
+	websites := websiteArrayDto{}
+	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &websites)
+	if err != nil {
+		return nil, err
+	}
+	return websites.Value, nil
+}
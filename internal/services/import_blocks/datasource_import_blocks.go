@@ -0,0 +1,181 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package import_blocks
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var (
+	_ datasource.DataSource              = &DataSource{}
+	_ datasource.DataSourceWithConfigure = &DataSource{}
+)
+
+var invalidLabelCharacters = regexp.MustCompile(`[^a-z0-9_]+`)
+
+func NewImportBlocksDataSource() datasource.DataSource {
+	return &DataSource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "import_blocks",
+		},
+	}
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Generates [import blocks](https://developer.hashicorp.com/terraform/language/import) for the environments, application users and Power Pages websites that already exist in the tenant, to bring them under Terraform management. Write the `content` attribute to a `.tf` file and run `terraform plan -generate-config-out=generated.tf` to generate the matching resource configuration.\n\n" +
+			"Application users include the built-in application users of Microsoft services, filter the `imports` list by name before importing them.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Read: true,
+			}),
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment to generate the import blocks for. When not set, import blocks are generated for every environment of the tenant",
+				Optional:            true,
+			},
+			"resource_types": schema.SetAttribute{
+				MarkdownDescription: fmt.Sprintf("Resource types to generate the import blocks for. Valid values are `%s`. When not set, import blocks are generated for all of them", strings.Join(resourceTypes, "`, `")),
+				ElementType:         types.StringType,
+				Optional:            true,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(resourceTypes...)),
+				},
+			},
+			"imports": schema.ListNestedAttribute{
+				MarkdownDescription: "List of imports",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"resource_type": schema.StringAttribute{
+							MarkdownDescription: "Resource type of the import",
+							Computed:            true,
+						},
+						"to": schema.StringAttribute{
+							MarkdownDescription: "Resource address the object is imported to, derived from the name of the object",
+							Computed:            true,
+						},
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Import id of the object. Objects that belong to an environment use the `<environment_id>/<id>` format",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"content": schema.StringAttribute{
+				MarkdownDescription: "Import blocks of all the imports, ready to be written to a `.tf` file",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ImportBlocksClient = newImportBlocksClient(client.Api)
+	client.Api.AddPreviewApiVersionWarning(&resp.Diagnostics, api.API_SERVICE_POWER_PAGES)
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var state ListDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	typesToImport := state.ResourceTypes
+	if len(typesToImport) == 0 {
+		typesToImport = resourceTypes
+	}
+
+	imports, err := d.ImportBlocksClient.GetImports(ctx, state.EnvironmentId.ValueString(), typesToImport)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+
+	state.Imports, state.Content = convertFromImportDtos(imports)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func convertFromImportDtos(imports []importDto) ([]DataSourceModel, types.String) {
+	models := make([]DataSourceModel, 0, len(imports))
+	blocks := make([]string, 0, len(imports))
+	usedAddresses := map[string]bool{}
+	for _, imp := range imports {
+		address := fmt.Sprintf("%s.%s", imp.ResourceType, resourceLabel(imp.Name, imp.Id))
+		for i := 2; usedAddresses[address]; i++ {
+			address = fmt.Sprintf("%s.%s_%d", imp.ResourceType, resourceLabel(imp.Name, imp.Id), i)
+		}
+		usedAddresses[address] = true
+
+		models = append(models, DataSourceModel{
+			ResourceType: types.StringValue(imp.ResourceType),
+			To:           types.StringValue(address),
+			Id:           types.StringValue(imp.Id),
+		})
+		blocks = append(blocks, fmt.Sprintf("import {\n  to = %s\n  id = %q\n}\n", address, imp.Id))
+	}
+	return models, types.StringValue(strings.Join(blocks, "\n"))
+}
+
+// resourceLabel turns the name of an object into a valid resource label, falling back to the id when the name has no usable characters.
+func resourceLabel(name, id string) string {
+	label := strings.Trim(invalidLabelCharacters.ReplaceAllString(strings.ToLower(name), "_"), "_")
+	if label == "" {
+		label = strings.Trim(invalidLabelCharacters.ReplaceAllString(strings.ToLower(id), "_"), "_")
+	}
+	if label == "" || (label[0] >= '0' && label[0] <= '9') {
+		label = "r_" + label
+	}
+	return label
+}
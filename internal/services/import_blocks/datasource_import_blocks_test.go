@@ -0,0 +1,144 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package import_blocks_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccImportBlocksDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_import_blocks" "environments" {
+					resource_types = ["powerplatform_environment"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.environments", "imports.0.resource_type", "powerplatform_environment"),
+					resource.TestMatchResourceAttr("data.powerplatform_import_blocks.environments", "imports.0.to", regexp.MustCompile(`^powerplatform_environment\.[a-z_][a-z0-9_]*$`)),
+					resource.TestMatchResourceAttr("data.powerplatform_import_blocks.environments", "imports.0.id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("data.powerplatform_import_blocks.environments", "content", regexp.MustCompile(`^import \{\n  to = powerplatform_environment\.`)),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitImportBlocksDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments?%24expand=properties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_environments.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/systemusers?%24filter=applicationid+ne+null&%24select=systemuserid%2Cfullname%2Capplicationid`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_application_users_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000002.crm4.dynamics.com/api/data/v9.2/systemusers?%24filter=applicationid+ne+null&%24select=systemuserid%2Cfullname%2Capplicationid`,
+		httpmock.NewStringResponder(http.StatusOK, `{"value":[]}`))
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_websites_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000002/websites?api-version=2022-03-01-preview`,
+		httpmock.NewStringResponder(http.StatusOK, `{"value":[]}`))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_import_blocks" "all" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.#", "5"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.0.resource_type", "powerplatform_environment"),
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.0.to", "powerplatform_environment.admin_adminonmicrosoft_s_environment"),
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.0.id", "00000000-0000-0000-0000-000000000001"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.1.resource_type", "powerplatform_application_user"),
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.1.to", "powerplatform_application_user.automation"),
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.1.id", "00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000010"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.2.resource_type", "powerplatform_powerpages_website"),
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.2.to", "powerplatform_powerpages_website.contoso_portal"),
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.2.id", "00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000020"),
+
+					// environments with the same display name get a unique address.
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.3.to", "powerplatform_environment.displayname"),
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.4.to", "powerplatform_environment.displayname_2"),
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.all", "imports.4.id", "00000000-0000-0000-0000-000000000003"),
+
+					resource.TestMatchResourceAttr("data.powerplatform_import_blocks.all", "content", regexp.MustCompile(`(?m)^import \{\n  to = powerplatform_application_user\.automation\n  id = "00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000010"\n\}$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitImportBlocksDataSource_Validate_Read_Environment_Resource_Types(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_websites_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_import_blocks" "websites" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					resource_types = ["powerplatform_powerpages_website"]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.websites", "imports.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.websites", "imports.0.to", "powerplatform_powerpages_website.contoso_portal"),
+					resource.TestCheckResourceAttr("data.powerplatform_import_blocks.websites", "content", "import {\n  to = powerplatform_powerpages_website.contoso_portal\n  id = \"00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000020\"\n}\n"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitImportBlocksDataSource_Validate_Invalid_Resource_Type(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_import_blocks" "users" {
+					resource_types = ["powerplatform_user"]
+				}`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Value Match`),
+			},
+		},
+	})
+}
@@ -0,0 +1,29 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package import_blocks
+
+type applicationUserDto struct {
+	Id            string `json:"systemuserid"`
+	FullName      string `json:"fullname"`
+	ApplicationId string `json:"applicationid"`
+}
+
+type applicationUserArrayDto struct {
+	Value []applicationUserDto `json:"value"`
+}
+
+type websiteDto struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type websiteArrayDto struct {
+	Value []websiteDto `json:"value"`
+}
+
+type importDto struct {
+	ResourceType string
+	Name         string
+	Id           string
+}
@@ -0,0 +1,29 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package import_blocks
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+type DataSource struct {
+	helpers.TypeInfo
+	ImportBlocksClient client
+}
+
+type ListDataSourceModel struct {
+	Timeouts      timeouts.Value    `tfsdk:"timeouts"`
+	EnvironmentId types.String      `tfsdk:"environment_id"`
+	ResourceTypes []string          `tfsdk:"resource_types"`
+	Imports       []DataSourceModel `tfsdk:"imports"`
+	Content       types.String      `tfsdk:"content"`
+}
+
+type DataSourceModel struct {
+	ResourceType types.String `tfsdk:"resource_type"`
+	To           types.String `tfsdk:"to"`
+	Id           types.String `tfsdk:"id"`
+}
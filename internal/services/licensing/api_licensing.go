@@ -34,7 +34,7 @@ func (client *Client) GetBillingPolicies(ctx context.Context) ([]BillingPolicyDt
 	}
 
 	values := url.Values{}
-	values.Add("api-version", "2022-03-01-preview")
+	values.Add("api-version", client.Api.GetApiVersion(ctx, api.API_SERVICE_LICENSING))
 	apiUrl.RawQuery = values.Encode()
 
 	policies := BillingPolicyArrayDto{}
@@ -51,13 +51,13 @@ func (client *Client) GetBillingPolicy(ctx context.Context, billingId string) (*
 	}
 
 	values := url.Values{}
-	values.Add("api-version", "2022-03-01-preview")
+	values.Add("api-version", client.Api.GetApiVersion(ctx, api.API_SERVICE_LICENSING))
 	apiUrl.RawQuery = values.Encode()
 
 	policy := BillingPolicyDto{}
 	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &policy)
 
-	var httpError *customerrors.UnexpectedHttpStatusCodeError
+	var httpError customerrors.UnexpectedHttpStatusCodeError
 	if err != nil && errors.As(err, &httpError) && httpError.StatusCode == http.StatusNotFound {
 		return nil, customerrors.WrapIntoProviderError(err, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("Billing Policy with ID '%s' not found", billingId))
 	}
@@ -72,7 +72,7 @@ func (client *Client) CreateBillingPolicy(ctx context.Context, policyToCreate bi
 	}
 
 	values := url.Values{}
-	values.Add(constants.API_VERSION_PARAM, "2022-03-01-preview")
+	values.Add(constants.API_VERSION_PARAM, client.Api.GetApiVersion(ctx, api.API_SERVICE_LICENSING))
 	apiUrl.RawQuery = values.Encode()
 
 	policy := &BillingPolicyDto{}
@@ -101,7 +101,7 @@ func (client *Client) UpdateBillingPolicy(ctx context.Context, billingId string,
 	}
 
 	values := url.Values{}
-	values.Add("api-version", "2022-03-01-preview")
+	values.Add("api-version", client.Api.GetApiVersion(ctx, api.API_SERVICE_LICENSING))
 	apiUrl.RawQuery = values.Encode()
 
 	policy := &BillingPolicyDto{}
@@ -127,7 +127,7 @@ func (client *Client) DeleteBillingPolicy(ctx context.Context, billingId string)
 	}
 
 	values := url.Values{}
-	values.Add("api-version", "2022-03-01-preview")
+	values.Add("api-version", client.Api.GetApiVersion(ctx, api.API_SERVICE_LICENSING))
 	apiUrl.RawQuery = values.Encode()
 
 	_, err := client.Api.Execute(ctx, nil, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusNoContent}, nil)
@@ -143,13 +143,13 @@ func (client *Client) GetEnvironmentsForBillingPolicy(ctx context.Context, billi
 	}
 
 	values := url.Values{}
-	values.Add("api-version", "2022-03-01-preview")
+	values.Add("api-version", client.Api.GetApiVersion(ctx, api.API_SERVICE_LICENSING))
 	apiUrl.RawQuery = values.Encode()
 
 	billingPolicyEnvironments := BillingPolicyEnvironmentsArrayResponseDto{}
 	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &billingPolicyEnvironments)
 	if err != nil {
-		var httpError *customerrors.UnexpectedHttpStatusCodeError
+		var httpError customerrors.UnexpectedHttpStatusCodeError
 		if errors.As(err, &httpError) && httpError.StatusCode == http.StatusNotFound {
 			return nil, customerrors.WrapIntoProviderError(err, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("Billing Policy with ID '%s' not found", billingId))
 		}
@@ -174,7 +174,7 @@ func (client *Client) AddEnvironmentsToBillingPolicy(ctx context.Context, billin
 	}
 
 	values := url.Values{}
-	values.Add("api-version", "2022-03-01-preview")
+	values.Add("api-version", client.Api.GetApiVersion(ctx, api.API_SERVICE_LICENSING))
 	apiUrl.RawQuery = values.Encode()
 
 	environments := BillingPolicyEnvironmentsArrayDto{
@@ -196,7 +196,7 @@ func (client *Client) RemoveEnvironmentsToBillingPolicy(ctx context.Context, bil
 	}
 
 	values := url.Values{}
-	values.Add("api-version", "2022-03-01-preview")
+	values.Add("api-version", client.Api.GetApiVersion(ctx, api.API_SERVICE_LICENSING))
 	apiUrl.RawQuery = values.Encode()
 
 	environments := BillingPolicyEnvironmentsArrayDto{
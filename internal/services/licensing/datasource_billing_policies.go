@@ -115,6 +115,7 @@ func (d *BillingPoliciesDataSource) Configure(ctx context.Context, req datasourc
 		return
 	}
 	d.LicensingClient = NewLicensingClient(client.Api)
+	client.Api.AddPreviewApiVersionWarning(&resp.Diagnostics, api.API_SERVICE_LICENSING)
 }
 
 func (d *BillingPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
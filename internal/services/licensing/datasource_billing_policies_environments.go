@@ -82,6 +82,7 @@ func (d *BillingPoliciesEnvironmetsDataSource) Configure(ctx context.Context, re
 		return
 	}
 	d.LicensingClient = NewLicensingClient(client.Api)
+	client.Api.AddPreviewApiVersionWarning(&resp.Diagnostics, api.API_SERVICE_LICENSING)
 }
 
 func (d *BillingPoliciesEnvironmetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
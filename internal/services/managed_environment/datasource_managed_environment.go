@@ -0,0 +1,174 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package managed_environment
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment"
+)
+
+const (
+	PROTECTION_LEVEL_STANDARD = "Standard"
+
+	LIMIT_SHARING_MODE_NO_LIMIT = "NoLimit"
+)
+
+var (
+	_ datasource.DataSource              = &ManagedEnvironmentDataSource{}
+	_ datasource.DataSourceWithConfigure = &ManagedEnvironmentDataSource{}
+)
+
+func NewManagedEnvironmentDataSource() datasource.DataSource {
+	return &ManagedEnvironmentDataSource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "managed_environment",
+		},
+	}
+}
+
+func (d *ManagedEnvironmentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *ManagedEnvironmentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the effective [Managed Environment](https://learn.microsoft.com/power-platform/admin/managed-environment-overview) settings of an environment, such as its [sharing limits](https://learn.microsoft.com/power-platform/admin/managed-environment-sharing-limits), so that they can be asserted with checks or preconditions. Environments that are not managed report the settings that apply to them, which is no sharing limit.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Read: true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique id of the environment",
+				Computed:            true,
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment",
+				Required:            true,
+			},
+			"is_managed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the environment is a Managed Environment",
+				Computed:            true,
+			},
+			"protection_level": schema.StringAttribute{
+				MarkdownDescription: "Protection level of the environment, `Standard` for Managed Environments",
+				Computed:            true,
+			},
+			"is_sharing_limited": schema.BoolAttribute{
+				MarkdownDescription: "Whether the sharing of canvas apps is limited, either by excluding sharing with security groups or by limiting the number of users the apps can be shared with",
+				Computed:            true,
+			},
+			"is_group_sharing_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Whether canvas apps can not be shared with security groups",
+				Computed:            true,
+			},
+			"limit_sharing_mode": schema.StringAttribute{
+				MarkdownDescription: "Sharing limit mode of canvas apps, `ExcludeSharingToSecurityGroups` or `NoLimit`",
+				Computed:            true,
+			},
+			"max_limit_user_sharing": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of users canvas apps can be shared with, `-1` when the number of users is not limited",
+				Computed:            true,
+			},
+			"solution_checker_mode": schema.StringAttribute{
+				MarkdownDescription: "Solution checker enforcement mode, `None`, `Warn` or `Block`",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ManagedEnvironmentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	d.ManagedEnvironmentClient = newManagedEnvironmentClient(client.Api)
+}
+
+func (d *ManagedEnvironmentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var state ManagedEnvironmentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	env, err := d.ManagedEnvironmentClient.environmentClient.GetEnvironment(ctx, state.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromGovernanceConfigurationDto(&state, env)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func convertFromGovernanceConfigurationDto(model *ManagedEnvironmentDataSourceModel, env *environment.EnvironmentDto) {
+	model.Id = types.StringValue(env.Name)
+
+	// environments that are not managed have no sharing limits.
+	model.IsManaged = types.BoolValue(false)
+	model.ProtectionLevel = types.StringNull()
+	model.IsGroupSharingDisabled = types.BoolValue(false)
+	model.LimitSharingMode = types.StringValue(LIMIT_SHARING_MODE_NO_LIMIT)
+	model.MaxLimitUserSharing = types.Int64Value(-1)
+	model.SolutionCheckerMode = types.StringValue("None")
+
+	if env.Properties != nil && env.Properties.GovernanceConfiguration != nil {
+		model.ProtectionLevel = types.StringValue(env.Properties.GovernanceConfiguration.ProtectionLevel)
+		model.IsManaged = types.BoolValue(env.Properties.GovernanceConfiguration.ProtectionLevel == PROTECTION_LEVEL_STANDARD)
+
+		if model.IsManaged.ValueBool() && env.Properties.GovernanceConfiguration.Settings != nil {
+			settings := env.Properties.GovernanceConfiguration.Settings.ExtendedSettings
+			if maxLimitUserSharing, err := strconv.ParseInt(settings.MaxLimitUserSharing, 10, 64); err == nil {
+				model.MaxLimitUserSharing = types.Int64Value(maxLimitUserSharing)
+			}
+			model.IsGroupSharingDisabled = types.BoolValue(settings.IsGroupSharingDisabled == "true")
+			if settings.LimitSharingMode != "" {
+				model.LimitSharingMode = types.StringValue(strings.ToUpper(settings.LimitSharingMode[:1]) + settings.LimitSharingMode[1:])
+			}
+			if settings.SolutionCheckerMode != "" {
+				model.SolutionCheckerMode = types.StringValue(strings.ToUpper(settings.SolutionCheckerMode[:1]) + settings.SolutionCheckerMode[1:])
+			}
+		}
+	}
+
+	model.IsSharingLimited = types.BoolValue(model.IsGroupSharingDisabled.ValueBool() || model.MaxLimitUserSharing.ValueInt64() >= 0)
+}
@@ -0,0 +1,106 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package managed_environment_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccManagedEnvironmentDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "development" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_managed_environment" "managed_development" {
+					environment_id             = powerplatform_environment.development.id
+					is_usage_insights_disabled = true
+					is_group_sharing_disabled  = true
+					limit_sharing_mode         = "ExcludeSharingToSecurityGroups"
+					max_limit_user_sharing     = 10
+					solution_checker_mode      = "None"
+					suppress_validation_emails = true
+					maker_onboarding_markdown  = "this is example markdown"
+					maker_onboarding_url       = "https://www.microsoft.com"
+				}
+
+				data "powerplatform_managed_environment" "managed_development" {
+					environment_id = powerplatform_managed_environment.managed_development.environment_id
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed_development", "is_managed", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed_development", "is_sharing_limited", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed_development", "is_group_sharing_disabled", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed_development", "limit_sharing_mode", "ExcludeSharingToSecurityGroups"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed_development", "max_limit_user_sharing", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitManagedEnvironmentDataSource_Validate_Read_Sharing_Limits(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read_Sharing_Limits/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000002?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read_Sharing_Limits/get_environment_00000000-0000-0000-0000-000000000002.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_managed_environment" "managed" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}
+
+				data "powerplatform_managed_environment" "not_managed" {
+					environment_id = "00000000-0000-0000-0000-000000000002"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed", "is_managed", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed", "protection_level", "Standard"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed", "is_sharing_limited", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed", "is_group_sharing_disabled", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed", "limit_sharing_mode", "ExcludeSharingToSecurityGroups"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed", "max_limit_user_sharing", "20"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.managed", "solution_checker_mode", "Block"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.not_managed", "is_managed", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.not_managed", "protection_level", "Basic"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.not_managed", "is_sharing_limited", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.not_managed", "is_group_sharing_disabled", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.not_managed", "limit_sharing_mode", "NoLimit"),
+					resource.TestCheckResourceAttr("data.powerplatform_managed_environment.not_managed", "max_limit_user_sharing", "-1"),
+				),
+			},
+		},
+	})
+}
@@ -45,3 +45,21 @@ type SolutionCheckerRuleDto struct {
 	Severity        string `json:"severity,omitempty"`
 	HowToFix        string `json:"howToFix,omitempty"`
 }
+
+type ManagedEnvironmentDataSource struct {
+	helpers.TypeInfo
+	ManagedEnvironmentClient client
+}
+
+type ManagedEnvironmentDataSourceModel struct {
+	Timeouts               timeouts.Value `tfsdk:"timeouts"`
+	Id                     types.String   `tfsdk:"id"`
+	EnvironmentId          types.String   `tfsdk:"environment_id"`
+	IsManaged              types.Bool     `tfsdk:"is_managed"`
+	ProtectionLevel        types.String   `tfsdk:"protection_level"`
+	IsSharingLimited       types.Bool     `tfsdk:"is_sharing_limited"`
+	IsGroupSharingDisabled types.Bool     `tfsdk:"is_group_sharing_disabled"`
+	MaxLimitUserSharing    types.Int64    `tfsdk:"max_limit_user_sharing"`
+	LimitSharingMode       types.String   `tfsdk:"limit_sharing_mode"`
+	SolutionCheckerMode    types.String   `tfsdk:"solution_checker_mode"`
+}
@@ -14,6 +14,19 @@ import (
 	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment"
 )
 
+const (
+	POWER_APP_TYPE_CANVAS       = "canvas"
+	POWER_APP_TYPE_MODEL_DRIVEN = "model_driven"
+
+	POWER_APP_ALM_MODE_SOLUTION = "Solution"
+
+	POWER_APP_ROLE_CAN_EDIT = "CanEdit"
+
+	POWER_APP_PRINCIPAL_TYPE_USER              = "User"
+	POWER_APP_PRINCIPAL_TYPE_GROUP             = "Group"
+	POWER_APP_PRINCIPAL_TYPE_SERVICE_PRINCIPAL = "ServicePrincipal"
+)
+
 func newPowerAppssClient(apiClient *api.Client) client {
 	return client{
 		Api:               apiClient,
@@ -26,28 +39,81 @@ type client struct {
 	environmentClient environment.Client
 }
 
-func (client *client) GetPowerApps(ctx context.Context) ([]powerAppBapiDto, error) {
-	envs, err := client.environmentClient.GetEnvironments(ctx)
-	if err != nil {
-		return nil, err
+// GetPowerApps lists the apps of the environment, or of all the environments of the tenant when environmentId is empty.
+func (client *client) GetPowerApps(ctx context.Context, environmentId string) ([]powerAppBapiDto, error) {
+	environmentIds := []string{environmentId}
+	if environmentId == "" {
+		envs, err := client.environmentClient.GetEnvironments(ctx)
+		if err != nil {
+			return nil, err
+		}
+		environmentIds = make([]string, 0, len(envs))
+		for _, env := range envs {
+			environmentIds = append(environmentIds, env.Name)
+		}
 	}
+
 	apps := make([]powerAppBapiDto, 0)
-	for _, env := range envs {
-		apiUrl := &url.URL{
-			Scheme: constants.HTTPS,
-			Host:   client.Api.GetConfig().Urls.PowerAppsUrl,
-			Path:   fmt.Sprintf("/providers/Microsoft.PowerApps/scopes/admin/environments/%s/apps", env.Name),
+	for _, id := range environmentIds {
+		environmentApps, err := client.getEnvironmentPowerApps(ctx, id)
+		if err != nil {
+			return nil, err
 		}
-		values := url.Values{}
-		values.Add("api-version", "2023-06-01")
-		apiUrl.RawQuery = values.Encode()
+		apps = append(apps, environmentApps...)
+	}
+	return apps, nil
+}
 
+func (client *client) getEnvironmentPowerApps(ctx context.Context, environmentId string) ([]powerAppBapiDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.PowerAppsUrl,
+		Path:   fmt.Sprintf("/providers/Microsoft.PowerApps/scopes/admin/environments/%s/apps", environmentId),
+	}
+	values := url.Values{}
+	values.Add("api-version", "2023-06-01")
+	apiUrl.RawQuery = values.Encode()
+
+	apps := make([]powerAppBapiDto, 0)
+	for nextLink := apiUrl.String(); nextLink != ""; {
 		appsArray := powerAppArrayDto{}
-		_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &appsArray)
+		_, err := client.Api.Execute(ctx, nil, "GET", nextLink, nil, nil, []int{http.StatusOK}, &appsArray)
 		if err != nil {
 			return nil, err
 		}
 		apps = append(apps, appsArray.Value...)
+		nextLink = appsArray.NextLink
 	}
 	return apps, nil
 }
+
+// AddPowerAppCoOwner shares the canvas app with the principal with the `CanEdit` role, which makes the principal a co-owner of the app.
+func (client *client) AddPowerAppCoOwner(ctx context.Context, environmentId, appName, principalId, principalType string) error {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.PowerAppsUrl,
+		Path:   fmt.Sprintf("/providers/Microsoft.PowerApps/scopes/admin/environments/%s/apps/%s/modifyPermissions", environmentId, appName),
+	}
+	values := url.Values{}
+	values.Add("api-version", "2022-11-01")
+	apiUrl.RawQuery = values.Encode()
+
+	permissions := powerAppModifyPermissionsDto{
+		Put: []powerAppPermissionDto{
+			{
+				Properties: powerAppPermissionPropertiesDto{
+					RoleName: POWER_APP_ROLE_CAN_EDIT,
+					Principal: powerAppPrincipalDto{
+						Id:   principalId,
+						Type: principalType,
+					},
+					NotifyShareTargetOption: "DontNotify",
+				},
+			},
+		},
+		Delete: []powerAppPermissionIdDto{},
+	}
+
+	_, err := client.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, permissions, []int{http.StatusOK, http.StatusNoContent}, nil)
+	return err
+}
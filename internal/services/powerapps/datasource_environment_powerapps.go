@@ -6,10 +6,14 @@ package powerapps
 import (
 	"context"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/microsoft/terraform-provider-power-platform/internal/api"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
@@ -44,11 +48,32 @@ func (d *EnvironmentPowerAppsDataSource) Schema(ctx context.Context, req datasou
 	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
 	defer exitContext()
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetches the list of Power Apps in an environment.  See [Manage Power Apps](https://learn.microsoft.com/power-platform/admin/admin-manage-apps) for more details about how this data is surfaced in Power Platform Admin Center.",
+		MarkdownDescription: "Fetches the list of Power Apps in an environment, or in all the environments of the tenant when `environment_id` is not set.  See [Manage Power Apps](https://learn.microsoft.com/power-platform/admin/admin-manage-apps) for more details about how this data is surfaced in Power Platform Admin Center.",
 		Attributes: map[string]schema.Attribute{
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Read: true,
 			}),
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment whose apps are listed. When not set, the apps of all the environments are listed",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "environment_id must be a valid environment id guid"),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "Entra object id of the owner of the apps to list",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "owner_id must be a valid object id guid"),
+				},
+			},
+			"app_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the apps to list, `canvas` or `model_driven`",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(POWER_APP_TYPE_CANVAS, POWER_APP_TYPE_MODEL_DRIVEN),
+				},
+			},
 			"powerapps": schema.ListNestedAttribute{
 				MarkdownDescription: "List of Power Apps",
 				Computed:            true,
@@ -70,6 +95,30 @@ func (d *EnvironmentPowerAppsDataSource) Schema(ctx context.Context, req datasou
 							MarkdownDescription: "Created time",
 							Computed:            true,
 						},
+						"last_modified_time": schema.StringAttribute{
+							MarkdownDescription: "Last modified time",
+							Computed:            true,
+						},
+						"owner_id": schema.StringAttribute{
+							MarkdownDescription: "Entra object id of the owner of the app",
+							Computed:            true,
+						},
+						"owner_display_name": schema.StringAttribute{
+							MarkdownDescription: "Display name of the owner of the app",
+							Computed:            true,
+						},
+						"app_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the app, `canvas` or `model_driven`",
+							Computed:            true,
+						},
+						"app_open_uri": schema.StringAttribute{
+							MarkdownDescription: "Url that opens the app",
+							Computed:            true,
+						},
+						"is_solution_aware": schema.BoolAttribute{
+							MarkdownDescription: "Whether the app is part of a solution",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -109,7 +158,7 @@ func (d *EnvironmentPowerAppsDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 
-	apps, err := d.PowerAppssClient.GetPowerApps(ctx)
+	apps, err := d.PowerAppssClient.GetPowerApps(ctx, state.EnvironmentId.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
 		return
@@ -117,6 +166,12 @@ func (d *EnvironmentPowerAppsDataSource) Read(ctx context.Context, req datasourc
 
 	for _, app := range apps {
 		appModel := ConvertFromPowerAppDto(app)
+		if !state.OwnerId.IsNull() && !strings.EqualFold(appModel.OwnerId.ValueString(), state.OwnerId.ValueString()) {
+			continue
+		}
+		if !state.AppType.IsNull() && appModel.AppType.ValueString() != state.AppType.ValueString() {
+			continue
+		}
 		state.PowerApps = append(state.PowerApps, appModel)
 	}
 
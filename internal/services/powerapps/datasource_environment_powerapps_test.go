@@ -83,3 +83,60 @@ func TestUnitEnvironmentPowerAppsDataSource_Validate_Read(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitEnvironmentPowerAppsDataSource_Validate_Read_Filtered(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.powerapps.com/providers/Microsoft.PowerApps/scopes/admin/environments/00000000-0000-0000-0000-000000000001/apps?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/Validate_Read_Filtered/get_apps_page_1.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerapps.com/providers/Microsoft.PowerApps/scopes/admin/environments/00000000-0000-0000-0000-000000000001/apps?%24skiptoken=page2&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/Validate_Read_Filtered/get_apps_page_2.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_environment_powerapps" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}
+
+				data "powerplatform_environment_powerapps" "owned" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					owner_id       = "00000000-0000-0000-0000-000000000201"
+				}
+
+				data "powerplatform_environment_powerapps" "model_driven" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					app_type       = "model_driven"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.all", "powerapps.#", "3"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.all", "powerapps.0.owner_id", "00000000-0000-0000-0000-000000000201"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.all", "powerapps.0.owner_display_name", "Alex"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.all", "powerapps.0.app_type", "canvas"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.all", "powerapps.0.app_open_uri", "https://apps.powerapps.com/play/e/00000000-0000-0000-0000-000000000001/a/00000000-0000-0000-0000-000000000101"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.all", "powerapps.0.last_modified_time", "2026-10-01T09:30:00.0000000Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.all", "powerapps.0.is_solution_aware", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.all", "powerapps.1.is_solution_aware", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.all", "powerapps.2.app_type", "model_driven"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.owned", "powerapps.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.owned", "powerapps.0.display_name", "Expenses"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.owned", "powerapps.1.display_name", "Case Management"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.model_driven", "powerapps.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_environment_powerapps.model_driven", "powerapps.0.name", "00000000-0000-0000-0000-000000000103"),
+				),
+			},
+		},
+	})
+}
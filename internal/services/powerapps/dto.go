@@ -18,6 +18,9 @@ type powerAppPropertiesBapiDto struct {
 	LastModifiedTime string                 `json:"lastModifiedTime"`
 	LastPublishTime  string                 `json:"lastPublishTime"`
 	Environment      powerAppEnvironmentDto `json:"environment"`
+	AppOpenUri       string                 `json:"appOpenUri"`
+	AppType          string                 `json:"appType"`
+	AlmMode          string                 `json:"almMode"`
 }
 
 type powerAppEnvironmentDto struct {
@@ -33,5 +36,30 @@ type powerAppCreatedByDto struct {
 }
 
 type powerAppArrayDto struct {
-	Value []powerAppBapiDto `json:"value"`
+	Value    []powerAppBapiDto `json:"value"`
+	NextLink string            `json:"nextLink,omitempty"`
+}
+
+type powerAppModifyPermissionsDto struct {
+	Put    []powerAppPermissionDto   `json:"put"`
+	Delete []powerAppPermissionIdDto `json:"delete"`
+}
+
+type powerAppPermissionDto struct {
+	Properties powerAppPermissionPropertiesDto `json:"properties"`
+}
+
+type powerAppPermissionPropertiesDto struct {
+	RoleName                string               `json:"roleName"`
+	Principal               powerAppPrincipalDto `json:"principal"`
+	NotifyShareTargetOption string               `json:"NotifyShareTargetOption"`
+}
+
+type powerAppPrincipalDto struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type powerAppPermissionIdDto struct {
+	Id string `json:"id"`
 }
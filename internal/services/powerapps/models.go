@@ -4,6 +4,8 @@
 package powerapps
 
 import (
+	"strings"
+
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
@@ -15,22 +17,61 @@ type EnvironmentPowerAppsDataSource struct {
 }
 
 type EnvironmentPowerAppsListDataSourceModel struct {
-	Timeouts  timeouts.Value                        `tfsdk:"timeouts"`
-	PowerApps []EnvironmentPowerAppsDataSourceModel `tfsdk:"powerapps"`
+	Timeouts      timeouts.Value                        `tfsdk:"timeouts"`
+	EnvironmentId types.String                          `tfsdk:"environment_id"`
+	OwnerId       types.String                          `tfsdk:"owner_id"`
+	AppType       types.String                          `tfsdk:"app_type"`
+	PowerApps     []EnvironmentPowerAppsDataSourceModel `tfsdk:"powerapps"`
 }
 
 type EnvironmentPowerAppsDataSourceModel struct {
-	EnvironmentId types.String `tfsdk:"id"`
-	DisplayName   types.String `tfsdk:"display_name"`
-	Name          types.String `tfsdk:"name"`
-	CreatedTime   types.String `tfsdk:"created_time"`
+	EnvironmentId    types.String `tfsdk:"id"`
+	DisplayName      types.String `tfsdk:"display_name"`
+	Name             types.String `tfsdk:"name"`
+	CreatedTime      types.String `tfsdk:"created_time"`
+	LastModifiedTime types.String `tfsdk:"last_modified_time"`
+	OwnerId          types.String `tfsdk:"owner_id"`
+	OwnerDisplayName types.String `tfsdk:"owner_display_name"`
+	AppType          types.String `tfsdk:"app_type"`
+	AppOpenUri       types.String `tfsdk:"app_open_uri"`
+	IsSolutionAware  types.Bool   `tfsdk:"is_solution_aware"`
+}
+
+type PowerAppsCoOwnerResource struct {
+	helpers.TypeInfo
+	PowerAppssClient client
+}
+
+type PowerAppsCoOwnerResourceModel struct {
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	Id             types.String   `tfsdk:"id"`
+	EnvironmentId  types.String   `tfsdk:"environment_id"`
+	PrincipalId    types.String   `tfsdk:"principal_id"`
+	PrincipalType  types.String   `tfsdk:"principal_type"`
+	OwnerId        types.String   `tfsdk:"owner_id"`
+	IdempotencyKey types.String   `tfsdk:"idempotency_key"`
+	AppNames       types.Set      `tfsdk:"app_names"`
 }
 
 func ConvertFromPowerAppDto(powerAppDto powerAppBapiDto) EnvironmentPowerAppsDataSourceModel {
 	return EnvironmentPowerAppsDataSourceModel{
-		EnvironmentId: types.StringValue(powerAppDto.Properties.Environment.Name),
-		DisplayName:   types.StringValue(powerAppDto.Properties.DisplayName),
-		Name:          types.StringValue(powerAppDto.Name),
-		CreatedTime:   types.StringValue(powerAppDto.Properties.CreatedTime),
+		EnvironmentId:    types.StringValue(powerAppDto.Properties.Environment.Name),
+		DisplayName:      types.StringValue(powerAppDto.Properties.DisplayName),
+		Name:             types.StringValue(powerAppDto.Name),
+		CreatedTime:      types.StringValue(powerAppDto.Properties.CreatedTime),
+		LastModifiedTime: types.StringValue(powerAppDto.Properties.LastModifiedTime),
+		OwnerId:          types.StringValue(powerAppDto.Properties.Owner.Id),
+		OwnerDisplayName: types.StringValue(powerAppDto.Properties.Owner.DisplayName),
+		AppType:          types.StringValue(getPowerAppType(powerAppDto)),
+		AppOpenUri:       types.StringValue(powerAppDto.Properties.AppOpenUri),
+		IsSolutionAware:  types.BoolValue(strings.EqualFold(powerAppDto.Properties.AlmMode, POWER_APP_ALM_MODE_SOLUTION)),
+	}
+}
+
+// getPowerAppType returns the type of the app, the API only reporting the app type of apps that are not canvas apps.
+func getPowerAppType(powerAppDto powerAppBapiDto) string {
+	if strings.Contains(strings.ToLower(powerAppDto.Properties.AppType), "modeldriven") {
+		return POWER_APP_TYPE_MODEL_DRIVEN
 	}
+	return POWER_APP_TYPE_CANVAS
 }
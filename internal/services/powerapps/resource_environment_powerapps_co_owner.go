@@ -0,0 +1,236 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerapps
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &PowerAppsCoOwnerResource{}
+
+func NewEnvironmentPowerAppsCoOwnerResource() resource.Resource {
+	return &PowerAppsCoOwnerResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "environment_powerapps_co_owner",
+		},
+	}
+}
+
+func (r *PowerAppsCoOwnerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *PowerAppsCoOwnerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Makes a principal, typically a service principal, a co-owner of all the canvas apps of an environment, or of the apps owned by a user, so that the apps stay manageable when their owners leave the organization. The apps are shared with the `CanEdit` role with the admin api, without notifying the principal. The apps are shared when the resource is created, and again when any of its arguments change, for example a new `idempotency_key` to include the apps created since. Destroying the resource doesn't remove the co-owner from the apps.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the operation",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment of the apps",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "environment_id must be a valid environment id guid"),
+				},
+			},
+			"principal_id": schema.StringAttribute{
+				MarkdownDescription: "Entra object id of the principal that becomes a co-owner of the apps",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "principal_id must be a valid guid"),
+				},
+			},
+			"principal_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the principal, `ServicePrincipal`, `User` or `Group`. Defaults to `ServicePrincipal`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(POWER_APP_PRINCIPAL_TYPE_SERVICE_PRINCIPAL),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(POWER_APP_PRINCIPAL_TYPE_SERVICE_PRINCIPAL, POWER_APP_PRINCIPAL_TYPE_USER, POWER_APP_PRINCIPAL_TYPE_GROUP),
+				},
+			},
+			"owner_id": schema.StringAttribute{
+				MarkdownDescription: "Entra object id of a user, such as a leaver, to only share the apps owned by the user. All the canvas apps of the environment are shared when it isn't set",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "owner_id must be a valid guid"),
+				},
+			},
+			"idempotency_key": schema.StringAttribute{
+				MarkdownDescription: "Key of the operation. Changing the key shares the apps again, including the apps created since the last operation",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_names": schema.SetAttribute{
+				MarkdownDescription: "Names of the apps that the principal was made a co-owner of",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *PowerAppsCoOwnerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.PowerAppssClient = newPowerAppssClient(client.Api)
+}
+
+func (r *PowerAppsCoOwnerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *PowerAppsCoOwnerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apps, err := r.PowerAppssClient.GetPowerApps(ctx, plan.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	appNames := []string{}
+	var errs []error
+	for _, app := range apps {
+		if getPowerAppType(app) != POWER_APP_TYPE_CANVAS {
+			continue
+		}
+		if !plan.OwnerId.IsNull() && !strings.EqualFold(app.Properties.Owner.Id, plan.OwnerId.ValueString()) {
+			continue
+		}
+		// an app that can't be shared doesn't prevent the other apps from being shared.
+		err := r.PowerAppssClient.AddPowerAppCoOwner(ctx, plan.EnvironmentId.ValueString(), app.Name, plan.PrincipalId.ValueString(), plan.PrincipalType.ValueString())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("app '%s': %w", app.Name, err))
+			continue
+		}
+		appNames = append(appNames, app.Name)
+	}
+	if len(errs) > 0 {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), fmt.Sprintf("%d of %d apps could not be shared: %s", len(errs), len(errs)+len(appNames), errors.Join(errs...).Error()))
+		return
+	}
+
+	plan.Id = types.StringValue(uuid.New().String())
+	appNamesSet, diags := types.SetValueFrom(ctx, types.StringType, appNames)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.AppNames = appNamesSet
+
+	tflog.Debug(ctx, fmt.Sprintf("CREATE: %s made %s a co-owner of %d apps", r.FullTypeName(), plan.PrincipalId.ValueString(), len(appNames)))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *PowerAppsCoOwnerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *PowerAppsCoOwnerResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The operation has no remote state to refresh, sharing the apps again is left to changes of its arguments.
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *PowerAppsCoOwnerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// All the arguments except the timeouts require a replacement, which shares the apps again.
+	var plan *PowerAppsCoOwnerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *PowerAppsCoOwnerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// The co-owner keeps access to the apps, since removing it could leave apps without an active owner.
+	tflog.Debug(ctx, fmt.Sprintf("%s removed from state, the co-owner is not removed from the apps", r.FullTypeName()))
+}
@@ -0,0 +1,138 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerapps_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func registerCoOwnerAppsResponders() {
+	httpmock.RegisterResponder("GET", `https://api.powerapps.com/providers/Microsoft.PowerApps/scopes/admin/environments/00000000-0000-0000-0000-000000000001/apps?api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/Validate_Read_Filtered/get_apps_page_1.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerapps.com/providers/Microsoft.PowerApps/scopes/admin/environments/00000000-0000-0000-0000-000000000001/apps?%24skiptoken=page2&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/Validate_Read_Filtered/get_apps_page_2.json").String()), nil
+		})
+}
+
+func TestUnitEnvironmentPowerAppsCoOwnerResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerCoOwnerAppsResponders()
+
+	sharedApps := []string{}
+	httpmock.RegisterResponder("POST", `=~^https://api\.powerapps\.com/providers/Microsoft\.PowerApps/scopes/admin/environments/00000000-0000-0000-0000-000000000001/apps/([\d-]+)/modifyPermissions\?api-version=2022-11-01$`,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			permissions := struct {
+				Put []struct {
+					Properties struct {
+						RoleName  string `json:"roleName"`
+						Principal struct {
+							Id   string `json:"id"`
+							Type string `json:"type"`
+						} `json:"principal"`
+						NotifyShareTargetOption string `json:"NotifyShareTargetOption"`
+					} `json:"properties"`
+				} `json:"put"`
+			}{}
+			if err := json.Unmarshal(body, &permissions); err != nil || len(permissions.Put) != 1 ||
+				permissions.Put[0].Properties.RoleName != "CanEdit" ||
+				permissions.Put[0].Properties.Principal.Id != "00000000-0000-0000-0000-000000000300" ||
+				permissions.Put[0].Properties.Principal.Type != "ServicePrincipal" ||
+				permissions.Put[0].Properties.NotifyShareTargetOption != "DontNotify" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			sharedApps = append(sharedApps, httpmock.MustGetSubmatch(req, 1))
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment_powerapps_co_owner" "pipeline" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					principal_id   = "00000000-0000-0000-0000-000000000300"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_environment_powerapps_co_owner.pipeline", "id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_environment_powerapps_co_owner.pipeline", "principal_type", "ServicePrincipal"),
+					resource.TestCheckResourceAttr("powerplatform_environment_powerapps_co_owner.pipeline", "app_names.#", "2"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_environment_powerapps_co_owner.pipeline", "app_names.*", "00000000-0000-0000-0000-000000000101"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_environment_powerapps_co_owner.pipeline", "app_names.*", "00000000-0000-0000-0000-000000000102"),
+				),
+			},
+			{
+				// the model-driven app owned by the leaver isn't shared.
+				Config: `
+				resource "powerplatform_environment_powerapps_co_owner" "pipeline" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					principal_id   = "00000000-0000-0000-0000-000000000300"
+					owner_id       = "00000000-0000-0000-0000-000000000201"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_environment_powerapps_co_owner.pipeline", "app_names.#", "1"),
+					resource.TestCheckTypeSetElemAttr("powerplatform_environment_powerapps_co_owner.pipeline", "app_names.*", "00000000-0000-0000-0000-000000000101"),
+					func(_ *terraform.State) error {
+						if len(sharedApps) != 3 || slices.Contains(sharedApps, "00000000-0000-0000-0000-000000000103") {
+							return fmt.Errorf("unexpected shared apps %v", sharedApps)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestUnitEnvironmentPowerAppsCoOwnerResource_Validate_Create_Partial_Failure(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerCoOwnerAppsResponders()
+
+	httpmock.RegisterResponder("POST", `https://api.powerapps.com/providers/Microsoft.PowerApps/scopes/admin/environments/00000000-0000-0000-0000-000000000001/apps/00000000-0000-0000-0000-000000000101/modifyPermissions?api-version=2022-11-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("POST", `https://api.powerapps.com/providers/Microsoft.PowerApps/scopes/admin/environments/00000000-0000-0000-0000-000000000001/apps/00000000-0000-0000-0000-000000000102/modifyPermissions?api-version=2022-11-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusForbidden, `{"error":{"code":"AppPermissionsForbidden","message":"The app is quarantined"}}`), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment_powerapps_co_owner" "pipeline" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					principal_id   = "00000000-0000-0000-0000-000000000300"
+				}`,
+				ExpectError: regexp.MustCompile(`1 of 2 apps could not be shared: app\s+'00000000-0000-0000-0000-000000000102'`),
+			},
+		},
+	})
+}
@@ -0,0 +1,126 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerautomate
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+)
+
+const (
+	FLOW_STATE_STARTED = "Started"
+	FLOW_STATE_STOPPED = "Stopped"
+
+	FLOW_ROLE_OWNER    = "Owner"
+	FLOW_ROLE_CAN_EDIT = "CanEdit"
+
+	FLOW_PRINCIPAL_TYPE_USER              = "User"
+	FLOW_PRINCIPAL_TYPE_GROUP             = "Group"
+	FLOW_PRINCIPAL_TYPE_SERVICE_PRINCIPAL = "ServicePrincipal"
+)
+
+func newPowerAutomateClient(apiClient *api.Client) client {
+	return client{
+		Api: apiClient,
+	}
+}
+
+type client struct {
+	Api *api.Client
+}
+
+// GetFlows lists the cloud flows of the environment with the admin scope of the flow API, so that the flows of all the makers are returned.
+func (client *client) buildFlowUrl(environmentId, flowName string, elements ...string) string {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.PowerAutomateUrl,
+		Path:   strings.Join(append([]string{fmt.Sprintf("/providers/Microsoft.ProcessSimple/scopes/admin/environments/%s/flows/%s", environmentId, flowName)}, elements...), "/"),
+	}
+	values := url.Values{}
+	values.Add("api-version", "2016-11-01")
+	apiUrl.RawQuery = values.Encode()
+	return apiUrl.String()
+}
+
+func (client *client) GetFlows(ctx context.Context, environmentId string) ([]flowDto, error) {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.PowerAutomateUrl,
+		Path:   fmt.Sprintf("/providers/Microsoft.ProcessSimple/scopes/admin/environments/%s/v2/flows", environmentId),
+	}
+	values := url.Values{}
+	values.Add("api-version", "2016-11-01")
+	apiUrl.RawQuery = values.Encode()
+
+	flows := make([]flowDto, 0)
+	for nextLink := apiUrl.String(); nextLink != ""; {
+		flowsArray := flowArrayDto{}
+		_, err := client.Api.Execute(ctx, nil, "GET", nextLink, nil, nil, []int{http.StatusOK}, &flowsArray)
+		if err != nil {
+			return nil, err
+		}
+		flows = append(flows, flowsArray.Value...)
+		nextLink = flowsArray.NextLink
+	}
+	return flows, nil
+}
+
+func (client *client) GetFlow(ctx context.Context, environmentId, flowName string) (*flowDto, error) {
+	flow := flowDto{}
+	_, err := client.Api.Execute(ctx, nil, "GET", client.buildFlowUrl(environmentId, flowName), nil, nil, []int{http.StatusOK}, &flow)
+	if err != nil {
+		return nil, err
+	}
+	return &flow, nil
+}
+
+// SetFlowState starts or stops the flow, the flow API returning once the state of the flow has changed.
+func (client *client) SetFlowState(ctx context.Context, environmentId, flowName, state string) (*flowDto, error) {
+	action := "start"
+	if state == FLOW_STATE_STOPPED {
+		action = "stop"
+	}
+	_, err := client.Api.Execute(ctx, nil, "POST", client.buildFlowUrl(environmentId, flowName, action), nil, nil, []int{http.StatusOK, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetFlow(ctx, environmentId, flowName)
+}
+
+func (client *client) GetFlowPermissions(ctx context.Context, environmentId, flowName string) ([]flowPermissionDto, error) {
+	permissions := flowPermissionArrayDto{}
+	_, err := client.Api.Execute(ctx, nil, "GET", client.buildFlowUrl(environmentId, flowName, "permissions"), nil, nil, []int{http.StatusOK}, &permissions)
+	if err != nil {
+		return nil, err
+	}
+	return permissions.Value, nil
+}
+
+// ModifyFlowOwners makes the principals to add co-owners of the flow and removes the co-owner permissions with the ids to remove.
+func (client *client) ModifyFlowOwners(ctx context.Context, environmentId, flowName string, ownersToAdd []flowPrincipalDto, permissionIdsToRemove []string) error {
+	modification := flowModifyPermissionsDto{
+		Put:    make([]flowPermissionDto, 0, len(ownersToAdd)),
+		Delete: make([]flowPermissionIdDto, 0, len(permissionIdsToRemove)),
+	}
+	for _, principal := range ownersToAdd {
+		modification.Put = append(modification.Put, flowPermissionDto{
+			Properties: flowPermissionPropertiesDto{
+				RoleName:  FLOW_ROLE_CAN_EDIT,
+				Principal: principal,
+			},
+		})
+	}
+	for _, permissionId := range permissionIdsToRemove {
+		modification.Delete = append(modification.Delete, flowPermissionIdDto{Id: permissionId})
+	}
+
+	_, err := client.Api.Execute(ctx, nil, "POST", client.buildFlowUrl(environmentId, flowName, "modifyPermissions"), nil, modification, []int{http.StatusOK, http.StatusNoContent}, nil)
+	return err
+}
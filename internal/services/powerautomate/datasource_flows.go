@@ -0,0 +1,142 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerautomate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var (
+	_ datasource.DataSource              = &FlowsDataSource{}
+	_ datasource.DataSourceWithConfigure = &FlowsDataSource{}
+)
+
+func NewFlowsDataSource() datasource.DataSource {
+	return &FlowsDataSource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "flows",
+		},
+	}
+}
+
+func (d *FlowsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *FlowsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the list of Power Automate cloud flows in an environment, to audit the automations of the environment. The flows of all the makers are listed, which requires an administrator of the environment. See [Manage Power Automate](https://learn.microsoft.com/power-platform/admin/power-automate-licensing/manage-flows) for more details about how this data is surfaced in Power Platform Admin Center.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Read: true,
+			}),
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment whose flows are listed",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "environment_id must be a valid environment id guid"),
+				},
+			},
+			"flows": schema.ListNestedAttribute{
+				MarkdownDescription: "List of cloud flows",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Unique name (guid) of the flow",
+							Computed:            true,
+						},
+						"display_name": schema.StringAttribute{
+							MarkdownDescription: "Display name",
+							Computed:            true,
+						},
+						"state": schema.StringAttribute{
+							MarkdownDescription: "State of the flow, `Started` or `Stopped`",
+							Computed:            true,
+						},
+						"created_time": schema.StringAttribute{
+							MarkdownDescription: "Created time",
+							Computed:            true,
+						},
+						"last_modified_time": schema.StringAttribute{
+							MarkdownDescription: "Last modified time",
+							Computed:            true,
+						},
+						"owner_id": schema.StringAttribute{
+							MarkdownDescription: "Entra object id of the owner of the flow",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *FlowsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.PowerAutomateClient = newPowerAutomateClient(client.Api)
+}
+
+func (d *FlowsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var state FlowsListDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flows, err := d.PowerAutomateClient.GetFlows(ctx, state.EnvironmentId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+
+	state.Flows = make([]FlowDataSourceModel, 0, len(flows))
+	for _, flow := range flows {
+		state.Flows = append(state.Flows, convertFromFlowDto(flow))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,95 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerautomate_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccFlowsDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+				}
+
+				data "powerplatform_flows" "all" {
+					environment_id = powerplatform_environment.env.id
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.powerplatform_flows.all", "flows.#", regexp.MustCompile(`^\d+$`)),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitFlowsDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.flow.microsoft.com/providers/Microsoft.ProcessSimple/scopes/admin/environments/00000000-0000-0000-0000-000000000001/v2/flows?api-version=2016-11-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/Validate_Read/get_flows_page_1.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.flow.microsoft.com/providers/Microsoft.ProcessSimple/scopes/admin/environments/00000000-0000-0000-0000-000000000001/v2/flows?%24skiptoken=page2&api-version=2016-11-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/Validate_Read/get_flows_page_2.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_flows" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_flows.all", "flows.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_flows.all", "flows.0.name", "00000000-0000-0000-0000-000000000101"),
+					resource.TestCheckResourceAttr("data.powerplatform_flows.all", "flows.0.display_name", "Notify new accounts"),
+					resource.TestCheckResourceAttr("data.powerplatform_flows.all", "flows.0.state", "Started"),
+					resource.TestCheckResourceAttr("data.powerplatform_flows.all", "flows.0.created_time", "2026-03-02T10:12:45.1234567Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_flows.all", "flows.0.last_modified_time", "2026-09-30T08:01:12.7654321Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_flows.all", "flows.0.owner_id", "00000000-0000-0000-0000-000000000201"),
+					resource.TestMatchResourceAttr("data.powerplatform_flows.all", "flows.1.name", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("data.powerplatform_flows.all", "flows.1.state", "Stopped"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitFlowsDataSource_Validate_Invalid_Environment_Id(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_flows" "all" {
+					environment_id = "not-a-guid"
+				}`,
+				ExpectError: regexp.MustCompile(`environment_id must be a valid environment id guid`),
+			},
+		},
+	})
+}
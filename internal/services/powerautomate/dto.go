@@ -0,0 +1,58 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerautomate
+
+type flowDto struct {
+	Name       string            `json:"name"`
+	Properties flowPropertiesDto `json:"properties"`
+}
+
+type flowPropertiesDto struct {
+	DisplayName      string         `json:"displayName"`
+	State            string         `json:"state"`
+	CreatedTime      string         `json:"createdTime"`
+	LastModifiedTime string         `json:"lastModifiedTime"`
+	Creator          flowCreatorDto `json:"creator"`
+}
+
+type flowCreatorDto struct {
+	TenantId string `json:"tenantId"`
+	ObjectId string `json:"objectId"`
+	UserId   string `json:"userId"`
+	UserType string `json:"userType"`
+}
+
+type flowPermissionDto struct {
+	Id         string                      `json:"id,omitempty"`
+	Name       string                      `json:"name,omitempty"`
+	Properties flowPermissionPropertiesDto `json:"properties"`
+}
+
+type flowPermissionPropertiesDto struct {
+	RoleName  string           `json:"roleName"`
+	Principal flowPrincipalDto `json:"principal"`
+}
+
+type flowPrincipalDto struct {
+	Id   string `json:"id"`
+	Type string `json:"type"`
+}
+
+type flowPermissionArrayDto struct {
+	Value []flowPermissionDto `json:"value"`
+}
+
+type flowPermissionIdDto struct {
+	Id string `json:"id"`
+}
+
+type flowModifyPermissionsDto struct {
+	Put    []flowPermissionDto   `json:"put"`
+	Delete []flowPermissionIdDto `json:"delete"`
+}
+
+type flowArrayDto struct {
+	Value    []flowDto `json:"value"`
+	NextLink string    `json:"nextLink,omitempty"`
+}
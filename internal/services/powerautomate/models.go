@@ -0,0 +1,68 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerautomate
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+type FlowsDataSource struct {
+	helpers.TypeInfo
+	PowerAutomateClient client
+}
+
+type FlowStateResource struct {
+	helpers.TypeInfo
+	PowerAutomateClient client
+}
+
+type FlowStateResourceModel struct {
+	Timeouts      timeouts.Value   `tfsdk:"timeouts"`
+	Id            types.String     `tfsdk:"id"`
+	EnvironmentId types.String     `tfsdk:"environment_id"`
+	FlowName      types.String     `tfsdk:"flow_name"`
+	State         types.String     `tfsdk:"state"`
+	Owners        []FlowOwnerModel `tfsdk:"owners"`
+	DisplayName   types.String     `tfsdk:"display_name"`
+	CreatorId     types.String     `tfsdk:"creator_id"`
+}
+
+type FlowOwnerModel struct {
+	PrincipalId   types.String `tfsdk:"principal_id"`
+	PrincipalType types.String `tfsdk:"principal_type"`
+}
+
+type FlowsListDataSourceModel struct {
+	Timeouts      timeouts.Value        `tfsdk:"timeouts"`
+	EnvironmentId types.String          `tfsdk:"environment_id"`
+	Flows         []FlowDataSourceModel `tfsdk:"flows"`
+}
+
+type FlowDataSourceModel struct {
+	Name             types.String `tfsdk:"name"`
+	DisplayName      types.String `tfsdk:"display_name"`
+	State            types.String `tfsdk:"state"`
+	CreatedTime      types.String `tfsdk:"created_time"`
+	LastModifiedTime types.String `tfsdk:"last_modified_time"`
+	OwnerId          types.String `tfsdk:"owner_id"`
+}
+
+func convertFromFlowDto(flowDto flowDto) FlowDataSourceModel {
+	return FlowDataSourceModel{
+		Name:             types.StringValue(flowDto.Name),
+		DisplayName:      types.StringValue(flowDto.Properties.DisplayName),
+		State:            types.StringValue(flowDto.Properties.State),
+		CreatedTime:      types.StringValue(flowDto.Properties.CreatedTime),
+		LastModifiedTime: types.StringValue(flowDto.Properties.LastModifiedTime),
+		OwnerId:          types.StringValue(flowDto.Properties.Creator.ObjectId),
+	}
+}
+
+func convertFromFlowDtoToStateModel(model *FlowStateResourceModel, flowDto *flowDto) {
+	model.State = types.StringValue(flowDto.Properties.State)
+	model.DisplayName = types.StringValue(flowDto.Properties.DisplayName)
+	model.CreatorId = types.StringValue(flowDto.Properties.Creator.ObjectId)
+}
@@ -0,0 +1,334 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerautomate
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &FlowStateResource{}
+var _ resource.ResourceWithImportState = &FlowStateResource{}
+
+func NewFlowStateResource() resource.Resource {
+	return &FlowStateResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "flow_state",
+		},
+	}
+}
+
+func (r *FlowStateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *FlowStateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the state and the co-owners of an existing Power Automate cloud flow, for example to make sure that the critical flows of a solution are turned on after the solution is deployed. Destroying the resource leaves the flow in its current state and keeps its co-owners.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the flow state, same as `flow_name`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment where the flow is located",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "environment_id must be a valid environment id guid"),
+				},
+			},
+			"flow_name": schema.StringAttribute{
+				MarkdownDescription: "Unique name (guid) of the flow, as listed by the `powerplatform_flows` data source",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "flow_name must be a valid guid"),
+				},
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: fmt.Sprintf("State of the flow. Valid values are `%s` and `%s`", FLOW_STATE_STARTED, FLOW_STATE_STOPPED),
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(FLOW_STATE_STARTED, FLOW_STATE_STOPPED),
+				},
+			},
+			"owners": schema.SetNestedAttribute{
+				MarkdownDescription: "Principals that are co-owners of the flow. Principals removed from the set lose their co-ownership, while the co-owners of the flow that are not in the set are left unchanged",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"principal_id": schema.StringAttribute{
+							MarkdownDescription: "Entra object id of the user, group or service principal",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "principal_id must be a valid object id guid"),
+							},
+						},
+						"principal_type": schema.StringAttribute{
+							MarkdownDescription: fmt.Sprintf("Type of the principal. Valid values are `%s`, `%s` and `%s`", FLOW_PRINCIPAL_TYPE_USER, FLOW_PRINCIPAL_TYPE_GROUP, FLOW_PRINCIPAL_TYPE_SERVICE_PRINCIPAL),
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(FLOW_PRINCIPAL_TYPE_USER, FLOW_PRINCIPAL_TYPE_GROUP, FLOW_PRINCIPAL_TYPE_SERVICE_PRINCIPAL),
+							},
+						},
+					},
+				},
+			},
+			"display_name": schema.StringAttribute{
+				MarkdownDescription: "Display name of the flow",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"creator_id": schema.StringAttribute{
+				MarkdownDescription: "Entra object id of the creator of the flow",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *FlowStateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.PowerAutomateClient = newPowerAutomateClient(client.Api)
+}
+
+func (r *FlowStateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *FlowStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flow, err := r.applyFlowState(ctx, plan, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	plan.Id = plan.FlowName
+	convertFromFlowDtoToStateModel(plan, flow)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *FlowStateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *FlowStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flow, err := r.PowerAutomateClient.GetFlow(ctx, state.EnvironmentId.ValueString(), state.FlowName.ValueString())
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	if state.Owners != nil {
+		permissions, err := r.PowerAutomateClient.GetFlowPermissions(ctx, state.EnvironmentId.ValueString(), state.FlowName.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+			return
+		}
+
+		// only the managed co-owners are refreshed, so that a co-owner removed outside of Terraform is added back by the next apply.
+		owners := make([]FlowOwnerModel, 0, len(state.Owners))
+		for _, owner := range state.Owners {
+			if len(findOwnerPermissions(permissions, owner.PrincipalId.ValueString(), false)) > 0 {
+				owners = append(owners, owner)
+			}
+		}
+		state.Owners = owners
+	}
+
+	convertFromFlowDtoToStateModel(state, flow)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *FlowStateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *FlowStateResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *FlowStateResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	flow, err := r.applyFlowState(ctx, plan, state.Owners)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromFlowDtoToStateModel(plan, flow)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *FlowStateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// The flow is not owned by the resource, so it is left in its current state and only removed from the state.
+	tflog.Debug(ctx, fmt.Sprintf("%s removed from state, flow state and co-owners are left unchanged", r.FullTypeName()))
+}
+
+func (r *FlowStateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	environmentId, flowName, found := strings.Cut(req.ID, "/")
+	guidRegex := regexp.MustCompile(helpers.GuidRegex)
+	if !found || !guidRegex.MatchString(environmentId) || !guidRegex.MatchString(flowName) {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>/<flow_name>, where both parts are guids. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), flowName)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("flow_name"), flowName)...)
+}
+
+// applyFlowState starts or stops the flow when its state differs from the plan, then adds the planned co-owners
+// and removes the previously managed co-owners that are no longer planned.
+func (r *FlowStateResource) applyFlowState(ctx context.Context, plan *FlowStateResourceModel, previousOwners []FlowOwnerModel) (*flowDto, error) {
+	environmentId, flowName := plan.EnvironmentId.ValueString(), plan.FlowName.ValueString()
+
+	flow, err := r.PowerAutomateClient.GetFlow(ctx, environmentId, flowName)
+	if err != nil {
+		return nil, err
+	}
+	if flow.Properties.State != plan.State.ValueString() {
+		flow, err = r.PowerAutomateClient.SetFlowState(ctx, environmentId, flowName, plan.State.ValueString())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if plan.Owners == nil && previousOwners == nil {
+		return flow, nil
+	}
+
+	permissions, err := r.PowerAutomateClient.GetFlowPermissions(ctx, environmentId, flowName)
+	if err != nil {
+		return nil, err
+	}
+
+	ownersToAdd := make([]flowPrincipalDto, 0)
+	for _, owner := range plan.Owners {
+		if len(findOwnerPermissions(permissions, owner.PrincipalId.ValueString(), false)) == 0 {
+			ownersToAdd = append(ownersToAdd, flowPrincipalDto{Id: owner.PrincipalId.ValueString(), Type: owner.PrincipalType.ValueString()})
+		}
+	}
+
+	permissionIdsToRemove := make([]string, 0)
+	for _, owner := range previousOwners {
+		if !hasOwner(plan.Owners, owner.PrincipalId.ValueString()) {
+			permissionIdsToRemove = append(permissionIdsToRemove, findOwnerPermissions(permissions, owner.PrincipalId.ValueString(), true)...)
+		}
+	}
+
+	if len(ownersToAdd) == 0 && len(permissionIdsToRemove) == 0 {
+		return flow, nil
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Adding %d and removing %d co-owners of flow '%s'", len(ownersToAdd), len(permissionIdsToRemove), flowName))
+	if err := r.PowerAutomateClient.ModifyFlowOwners(ctx, environmentId, flowName, ownersToAdd, permissionIdsToRemove); err != nil {
+		return nil, err
+	}
+	return flow, nil
+}
+
+// findOwnerPermissions returns the ids of the permissions that make the principal an owner of the flow.
+// The permission of the creator of the flow is not returned when only the co-owner permissions are requested, so that it's never removed.
+func findOwnerPermissions(permissions []flowPermissionDto, principalId string, coOwnersOnly bool) []string {
+	permissionIds := make([]string, 0)
+	for _, permission := range permissions {
+		if !strings.EqualFold(permission.Properties.Principal.Id, principalId) {
+			continue
+		}
+		if permission.Properties.RoleName == FLOW_ROLE_CAN_EDIT || (!coOwnersOnly && permission.Properties.RoleName == FLOW_ROLE_OWNER) {
+			permissionIds = append(permissionIds, permission.Name)
+		}
+	}
+	return permissionIds
+}
+
+func hasOwner(owners []FlowOwnerModel, principalId string) bool {
+	for _, owner := range owners {
+		if strings.EqualFold(owner.PrincipalId.ValueString(), principalId) {
+			return true
+		}
+	}
+	return false
+}
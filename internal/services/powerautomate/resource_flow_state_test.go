@@ -0,0 +1,191 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerautomate_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestUnitFlowStateResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	const flowUrl = "https://api.flow.microsoft.com/providers/Microsoft.ProcessSimple/scopes/admin/environments/00000000-0000-0000-0000-000000000001/flows/00000000-0000-0000-0000-000000000101"
+
+	flowState := "Stopped"
+	permissions := map[string]string{
+		"00000000-0000-0000-0000-000000000201": "Owner",
+		"00000000-0000-0000-0000-000000000202": "CanEdit",
+	}
+	modifications := []string{}
+
+	httpmock.RegisterResponder("GET", flowUrl+"?api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{
+				"name": "00000000-0000-0000-0000-000000000101",
+				"properties": {
+					"displayName": "Notify new accounts",
+					"state": "%s",
+					"creator": { "objectId": "00000000-0000-0000-0000-000000000201" }
+				}
+			}`, flowState)), nil
+		})
+
+	httpmock.RegisterResponder("POST", flowUrl+"/start?api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			flowState = "Started"
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("POST", flowUrl+"/stop?api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			flowState = "Stopped"
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", flowUrl+"/permissions?api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			values := []string{}
+			for principalId, roleName := range permissions {
+				values = append(values, fmt.Sprintf(`{"name":"%s","properties":{"roleName":"%s","principal":{"id":"%s","type":"User"}}}`, principalId, roleName, principalId))
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"value":[`+strings.Join(values, ",")+`]}`), nil
+		})
+
+	httpmock.RegisterResponder("POST", flowUrl+"/modifyPermissions?api-version=2016-11-01",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			modification := struct {
+				Put []struct {
+					Properties struct {
+						RoleName  string `json:"roleName"`
+						Principal struct {
+							Id   string `json:"id"`
+							Type string `json:"type"`
+						} `json:"principal"`
+					} `json:"properties"`
+				} `json:"put"`
+				Delete []struct {
+					Id string `json:"id"`
+				} `json:"delete"`
+			}{}
+			if err := json.Unmarshal(body, &modification); err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			for _, put := range modification.Put {
+				permissions[put.Properties.Principal.Id] = put.Properties.RoleName
+				modifications = append(modifications, fmt.Sprintf("put %s %s %s", put.Properties.Principal.Id, put.Properties.Principal.Type, put.Properties.RoleName))
+			}
+			for _, del := range modification.Delete {
+				delete(permissions, del.Id)
+				modifications = append(modifications, "delete "+del.Id)
+			}
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_flow_state" "notify" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					flow_name      = "00000000-0000-0000-0000-000000000101"
+					state          = "Started"
+					owners = [
+						{
+							principal_id   = "00000000-0000-0000-0000-000000000202"
+							principal_type = "User"
+						},
+						{
+							principal_id   = "00000000-0000-0000-0000-000000000301"
+							principal_type = "ServicePrincipal"
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_flow_state.notify", "id", "00000000-0000-0000-0000-000000000101"),
+					resource.TestCheckResourceAttr("powerplatform_flow_state.notify", "state", "Started"),
+					resource.TestCheckResourceAttr("powerplatform_flow_state.notify", "display_name", "Notify new accounts"),
+					resource.TestCheckResourceAttr("powerplatform_flow_state.notify", "creator_id", "00000000-0000-0000-0000-000000000201"),
+					resource.TestCheckResourceAttr("powerplatform_flow_state.notify", "owners.#", "2"),
+					func(_ *terraform.State) error {
+						// the existing co-owner is not added again.
+						if len(modifications) != 1 || modifications[0] != "put 00000000-0000-0000-0000-000000000301 ServicePrincipal CanEdit" {
+							return fmt.Errorf("unexpected modifications of the permissions %v", modifications)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_flow_state" "notify" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					flow_name      = "00000000-0000-0000-0000-000000000101"
+					state          = "Stopped"
+					owners = [
+						{
+							principal_id   = "00000000-0000-0000-0000-000000000202"
+							principal_type = "User"
+						},
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_flow_state.notify", "state", "Stopped"),
+					resource.TestCheckResourceAttr("powerplatform_flow_state.notify", "owners.#", "1"),
+					func(_ *terraform.State) error {
+						if len(modifications) != 2 || modifications[1] != "delete 00000000-0000-0000-0000-000000000301" {
+							return fmt.Errorf("unexpected modifications of the permissions %v", modifications)
+						}
+						if permissions["00000000-0000-0000-0000-000000000201"] != "Owner" {
+							return fmt.Errorf("expected the creator to stay the owner of the flow, got %v", permissions)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "powerplatform_flow_state.notify",
+				ImportState:             true,
+				ImportStateId:           "00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000101",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"owners"},
+			},
+		},
+	})
+}
+
+func TestUnitFlowStateResource_Validate_Import_Invalid_Id(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_flow_state" "notify" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					flow_name      = "00000000-0000-0000-0000-000000000101"
+					state          = "Started"
+				}`,
+				ResourceName:  "powerplatform_flow_state.notify",
+				ImportState:   true,
+				ImportStateId: "00000000-0000-0000-0000-000000000101",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
@@ -0,0 +1,435 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerpages
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment"
+)
+
+const (
+	WEBSITE_OPERATION_STATUS_SUCCEEDED       = "Succeeded"
+	WEBSITE_OPERATION_STATUS_FAILED          = "Failed"
+	WEBSITE_OPERATION_STATUS_CANCELED        = "Canceled"
+	WEBSITE_PACKAGE_INSTALL_STATUS_INSTALLED = "Installed"
+	WEBSITE_STATUS_ACTIVE                    = "Active"
+
+	WAF_STATUS_CREATING = "Creating"
+	WAF_STATUS_CREATED  = "Created"
+
+	WAF_RULE_STATE_ENABLED  = "Enabled"
+	WAF_RULE_STATE_DISABLED = "Disabled"
+)
+
+// TENANT_WEBSITES_MAX_CONCURRENCY limits how many environments are queried in parallel when listing the websites of the tenant.
+const TENANT_WEBSITES_MAX_CONCURRENCY = 5
+
+func newPowerPagesClient(apiClient *api.Client) client {
+	return client{
+		Api:               apiClient,
+		environmentClient: environment.NewEnvironmentClient(apiClient),
+	}
+}
+
+type client struct {
+	Api               *api.Client
+	environmentClient environment.Client
+}
+
+func (client *client) buildWebsitesUrl(ctx context.Context, environmentId string, elements ...string) string {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.PowerPlatformUrl,
+		Path:   strings.Join(append([]string{fmt.Sprintf("/powerpages/environments/%s/websites", environmentId)}, elements...), "/"),
+	}
+	values := url.Values{}
+	values.Add(constants.API_VERSION_PARAM, client.Api.GetApiVersion(ctx, api.API_SERVICE_POWER_PAGES))
+	apiUrl.RawQuery = values.Encode()
+	return apiUrl.String()
+}
+
+func (client *client) GetWebsites(ctx context.Context, environmentId string) ([]websiteDto, error) {
+	websites := websiteArrayDto{}
+	_, err := client.Api.Execute(ctx, nil, "GET", client.buildWebsitesUrl(ctx, environmentId), nil, nil, []int{http.StatusOK}, &websites)
+	if err != nil {
+		return nil, err
+	}
+	return websites.Value, nil
+}
+
+// GetTenantWebsites lists the websites of every environment in the tenant that has Dataverse.
+// Environments are queried in parallel with at most TENANT_WEBSITES_MAX_CONCURRENCY requests in flight.
+// Environments that could not be queried are returned in the error map keyed by environment id instead of failing the whole listing.
+func (client *client) GetTenantWebsites(ctx context.Context) ([]websiteDto, map[string]error, error) {
+	envs, err := client.environmentClient.GetEnvironments(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make([][]websiteDto, len(envs))
+	errs := make([]error, len(envs))
+
+	semaphore := make(chan struct{}, TENANT_WEBSITES_MAX_CONCURRENCY)
+	var wg sync.WaitGroup
+	for i, env := range envs {
+		if env.Properties == nil || env.Properties.LinkedEnvironmentMetadata == nil {
+			tflog.Debug(ctx, fmt.Sprintf("Environment '%s' has no Dataverse, skipping websites", env.Name))
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, environmentId string) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			results[i], errs[i] = client.GetWebsites(ctx, environmentId)
+		}(i, env.Name)
+	}
+	wg.Wait()
+
+	websites := make([]websiteDto, 0)
+	failedEnvironments := map[string]error{}
+	for i, env := range envs {
+		if errs[i] != nil {
+			failedEnvironments[env.Name] = errs[i]
+			continue
+		}
+		websites = append(websites, results[i]...)
+	}
+	return websites, failedEnvironments, nil
+}
+
+func (client *client) GetWebsite(ctx context.Context, environmentId, websiteId string) (*websiteDto, error) {
+	website := websiteDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", client.buildWebsitesUrl(ctx, environmentId, websiteId), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &website)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("website '%s' not found", websiteId))
+	}
+	return &website, nil
+}
+
+// CreateWebsite starts the provisioning of a new website and waits until the provisioning operation has finished and the website package is installed.
+// When the website has been created but its provisioning failed, the website is returned together with the error.
+func (client *client) CreateWebsite(ctx context.Context, environmentId string, websiteToCreate createWebsiteDto) (*websiteDto, error) {
+	env, err := client.environmentClient.GetEnvironment(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+	if env.Properties.LinkedEnvironmentMetadata == nil {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_ENVIRONMENT_URL_NOT_FOUND, fmt.Sprintf("environment '%s' has no Dataverse, which is required for Power Pages websites", environmentId))
+	}
+	websiteToCreate.DataverseOrganizationId = env.Properties.LinkedEnvironmentMetadata.ResourceId
+
+	response, err := client.Api.Execute(ctx, nil, "POST", client.buildWebsitesUrl(ctx, environmentId), nil, websiteToCreate, []int{http.StatusAccepted}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	operationLocation := response.GetHeader(constants.HEADER_OPERATION_LOCATION)
+	if operationLocation == "" {
+		operationLocation = response.GetHeader(constants.HEADER_LOCATION)
+	}
+	if operationLocation != "" {
+		err = client.waitForWebsiteOperation(ctx, operationLocation, websiteToCreate.Name)
+	}
+
+	website, findErr := client.findWebsiteBySubdomain(ctx, environmentId, websiteToCreate.Subdomain)
+	if findErr != nil {
+		return nil, findErr
+	}
+	if err != nil {
+		return website, err
+	}
+
+	return client.waitForWebsitePackageInstall(ctx, environmentId, website)
+}
+
+// waitForWebsiteOperation polls the long-running operation returned when a website is created until it reaches a terminal state.
+func (client *client) waitForWebsiteOperation(ctx context.Context, operationLocation, websiteName string) error {
+	tflog.Debug(ctx, "Website operation location: "+operationLocation)
+
+	progress := client.Api.NewOperationProgress(fmt.Sprintf("Creation of website '%s'", websiteName))
+	for {
+		operation := websiteOperationDto{}
+		_, err := client.Api.Execute(ctx, nil, "GET", operationLocation, nil, nil, []int{http.StatusOK, http.StatusAccepted}, &operation)
+		if err != nil {
+			return err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Website operation status: '%s'", operation.Status))
+		progress.Report(ctx, operation.Status, -1)
+
+		switch {
+		case strings.EqualFold(operation.Status, WEBSITE_OPERATION_STATUS_SUCCEEDED):
+			return nil
+		case strings.EqualFold(operation.Status, WEBSITE_OPERATION_STATUS_FAILED), strings.EqualFold(operation.Status, WEBSITE_OPERATION_STATUS_CANCELED):
+			if operation.Error != nil {
+				return fmt.Errorf("creation of website '%s' ended with status '%s': %s %s", websiteName, operation.Status, operation.Error.Code, operation.Error.Message)
+			}
+			return fmt.Errorf("creation of website '%s' ended with status '%s'", websiteName, operation.Status)
+		}
+
+		err = client.Api.SleepWithContext(ctx, api.DefaultRetryAfter())
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// findWebsiteBySubdomain waits until a newly created website is listed in the environment.
+func (client *client) findWebsiteBySubdomain(ctx context.Context, environmentId, subdomain string) (*websiteDto, error) {
+	for {
+		websites, err := client.GetWebsites(ctx, environmentId)
+		if err != nil {
+			return nil, err
+		}
+		for _, website := range websites {
+			if strings.EqualFold(website.Subdomain, subdomain) {
+				return &website, nil
+			}
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Website with subdomain '%s' not listed yet, polling...", subdomain))
+
+		err = client.Api.SleepWithContext(ctx, api.DefaultRetryAfter())
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// waitForWebsitePackageInstall polls the website until the installation of its Power Pages package has succeeded or failed.
+func (client *client) waitForWebsitePackageInstall(ctx context.Context, environmentId string, website *websiteDto) (*websiteDto, error) {
+	progress := client.Api.NewOperationProgress(fmt.Sprintf("Package installation of website '%s'", website.Name))
+	for {
+		if isPackageInstallSucceeded(website.PackageInstallStatus) {
+			return website, nil
+		}
+		if isPackageInstallFailed(website.PackageInstallStatus) {
+			return website, fmt.Errorf("package installation of website '%s' failed with status '%s'", website.Name, website.PackageInstallStatus)
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Website '%s' package install status is '%s', polling...", website.Id, website.PackageInstallStatus))
+		progress.Report(ctx, website.PackageInstallStatus, -1)
+
+		err := client.Api.SleepWithContext(ctx, api.DefaultRetryAfter())
+		if err != nil {
+			return website, err
+		}
+
+		website, err = client.GetWebsite(ctx, environmentId, website.Id)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+func isPackageInstallSucceeded(status string) bool {
+	return strings.EqualFold(status, WEBSITE_PACKAGE_INSTALL_STATUS_INSTALLED) || strings.EqualFold(status, WEBSITE_OPERATION_STATUS_SUCCEEDED)
+}
+
+func isPackageInstallFailed(status string) bool {
+	return strings.Contains(strings.ToLower(status), "fail")
+}
+
+// UpdateWebsite changes the mutable properties of a website, such as its name, and waits until the change is reported by the website.
+func (client *client) UpdateWebsite(ctx context.Context, environmentId, websiteId string, websiteToUpdate updateWebsiteDto) (*websiteDto, error) {
+	_, err := client.Api.Execute(ctx, nil, "PATCH", client.buildWebsitesUrl(ctx, environmentId, websiteId), nil, websiteToUpdate, []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := client.Api.NewOperationProgress(fmt.Sprintf("Update of website '%s'", websiteId))
+	for {
+		website, err := client.GetWebsite(ctx, environmentId, websiteId)
+		if err != nil {
+			return nil, err
+		}
+		if website.Name == websiteToUpdate.Name {
+			return website, nil
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Website '%s' name is '%s', waiting for '%s'...", websiteId, website.Name, websiteToUpdate.Name))
+		progress.Report(ctx, "Updating", -1)
+
+		err = client.Api.SleepWithContext(ctx, api.DefaultRetryAfter())
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// DeleteWebsite starts the deletion of a website and waits until it no longer resolves.
+func (client *client) DeleteWebsite(ctx context.Context, environmentId, websiteId string) error {
+	_, err := client.Api.Execute(ctx, nil, "DELETE", client.buildWebsitesUrl(ctx, environmentId, websiteId), nil, nil, []int{http.StatusAccepted, http.StatusNoContent}, nil)
+	if err != nil {
+		return err
+	}
+
+	progress := client.Api.NewOperationProgress(fmt.Sprintf("Deletion of website '%s'", websiteId))
+	for {
+		_, err := client.GetWebsite(ctx, environmentId, websiteId)
+		if customerrors.Code(err) == customerrors.ERROR_OBJECT_NOT_FOUND {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Website '%s' still exists, polling...", websiteId))
+		progress.Report(ctx, "Deleting", -1)
+
+		err = client.Api.SleepWithContext(ctx, api.DefaultRetryAfter())
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// UpdateSiteVisibility switches a website between private and public visibility and waits until the change is reported by the website.
+func (client *client) UpdateSiteVisibility(ctx context.Context, environmentId, websiteId, siteVisibility string) (*websiteDto, error) {
+	_, err := client.Api.Execute(ctx, nil, "POST", client.buildWebsitesUrl(ctx, environmentId, websiteId, "updateSiteVisibility"), nil, updateSiteVisibilityDto{SiteVisibility: siteVisibility}, []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := client.Api.NewOperationProgress(fmt.Sprintf("Visibility change of website '%s'", websiteId))
+	for {
+		website, err := client.GetWebsite(ctx, environmentId, websiteId)
+		if err != nil {
+			return nil, err
+		}
+		if strings.EqualFold(website.SiteVisibility, siteVisibility) {
+			return website, nil
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Website '%s' visibility is '%s', waiting for '%s'...", websiteId, website.SiteVisibility, siteVisibility))
+		progress.Report(ctx, "Updating", -1)
+
+		err = client.Api.SleepWithContext(ctx, api.DefaultRetryAfter())
+		if err != nil {
+			return nil, err
+		}
+	}
+}
+
+// GetWafStatus returns the status of the web application firewall of a website, such as `Created` once the firewall is enabled.
+func (client *client) GetWafStatus(ctx context.Context, environmentId, websiteId string) (string, error) {
+	status := wafStatusDto{}
+	_, err := client.Api.Execute(ctx, nil, "GET", client.buildWebsitesUrl(ctx, environmentId, websiteId, "getWafStatus"), nil, nil, []int{http.StatusOK}, &status)
+	if err != nil {
+		return "", err
+	}
+	return status.Status, nil
+}
+
+// EnableWaf enables the web application firewall of a website, when it is not enabled yet, and waits until it has been created.
+func (client *client) EnableWaf(ctx context.Context, environmentId, websiteId string) error {
+	status, err := client.GetWafStatus(ctx, environmentId, websiteId)
+	if err != nil {
+		return err
+	}
+	if strings.EqualFold(status, WAF_STATUS_CREATED) {
+		return nil
+	}
+
+	if !strings.EqualFold(status, WAF_STATUS_CREATING) {
+		_, err = client.Api.Execute(ctx, nil, "POST", client.buildWebsitesUrl(ctx, environmentId, websiteId, "enableWaf"), nil, nil, []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent}, nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	progress := client.Api.NewOperationProgress(fmt.Sprintf("Enabling of the web application firewall of website '%s'", websiteId))
+	for {
+		status, err := client.GetWafStatus(ctx, environmentId, websiteId)
+		if err != nil {
+			return err
+		}
+		if strings.EqualFold(status, WAF_STATUS_CREATED) {
+			return nil
+		}
+		if strings.Contains(strings.ToLower(status), "fail") {
+			return fmt.Errorf("enabling the web application firewall of website '%s' failed with status '%s'", websiteId, status)
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Website '%s' web application firewall status is '%s', polling...", websiteId, status))
+		progress.Report(ctx, status, -1)
+
+		err = client.Api.SleepWithContext(ctx, api.DefaultRetryAfter())
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (client *client) GetWafRules(ctx context.Context, environmentId, websiteId string) (*wafRulesDto, error) {
+	rules := wafRulesDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", client.buildWebsitesUrl(ctx, environmentId, websiteId, "getWafRules"), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &rules)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("web application firewall rules of website '%s' not found", websiteId))
+	}
+	return &rules, nil
+}
+
+// SetWafRules replaces the custom rules of a website and the exclusions of each of its managed rule sets.
+// Custom rules that exist on the website but are not part of customRules are deleted.
+func (client *client) SetWafRules(ctx context.Context, environmentId, websiteId string, customRules []wafCustomRuleDto, exclusions []wafManagedRuleExclusionDto) (*wafRulesDto, error) {
+	existing, err := client.GetWafRules(ctx, environmentId, websiteId)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := wafRulesDto{
+		ManagedRules: existing.ManagedRules,
+		CustomRules:  customRules,
+	}
+	if rules.CustomRules == nil {
+		rules.CustomRules = []wafCustomRuleDto{}
+	}
+	if exclusions == nil {
+		exclusions = []wafManagedRuleExclusionDto{}
+	}
+	for i := range rules.ManagedRules {
+		rules.ManagedRules[i].Exclusions = exclusions
+	}
+
+	_, err = client.Api.Execute(ctx, nil, "PUT", client.buildWebsitesUrl(ctx, environmentId, websiteId, "createWafRules"), nil, rules, []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	rulesToDelete := make([]string, 0)
+	for _, rule := range existing.CustomRules {
+		if !slices.ContainsFunc(customRules, func(r wafCustomRuleDto) bool { return r.Name == rule.Name }) {
+			rulesToDelete = append(rulesToDelete, rule.Name)
+		}
+	}
+	if len(rulesToDelete) > 0 {
+		_, err = client.Api.Execute(ctx, nil, "DELETE", client.buildWebsitesUrl(ctx, environmentId, websiteId, "deleteWafCustomRules"), nil, rulesToDelete, []int{http.StatusOK, http.StatusAccepted, http.StatusNoContent}, nil)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return client.GetWafRules(ctx, environmentId, websiteId)
+}
@@ -0,0 +1,135 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerpages
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var (
+	_ datasource.DataSource              = &WebsiteStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &WebsiteStatusDataSource{}
+)
+
+func NewWebsiteStatusDataSource() datasource.DataSource {
+	return &WebsiteStatusDataSource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "powerpages_website_status",
+		},
+	}
+}
+
+func (d *WebsiteStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *WebsiteStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the status of a [Power Pages website](https://learn.microsoft.com/power-pages/admin/admin-overview), to be used in checks or preconditions that gate changes depending on the website, such as DNS records or CDN routes pointing to it.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Read: true,
+			}),
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment of the website",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "environment_id must be a valid environment id guid"),
+				},
+			},
+			"website_id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the website",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(helpers.GuidRegex), "website_id must be a valid website id guid"),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the website, for example `Active` or `OnHold`",
+				Computed:            true,
+			},
+			"package_install_status": schema.StringAttribute{
+				MarkdownDescription: "Installation status of the Power Pages package of the website, for example `Installed`",
+				Computed:            true,
+			},
+			"package_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the Power Pages package installed for the website",
+				Computed:            true,
+			},
+			"last_upgrade_time": schema.StringAttribute{
+				MarkdownDescription: "Time of the last upgrade of the Power Pages package of the website, null when the website has not reported an upgrade",
+				Computed:            true,
+			},
+			"is_healthy": schema.BoolAttribute{
+				MarkdownDescription: "`true` when the website is `Active` and its package is installed",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *WebsiteStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.PowerPagesClient = newPowerPagesClient(client.Api)
+	client.Api.AddPreviewApiVersionWarning(&resp.Diagnostics, api.API_SERVICE_POWER_PAGES)
+}
+
+func (d *WebsiteStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var state WebsiteStatusDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	website, err := d.PowerPagesClient.GetWebsite(ctx, state.EnvironmentId.ValueString(), state.WebsiteId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+
+	convertFromWebsiteDtoToStatusModel(&state, website)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
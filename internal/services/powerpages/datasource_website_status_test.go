@@ -0,0 +1,121 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerpages_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccWebsiteStatusDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_powerpages_website" "website" {
+					environment_id = powerplatform_environment.env.id
+					name           = "` + mocks.TestName() + `"
+					subdomain      = "tfacc-${substr(replace(powerplatform_environment.env.id, "-", ""), 0, 12)}"
+					language_code  = 1033
+				}
+
+				data "powerplatform_powerpages_website_status" "website" {
+					environment_id = powerplatform_environment.env.id
+					website_id     = powerplatform_powerpages_website.website.id
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_website_status.website", "package_install_status", "Installed"),
+					resource.TestCheckResourceAttrPair("data.powerplatform_powerpages_website_status.website", "package_version", "powerplatform_powerpages_website.website", "package_version"),
+					resource.TestCheckResourceAttrSet("data.powerplatform_powerpages_website_status.website", "status"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitWebsiteStatusDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Website_Status/get_website_active.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000011?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Website_Status/get_website_on_hold.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_powerpages_website_status" "active" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					website_id     = "00000000-0000-0000-0000-000000000010"
+				}
+
+				data "powerplatform_powerpages_website_status" "on_hold" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					website_id     = "00000000-0000-0000-0000-000000000011"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_website_status.active", "status", "Active"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_website_status.active", "package_install_status", "Installed"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_website_status.active", "package_version", "9.6.9.1"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_website_status.active", "last_upgrade_time", "2024-11-15T02:30:00.0000000Z"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_website_status.active", "is_healthy", "true"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_website_status.on_hold", "status", "OnHold"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_website_status.on_hold", "package_install_status", "Installing"),
+					resource.TestCheckNoResourceAttr("data.powerplatform_powerpages_website_status.on_hold", "last_upgrade_time"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_website_status.on_hold", "is_healthy", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitWebsiteStatusDataSource_Validate_Not_Found(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010?api-version=2022-03-01-preview`,
+		httpmock.NewStringResponder(http.StatusNotFound, ""))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_powerpages_website_status" "website" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					website_id     = "00000000-0000-0000-0000-000000000010"
+				}`,
+				ExpectError: regexp.MustCompile(`website '00000000-0000-0000-0000-000000000010' not found`),
+			},
+		},
+	})
+}
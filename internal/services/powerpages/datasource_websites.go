@@ -0,0 +1,170 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerpages
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var (
+	_ datasource.DataSource              = &WebsitesDataSource{}
+	_ datasource.DataSourceWithConfigure = &WebsitesDataSource{}
+)
+
+func NewWebsitesDataSource() datasource.DataSource {
+	return &WebsitesDataSource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "powerpages_websites",
+		},
+	}
+}
+
+func (d *WebsitesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *WebsitesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the list of [Power Pages websites](https://learn.microsoft.com/power-pages/admin/admin-overview) in an environment, or in every environment of the tenant with Dataverse when `environment_id` is not set.\n\nWhen listing the websites of the tenant, environments that can't be queried by the provider's identity are skipped and reported as warnings.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Read: true,
+			}),
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment to list the websites of. When not set, the websites of all environments with Dataverse are listed",
+				Optional:            true,
+			},
+			"websites": schema.ListNestedAttribute{
+				MarkdownDescription: "List of websites",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier of the website",
+							Computed:            true,
+						},
+						"environment_id": schema.StringAttribute{
+							MarkdownDescription: "Id of the environment where the website is located",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the website",
+							Computed:            true,
+						},
+						"subdomain": schema.StringAttribute{
+							MarkdownDescription: "Subdomain of the website",
+							Computed:            true,
+						},
+						"website_url": schema.StringAttribute{
+							MarkdownDescription: "Url of the website",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "Status of the website",
+							Computed:            true,
+						},
+						"type": schema.StringAttribute{
+							MarkdownDescription: "Type of the website, for example `Trial` or `Production`",
+							Computed:            true,
+						},
+						"package_version": schema.StringAttribute{
+							MarkdownDescription: "Version of the Power Pages package installed for the website",
+							Computed:            true,
+						},
+						"package_install_status": schema.StringAttribute{
+							MarkdownDescription: "Installation status of the Power Pages package of the website, for example `Installed`",
+							Computed:            true,
+						},
+						"site_visibility": schema.StringAttribute{
+							MarkdownDescription: "Visibility of the website, `private` or `public`",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *WebsitesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.PowerPagesClient = newPowerPagesClient(client.Api)
+	client.Api.AddPreviewApiVersionWarning(&resp.Diagnostics, api.API_SERVICE_POWER_PAGES)
+}
+
+func (d *WebsitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var state WebsitesListDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var websites []websiteDto
+	var failedEnvironments map[string]error
+	var err error
+	if state.EnvironmentId.ValueString() != "" {
+		websites, err = d.PowerPagesClient.GetWebsites(ctx, state.EnvironmentId.ValueString())
+	} else {
+		websites, failedEnvironments, err = d.PowerPagesClient.GetTenantWebsites(ctx)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", d.FullTypeName()), err.Error())
+		return
+	}
+
+	failedEnvironmentIds := make([]string, 0, len(failedEnvironments))
+	for environmentId := range failedEnvironments {
+		failedEnvironmentIds = append(failedEnvironmentIds, environmentId)
+	}
+	sort.Strings(failedEnvironmentIds)
+	for _, environmentId := range failedEnvironmentIds {
+		resp.Diagnostics.AddWarning(fmt.Sprintf("Unable to read websites in environment '%s'", environmentId), failedEnvironments[environmentId].Error())
+	}
+
+	state.Websites = make([]WebsiteDataSourceModel, 0, len(websites))
+	for _, website := range websites {
+		state.Websites = append(state.Websites, convertFromWebsiteDtoToDataSourceModel(website))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerpages_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccWebsitesDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_powerpages_website" "website" {
+					environment_id = powerplatform_environment.env.id
+					name           = "` + mocks.TestName() + `"
+					subdomain      = "tfacc-${substr(replace(powerplatform_environment.env.id, "-", ""), 0, 12)}"
+					language_code  = 1033
+				}
+
+				data "powerplatform_powerpages_websites" "all" {
+					environment_id = powerplatform_environment.env.id
+
+					depends_on = [powerplatform_powerpages_website.website]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.#", "1"),
+					resource.TestMatchResourceAttr("data.powerplatform_powerpages_websites.all", "websites.0.id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestCheckResourceAttrPair("data.powerplatform_powerpages_websites.all", "websites.0.website_url", "powerplatform_powerpages_website.website", "website_url"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitWebsitesDataSource_Validate_Read_Environment(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_websites_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_powerpages_websites" "all" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.0.id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.0.environment_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.0.name", "Contoso portal"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.0.subdomain", "contoso-portal"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.0.website_url", "https://contoso-portal.powerappsportals.com"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.0.status", "OnHold"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.0.type", "Trial"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.0.package_version", "9.6.9.1"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.0.package_install_status", "Installed"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.0.site_visibility", "private"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.1.id", "00000000-0000-0000-0000-000000000011"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.1.site_visibility", "public"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitWebsitesDataSource_Validate_Read_Tenant(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments?%24expand=properties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_environments.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^https://api\.powerplatform\.com/powerpages/environments/([\d-]+)/websites\?api-version=2022-03-01-preview$`,
+		func(req *http.Request) (*http.Response, error) {
+			id := httpmock.MustGetSubmatch(req, 1)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_websites_"+id+".json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_powerpages_websites" "all" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.#", "3"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.2.id", "00000000-0000-0000-0000-000000000012"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.2.environment_id", "00000000-0000-0000-0000-000000000002"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.2.subdomain", "contoso-intranet"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitWebsitesDataSource_Validate_Read_Tenant_Skips_Forbidden_Environments(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments?%24expand=properties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_environments.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_websites_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000002/websites?api-version=2022-03-01-preview`,
+		httpmock.NewStringResponder(http.StatusForbidden, `{"error":{"code":"Forbidden","message":"The caller does not have access to the environment"}}`))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_powerpages_websites" "all" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.#", "2"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.0.environment_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("data.powerplatform_powerpages_websites.all", "websites.1.environment_id", "00000000-0000-0000-0000-000000000001"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,103 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerpages
+
+type websiteDto struct {
+	Id                      string   `json:"id"`
+	Name                    string   `json:"name"`
+	CreatedOn               string   `json:"createdOn"`
+	TemplateName            string   `json:"templateName"`
+	WebsiteUrl              string   `json:"websiteUrl"`
+	TenantId                string   `json:"tenantId"`
+	DataverseInstanceUrl    string   `json:"dataverseInstanceUrl"`
+	EnvironmentName         string   `json:"environmentName"`
+	EnvironmentId           string   `json:"environmentId"`
+	DataverseOrganizationId string   `json:"dataverseOrganizationId"`
+	SelectedBaseLanguage    int64    `json:"selectedBaseLanguage"`
+	CustomHostNames         []string `json:"customHostNames"`
+	WebsiteRecordId         string   `json:"websiteRecordId"`
+	Subdomain               string   `json:"subdomain"`
+	PackageInstallStatus    string   `json:"packageInstallStatus"`
+	Type                    string   `json:"type"`
+	PackageVersion          string   `json:"packageVersion"`
+	OwnerId                 string   `json:"ownerId"`
+	Status                  string   `json:"status"`
+	SiteVisibility          string   `json:"siteVisibility"`
+	LastUpgradeTime         string   `json:"lastUpgradeTime,omitempty"`
+}
+
+type websiteArrayDto struct {
+	Value []websiteDto `json:"value"`
+}
+
+type createWebsiteDto struct {
+	DataverseOrganizationId string `json:"dataverseOrganizationId"`
+	Name                    string `json:"name"`
+	SelectedBaseLanguage    int64  `json:"selectedBaseLanguage"`
+	Subdomain               string `json:"subdomain"`
+	TemplateName            string `json:"templateName"`
+	WebsiteRecordId         string `json:"websiteRecordId,omitempty"`
+}
+
+type updateWebsiteDto struct {
+	Name string `json:"name"`
+}
+
+type websiteOperationDto struct {
+	Id     string                    `json:"id"`
+	Status string                    `json:"status"`
+	Error  *websiteOperationErrorDto `json:"error,omitempty"`
+}
+
+type websiteOperationErrorDto struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type updateSiteVisibilityDto struct {
+	SiteVisibility string `json:"siteVisibility"`
+}
+
+type wafStatusDto struct {
+	Status string `json:"status"`
+}
+
+type wafRulesDto struct {
+	ManagedRules []wafManagedRuleSetDto `json:"ManagedRules"`
+	CustomRules  []wafCustomRuleDto     `json:"CustomRules"`
+}
+
+type wafManagedRuleSetDto struct {
+	RuleSetType        string                       `json:"RuleSetType"`
+	RuleSetVersion     string                       `json:"RuleSetVersion"`
+	RuleSetAction      string                       `json:"RuleSetAction,omitempty"`
+	Exclusions         []wafManagedRuleExclusionDto `json:"Exclusions"`
+	RuleGroupOverrides []any                        `json:"RuleGroupOverrides,omitempty"`
+}
+
+type wafManagedRuleExclusionDto struct {
+	MatchVariable         string `json:"matchVariable"`
+	SelectorMatchOperator string `json:"selectorMatchOperator"`
+	Selector              string `json:"selector"`
+}
+
+type wafCustomRuleDto struct {
+	Name                       string                 `json:"name"`
+	Priority                   int64                  `json:"priority"`
+	EnabledState               string                 `json:"enabledState"`
+	RuleType                   string                 `json:"ruleType"`
+	RateLimitDurationInMinutes *int64                 `json:"rateLimitDurationInMinutes,omitempty"`
+	RateLimitThreshold         *int64                 `json:"rateLimitThreshold,omitempty"`
+	MatchConditions            []wafMatchConditionDto `json:"matchConditions"`
+	Action                     string                 `json:"action"`
+}
+
+type wafMatchConditionDto struct {
+	MatchVariable   string   `json:"matchVariable"`
+	Selector        string   `json:"selector,omitempty"`
+	Operator        string   `json:"operator"`
+	NegateCondition bool     `json:"negateCondition"`
+	MatchValue      []string `json:"matchValue"`
+	Transforms      []string `json:"transforms,omitempty"`
+}
@@ -0,0 +1,289 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerpages
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+type WebsiteResource struct {
+	helpers.TypeInfo
+	PowerPagesClient client
+}
+
+type WebsiteResourceModel struct {
+	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	Id              types.String   `tfsdk:"id"`
+	EnvironmentId   types.String   `tfsdk:"environment_id"`
+	Name            types.String   `tfsdk:"name"`
+	Subdomain       types.String   `tfsdk:"subdomain"`
+	LanguageCode    types.Int64    `tfsdk:"language_code"`
+	TemplateName    types.String   `tfsdk:"template_name"`
+	WebsiteRecordId types.String   `tfsdk:"website_record_id"`
+	WebsiteUrl      types.String   `tfsdk:"website_url"`
+	PackageVersion  types.String   `tfsdk:"package_version"`
+	PackageStatus   types.String   `tfsdk:"package_install_status"`
+	Status          types.String   `tfsdk:"status"`
+	Type            types.String   `tfsdk:"type"`
+	SiteVisibility  types.String   `tfsdk:"site_visibility"`
+	CustomHostNames types.Set      `tfsdk:"custom_host_names"`
+}
+
+type WebsiteVisibilityResource struct {
+	helpers.TypeInfo
+	PowerPagesClient client
+}
+
+type WebsiteVisibilityResourceModel struct {
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	Id             types.String   `tfsdk:"id"`
+	EnvironmentId  types.String   `tfsdk:"environment_id"`
+	WebsiteId      types.String   `tfsdk:"website_id"`
+	SiteVisibility types.String   `tfsdk:"site_visibility"`
+}
+
+type WebsiteWafResource struct {
+	helpers.TypeInfo
+	PowerPagesClient client
+}
+
+type WebsiteWafResourceModel struct {
+	Timeouts              timeouts.Value                 `tfsdk:"timeouts"`
+	Id                    types.String                   `tfsdk:"id"`
+	EnvironmentId         types.String                   `tfsdk:"environment_id"`
+	WebsiteId             types.String                   `tfsdk:"website_id"`
+	CustomRules           []WebsiteWafCustomRuleModel    `tfsdk:"custom_rules"`
+	ManagedRuleExclusions []WebsiteWafRuleExclusionModel `tfsdk:"managed_rule_exclusions"`
+}
+
+type WebsiteWafCustomRuleModel struct {
+	Name                       types.String                    `tfsdk:"name"`
+	Priority                   types.Int64                     `tfsdk:"priority"`
+	Enabled                    types.Bool                      `tfsdk:"enabled"`
+	RuleType                   types.String                    `tfsdk:"rule_type"`
+	Action                     types.String                    `tfsdk:"action"`
+	RateLimitDurationInMinutes types.Int64                     `tfsdk:"rate_limit_duration_in_minutes"`
+	RateLimitThreshold         types.Int64                     `tfsdk:"rate_limit_threshold"`
+	MatchConditions            []WebsiteWafMatchConditionModel `tfsdk:"match_conditions"`
+}
+
+type WebsiteWafMatchConditionModel struct {
+	MatchVariable   types.String `tfsdk:"match_variable"`
+	Selector        types.String `tfsdk:"selector"`
+	Operator        types.String `tfsdk:"operator"`
+	NegateCondition types.Bool   `tfsdk:"negate_condition"`
+	MatchValues     []string     `tfsdk:"match_values"`
+	Transforms      []string     `tfsdk:"transforms"`
+}
+
+type WebsiteWafRuleExclusionModel struct {
+	MatchVariable         types.String `tfsdk:"match_variable"`
+	SelectorMatchOperator types.String `tfsdk:"selector_match_operator"`
+	Selector              types.String `tfsdk:"selector"`
+}
+
+type WebsitesDataSource struct {
+	helpers.TypeInfo
+	PowerPagesClient client
+}
+
+type WebsitesListDataSourceModel struct {
+	Timeouts      timeouts.Value           `tfsdk:"timeouts"`
+	EnvironmentId types.String             `tfsdk:"environment_id"`
+	Websites      []WebsiteDataSourceModel `tfsdk:"websites"`
+}
+
+type WebsiteDataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	EnvironmentId  types.String `tfsdk:"environment_id"`
+	Name           types.String `tfsdk:"name"`
+	Subdomain      types.String `tfsdk:"subdomain"`
+	WebsiteUrl     types.String `tfsdk:"website_url"`
+	Status         types.String `tfsdk:"status"`
+	Type           types.String `tfsdk:"type"`
+	PackageVersion types.String `tfsdk:"package_version"`
+	PackageStatus  types.String `tfsdk:"package_install_status"`
+	SiteVisibility types.String `tfsdk:"site_visibility"`
+}
+
+type WebsiteStatusDataSource struct {
+	helpers.TypeInfo
+	PowerPagesClient client
+}
+
+type WebsiteStatusDataSourceModel struct {
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+	EnvironmentId        types.String   `tfsdk:"environment_id"`
+	WebsiteId            types.String   `tfsdk:"website_id"`
+	Status               types.String   `tfsdk:"status"`
+	PackageInstallStatus types.String   `tfsdk:"package_install_status"`
+	PackageVersion       types.String   `tfsdk:"package_version"`
+	LastUpgradeTime      types.String   `tfsdk:"last_upgrade_time"`
+	IsHealthy            types.Bool     `tfsdk:"is_healthy"`
+}
+
+func convertFromWebsiteDtoToStatusModel(model *WebsiteStatusDataSourceModel, website *websiteDto) {
+	model.Status = types.StringValue(website.Status)
+	model.PackageInstallStatus = types.StringValue(website.PackageInstallStatus)
+	model.PackageVersion = types.StringValue(website.PackageVersion)
+	model.LastUpgradeTime = types.StringNull()
+	if website.LastUpgradeTime != "" {
+		model.LastUpgradeTime = types.StringValue(website.LastUpgradeTime)
+	}
+	model.IsHealthy = types.BoolValue(strings.EqualFold(website.Status, WEBSITE_STATUS_ACTIVE) && isPackageInstallSucceeded(website.PackageInstallStatus))
+}
+
+func convertFromWebsiteDtoToDataSourceModel(website websiteDto) WebsiteDataSourceModel {
+	return WebsiteDataSourceModel{
+		Id:             types.StringValue(website.Id),
+		EnvironmentId:  types.StringValue(website.EnvironmentId),
+		Name:           types.StringValue(website.Name),
+		Subdomain:      types.StringValue(website.Subdomain),
+		WebsiteUrl:     types.StringValue(website.WebsiteUrl),
+		Status:         types.StringValue(website.Status),
+		Type:           types.StringValue(website.Type),
+		PackageVersion: types.StringValue(website.PackageVersion),
+		PackageStatus:  types.StringValue(website.PackageInstallStatus),
+		SiteVisibility: types.StringValue(website.SiteVisibility),
+	}
+}
+
+func convertFromWebsiteDto(model *WebsiteResourceModel, website *websiteDto) error {
+	model.Id = types.StringValue(website.Id)
+	model.EnvironmentId = types.StringValue(website.EnvironmentId)
+	model.Name = types.StringValue(website.Name)
+	model.Subdomain = types.StringValue(website.Subdomain)
+	model.LanguageCode = types.Int64Value(website.SelectedBaseLanguage)
+	model.TemplateName = types.StringValue(website.TemplateName)
+	model.WebsiteRecordId = types.StringValue(website.WebsiteRecordId)
+	model.WebsiteUrl = types.StringValue(website.WebsiteUrl)
+	model.PackageVersion = types.StringValue(website.PackageVersion)
+	model.PackageStatus = types.StringValue(website.PackageInstallStatus)
+	model.Status = types.StringValue(website.Status)
+	model.Type = types.StringValue(website.Type)
+	model.SiteVisibility = types.StringValue(website.SiteVisibility)
+	customHostNames, err := helpers.StringSliceToSet(website.CustomHostNames)
+	if err != nil {
+		return err
+	}
+	model.CustomHostNames = customHostNames
+	return nil
+}
+
+func convertToWafCustomRuleDtos(rules []WebsiteWafCustomRuleModel) []wafCustomRuleDto {
+	dtos := make([]wafCustomRuleDto, 0, len(rules))
+	for _, rule := range rules {
+		enabledState := WAF_RULE_STATE_ENABLED
+		if !rule.Enabled.ValueBool() {
+			enabledState = WAF_RULE_STATE_DISABLED
+		}
+		conditions := make([]wafMatchConditionDto, 0, len(rule.MatchConditions))
+		for _, condition := range rule.MatchConditions {
+			conditions = append(conditions, wafMatchConditionDto{
+				MatchVariable:   condition.MatchVariable.ValueString(),
+				Selector:        condition.Selector.ValueString(),
+				Operator:        condition.Operator.ValueString(),
+				NegateCondition: condition.NegateCondition.ValueBool(),
+				MatchValue:      condition.MatchValues,
+				Transforms:      condition.Transforms,
+			})
+		}
+		dtos = append(dtos, wafCustomRuleDto{
+			Name:                       rule.Name.ValueString(),
+			Priority:                   rule.Priority.ValueInt64(),
+			EnabledState:               enabledState,
+			RuleType:                   rule.RuleType.ValueString(),
+			Action:                     rule.Action.ValueString(),
+			RateLimitDurationInMinutes: rule.RateLimitDurationInMinutes.ValueInt64Pointer(),
+			RateLimitThreshold:         rule.RateLimitThreshold.ValueInt64Pointer(),
+			MatchConditions:            conditions,
+		})
+	}
+	return dtos
+}
+
+func convertToWafRuleExclusionDtos(exclusions []WebsiteWafRuleExclusionModel) []wafManagedRuleExclusionDto {
+	dtos := make([]wafManagedRuleExclusionDto, 0, len(exclusions))
+	for _, exclusion := range exclusions {
+		dtos = append(dtos, wafManagedRuleExclusionDto{
+			MatchVariable:         exclusion.MatchVariable.ValueString(),
+			SelectorMatchOperator: exclusion.SelectorMatchOperator.ValueString(),
+			Selector:              exclusion.Selector.ValueString(),
+		})
+	}
+	return dtos
+}
+
+func convertFromWafRulesDto(model *WebsiteWafResourceModel, rules *wafRulesDto) *WebsiteWafResourceModel {
+	model.Id = model.WebsiteId
+
+	// keep the custom rules in the configured order, the API may return them in a different one.
+	ruleOrder := make(map[string]int, len(model.CustomRules))
+	for i, rule := range model.CustomRules {
+		ruleOrder[rule.Name.ValueString()] = i
+	}
+	customRules := slices.Clone(rules.CustomRules)
+	slices.SortStableFunc(customRules, func(a, b wafCustomRuleDto) int {
+		aOrder, aOk := ruleOrder[a.Name]
+		bOrder, bOk := ruleOrder[b.Name]
+		if !aOk {
+			aOrder = len(ruleOrder)
+		}
+		if !bOk {
+			bOrder = len(ruleOrder)
+		}
+		return aOrder - bOrder
+	})
+
+	model.CustomRules = nil
+	for _, rule := range customRules {
+		conditions := make([]WebsiteWafMatchConditionModel, 0, len(rule.MatchConditions))
+		for _, condition := range rule.MatchConditions {
+			selector := types.StringNull()
+			if condition.Selector != "" {
+				selector = types.StringValue(condition.Selector)
+			}
+			var transforms []string
+			if len(condition.Transforms) > 0 {
+				transforms = condition.Transforms
+			}
+			conditions = append(conditions, WebsiteWafMatchConditionModel{
+				MatchVariable:   types.StringValue(condition.MatchVariable),
+				Selector:        selector,
+				Operator:        types.StringValue(condition.Operator),
+				NegateCondition: types.BoolValue(condition.NegateCondition),
+				MatchValues:     condition.MatchValue,
+				Transforms:      transforms,
+			})
+		}
+		model.CustomRules = append(model.CustomRules, WebsiteWafCustomRuleModel{
+			Name:                       types.StringValue(rule.Name),
+			Priority:                   types.Int64Value(rule.Priority),
+			Enabled:                    types.BoolValue(!strings.EqualFold(rule.EnabledState, WAF_RULE_STATE_DISABLED)),
+			RuleType:                   types.StringValue(rule.RuleType),
+			Action:                     types.StringValue(rule.Action),
+			RateLimitDurationInMinutes: types.Int64PointerValue(rule.RateLimitDurationInMinutes),
+			RateLimitThreshold:         types.Int64PointerValue(rule.RateLimitThreshold),
+			MatchConditions:            conditions,
+		})
+	}
+
+	// the exclusions are applied to every managed rule set, so they are read from the first one.
+	model.ManagedRuleExclusions = nil
+	if len(rules.ManagedRules) > 0 {
+		for _, exclusion := range rules.ManagedRules[0].Exclusions {
+			model.ManagedRuleExclusions = append(model.ManagedRuleExclusions, WebsiteWafRuleExclusionModel{
+				MatchVariable:         types.StringValue(exclusion.MatchVariable),
+				SelectorMatchOperator: types.StringValue(exclusion.SelectorMatchOperator),
+				Selector:              types.StringValue(exclusion.Selector),
+			})
+		}
+	}
+	return model
+}
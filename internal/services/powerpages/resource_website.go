@@ -0,0 +1,317 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerpages
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/modifiers"
+)
+
+var _ resource.Resource = &WebsiteResource{}
+var _ resource.ResourceWithImportState = &WebsiteResource{}
+
+func NewWebsiteResource() resource.Resource {
+	return &WebsiteResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "powerpages_website",
+		},
+	}
+}
+
+func (r *WebsiteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *WebsiteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a [Power Pages website](https://learn.microsoft.com/power-pages/admin/admin-overview) in a Dataverse environment using the [Power Pages websites API](https://learn.microsoft.com/rest/api/power-platform/powerpages/websites). Existing websites can be imported with the environment id and the website id.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the website",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Dataverse environment where the website is created",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the website. It can be changed without replacing the website",
+				Required:            true,
+			},
+			"subdomain": schema.StringAttribute{
+				MarkdownDescription: "Subdomain of the website, for example `contoso` for `https://contoso.powerappsportals.com`",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"language_code": schema.Int64Attribute{
+				MarkdownDescription: "Base language LCID (integer) of the website, for example `1033`",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"template_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the template the website is created from. Default is `DefaultPortalTemplate`",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("DefaultPortalTemplate"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"website_record_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the website record in Dataverse. Set it to create the website from an existing website record, otherwise a new record is created",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"website_url": schema.StringAttribute{
+				MarkdownDescription: "Url of the website",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"package_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the Power Pages package installed for the website",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.UseStateForServerDefaultString(),
+				},
+			},
+			"package_install_status": schema.StringAttribute{
+				MarkdownDescription: "Installation status of the Power Pages package of the website, for example `Installed`. Creation waits until the package installation has finished",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the website",
+				Computed:            true,
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the website, for example `Trial` or `Production`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					modifiers.UseStateForServerDefaultString(),
+				},
+			},
+			"site_visibility": schema.StringAttribute{
+				MarkdownDescription: "Visibility of the website, `private` or `public`",
+				Computed:            true,
+			},
+			"custom_host_names": schema.SetAttribute{
+				MarkdownDescription: "Custom host names bound to the website",
+				ElementType:         types.StringType,
+				Computed:            true,
+				PlanModifiers: []planmodifier.Set{
+					modifiers.UseStateForServerDefaultSet(),
+				},
+			},
+		},
+	}
+}
+
+func (r *WebsiteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.PowerPagesClient = newPowerPagesClient(client.Api)
+	client.Api.AddPreviewApiVersionWarning(&resp.Diagnostics, api.API_SERVICE_POWER_PAGES)
+}
+
+func (r *WebsiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *WebsiteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	websiteToCreate := createWebsiteDto{
+		Name:                 plan.Name.ValueString(),
+		Subdomain:            plan.Subdomain.ValueString(),
+		SelectedBaseLanguage: plan.LanguageCode.ValueInt64(),
+		TemplateName:         plan.TemplateName.ValueString(),
+		WebsiteRecordId:      plan.WebsiteRecordId.ValueString(),
+	}
+
+	website, err := r.PowerPagesClient.CreateWebsite(ctx, plan.EnvironmentId.ValueString(), websiteToCreate)
+	if err != nil {
+		if website != nil {
+			// the website exists even though its provisioning failed, keep track of it so that it gets replaced or destroyed.
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), website.Id)...)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), plan.EnvironmentId.ValueString())...)
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	if err := convertFromWebsiteDto(plan, website); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error when converting %s", r.FullTypeName()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *WebsiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *WebsiteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	website, err := r.PowerPagesClient.GetWebsite(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	if err := convertFromWebsiteDto(state, website); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error when converting %s", r.FullTypeName()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *WebsiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *WebsiteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *WebsiteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The name is the only attribute that is updated in place, the other configurable attributes require replacement.
+	var website *websiteDto
+	var err error
+	if !plan.Name.Equal(state.Name) {
+		website, err = r.PowerPagesClient.UpdateWebsite(ctx, plan.EnvironmentId.ValueString(), plan.Id.ValueString(), updateWebsiteDto{
+			Name: plan.Name.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+			return
+		}
+	} else {
+		website, err = r.PowerPagesClient.GetWebsite(ctx, plan.EnvironmentId.ValueString(), plan.Id.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+			return
+		}
+	}
+
+	if err := convertFromWebsiteDto(plan, website); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Error when converting %s", r.FullTypeName()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *WebsiteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *WebsiteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.PowerPagesClient.DeleteWebsite(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+}
+
+func (r *WebsiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	guidRegex := regexp.MustCompile(helpers.GuidRegex)
+	environmentId, websiteId, found := strings.Cut(req.ID, "/")
+	if !found || !guidRegex.MatchString(environmentId) || !guidRegex.MatchString(websiteId) {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>/<website_id>, where both parts are guids. Got: %q", req.ID))
+		return
+	}
+
+	// the remaining attributes, including the ones that are configured, are read from the Power Pages API.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), websiteId)...)
+}
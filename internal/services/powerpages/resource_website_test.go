@@ -0,0 +1,399 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerpages_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccWebsiteResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_powerpages_website" "website" {
+					environment_id = powerplatform_environment.env.id
+					name           = "` + mocks.TestName() + `"
+					subdomain      = "tfacc-${substr(replace(powerplatform_environment.env.id, "-", ""), 0, 12)}"
+					language_code  = 1033
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_powerpages_website.website", "id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_powerpages_website.website", "website_record_id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestMatchResourceAttr("powerplatform_powerpages_website.website", "website_url", regexp.MustCompile(`^https://tfacc-`)),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "template_name", "DefaultPortalTemplate"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitWebsiteResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	created := false
+	deleted := false
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			website := map[string]any{}
+			_ = json.Unmarshal(body, &website)
+			if website["dataverseOrganizationId"] != "00000000-0000-0000-0000-000000000002" || website["subdomain"] != "contoso-portal" ||
+				website["templateName"] != "DefaultPortalTemplate" || website["selectedBaseLanguage"] != float64(1033) {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			created = true
+			resp := httpmock.NewStringResponse(http.StatusAccepted, "")
+			resp.Header.Add("Operation-Location", "https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/operations/00000000-0000-0000-0000-000000000050?api-version=2022-03-01-preview")
+			return resp, nil
+		})
+
+	operationCount := 0
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/operations/00000000-0000-0000-0000-000000000050?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			operationCount++
+			if operationCount < 2 {
+				return httpmock.NewStringResponse(http.StatusOK, `{"id":"00000000-0000-0000-0000-000000000050","status":"Running"}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, `{"id":"00000000-0000-0000-0000-000000000050","status":"Succeeded"}`), nil
+		})
+
+	listCount := 0
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			listCount++
+			// the new website shows up in the list only after provisioning has started.
+			if !created || listCount < 2 {
+				return httpmock.NewStringResponse(http.StatusOK, `{"value":[]}`), nil
+			}
+			// the package is still being installed when the website is first listed.
+			website := strings.ReplaceAll(httpmock.File("tests/resource/Validate_Create/get_website.json").String(), `"Installed"`, `"Installing"`)
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"value":[%s]}`, website)), nil
+		})
+
+	getCount := 0
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			if deleted {
+				return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+			}
+			getCount++
+			if getCount < 2 {
+				return httpmock.NewStringResponse(http.StatusOK, strings.ReplaceAll(httpmock.File("tests/resource/Validate_Create/get_website.json").String(), `"Installed"`, `"Installing"`)), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create/get_website.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			deleted = true
+			return httpmock.NewStringResponse(http.StatusAccepted, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if !deleted {
+				return errors.New("website was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_powerpages_website" "website" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Contoso portal"
+					subdomain      = "contoso-portal"
+					language_code  = 1033
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "environment_id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "name", "Contoso portal"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "subdomain", "contoso-portal"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "language_code", "1033"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "template_name", "DefaultPortalTemplate"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "website_record_id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "website_url", "https://contoso-portal.powerappsportals.com"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "package_version", "9.6.9.1"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "package_install_status", "Installed"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "status", "OnHold"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "type", "Trial"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "site_visibility", "private"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "custom_host_names.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "powerplatform_powerpages_website.website",
+				ImportState:       true,
+				ImportStateId:     "00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000010",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitWebsiteResource_Validate_Import_Invalid_Id(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_powerpages_website" "website" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Contoso portal"
+					subdomain      = "contoso-portal"
+					language_code  = 1033
+				}`,
+				ResourceName:  "powerplatform_powerpages_website.website",
+				ImportState:   true,
+				ImportStateId: "00000000-0000-0000-0000-000000000010",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
+
+func TestUnitWebsiteResource_Validate_Create_Package_Install_Failed(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	deleted := false
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites?api-version=2022-03-01-preview`,
+		httpmock.NewStringResponder(http.StatusAccepted, ""))
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			website := strings.ReplaceAll(httpmock.File("tests/resource/Validate_Create/get_website.json").String(), `"Installed"`, `"InstallFailed"`)
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"value":[%s]}`, website)), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			if deleted {
+				return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, strings.ReplaceAll(httpmock.File("tests/resource/Validate_Create/get_website.json").String(), `"Installed"`, `"InstallFailed"`)), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			deleted = true
+			return httpmock.NewStringResponse(http.StatusAccepted, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if !deleted {
+				return errors.New("website with failed provisioning was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_powerpages_website" "website" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Contoso portal"
+					subdomain      = "contoso-portal"
+					language_code  = 1033
+				}`,
+				ExpectError: regexp.MustCompile(`package installation of website 'Contoso portal' failed with status\s+'InstallFailed'`),
+			},
+		},
+	})
+}
+
+func TestUnitWebsiteResource_Validate_Removed_Outside_Terraform(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	removed := false
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites?api-version=2022-03-01-preview`,
+		httpmock.NewStringResponder(http.StatusAccepted, ""))
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"value":[%s]}`, httpmock.File("tests/resource/Validate_Create/get_website.json").String())), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			if removed {
+				return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create/get_website.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010?api-version=2022-03-01-preview`,
+		httpmock.NewStringResponder(http.StatusAccepted, ""))
+
+	config := `
+	resource "powerplatform_powerpages_website" "website" {
+		environment_id = "00000000-0000-0000-0000-000000000001"
+		name           = "Contoso portal"
+		subdomain      = "contoso-portal"
+		language_code  = 1033
+	}`
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "id", "00000000-0000-0000-0000-000000000010"),
+					func(_ *terraform.State) error {
+						removed = true
+						return nil
+					},
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestUnitWebsiteResource_Validate_Update_Name(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	websiteName := "Contoso portal"
+	patched := 0
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites?api-version=2022-03-01-preview`,
+		httpmock.NewStringResponder(http.StatusAccepted, ""))
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, fmt.Sprintf(`{"value":[%s]}`, httpmock.File("tests/resource/Validate_Create/get_website.json").String())), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			if websiteName == "" {
+				return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+			}
+			website := strings.ReplaceAll(httpmock.File("tests/resource/Validate_Create/get_website.json").String(), `"Contoso portal"`, fmt.Sprintf("%q", websiteName))
+			return httpmock.NewStringResponse(http.StatusOK, website), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			website := map[string]any{}
+			_ = json.Unmarshal(body, &website)
+			if website["name"] != "Contoso customer portal" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			patched++
+			websiteName = website["name"].(string)
+			return httpmock.NewStringResponse(http.StatusAccepted, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			websiteName = ""
+			return httpmock.NewStringResponse(http.StatusAccepted, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_powerpages_website" "website" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Contoso portal"
+					subdomain      = "contoso-portal"
+					language_code  = 1033
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "name", "Contoso portal"),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_powerpages_website" "website" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					name           = "Contoso customer portal"
+					subdomain      = "contoso-portal"
+					language_code  = 1033
+				}`,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("powerplatform_powerpages_website.website", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website.website", "name", "Contoso customer portal"),
+					func(_ *terraform.State) error {
+						if patched != 1 {
+							return fmt.Errorf("expected the website to be patched once, got %d", patched)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,109 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerpages_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccWebsiteVisibilityResource_Validate_Update(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_powerpages_website" "website" {
+					environment_id = powerplatform_environment.env.id
+					name           = "` + mocks.TestName() + `"
+					subdomain      = "tfacc-${substr(replace(powerplatform_environment.env.id, "-", ""), 0, 12)}"
+					language_code  = 1033
+				}
+
+				resource "powerplatform_powerpages_website_visibility" "visibility" {
+					environment_id  = powerplatform_environment.env.id
+					website_id      = powerplatform_powerpages_website.website.id
+					site_visibility = "private"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_visibility.visibility", "site_visibility", "private"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitWebsiteVisibilityResource_Validate_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	siteVisibility := "private"
+
+	httpmock.RegisterResponder("POST", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010/updateSiteVisibility?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			visibility := map[string]any{}
+			_ = json.Unmarshal(body, &visibility)
+			value, ok := visibility["siteVisibility"].(string)
+			if !ok || (value != "private" && value != "public") {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			siteVisibility = value
+			return httpmock.NewStringResponse(http.StatusAccepted, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			response := strings.Replace(httpmock.File("tests/resource/Validate_Visibility_Update/get_website.json").String(), "{{site_visibility}}", siteVisibility, 1)
+			return httpmock.NewStringResponse(http.StatusOK, response), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_powerpages_website_visibility" "visibility" {
+					environment_id  = "00000000-0000-0000-0000-000000000001"
+					website_id      = "00000000-0000-0000-0000-000000000010"
+					site_visibility = "public"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_visibility.visibility", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_visibility.visibility", "site_visibility", "public"),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_powerpages_website_visibility" "visibility" {
+					environment_id  = "00000000-0000-0000-0000-000000000001"
+					website_id      = "00000000-0000-0000-0000-000000000010"
+					site_visibility = "private"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_visibility.visibility", "site_visibility", "private"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,314 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerpages
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &WebsiteWafResource{}
+
+func NewWebsiteWafResource() resource.Resource {
+	return &WebsiteWafResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "powerpages_website_waf",
+		},
+	}
+}
+
+func (r *WebsiteWafResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *WebsiteWafResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the [web application firewall](https://learn.microsoft.com/power-pages/security/configure-web-application-firewall) rules of a Power Pages website. The firewall is enabled when the resource is created, then its custom rules and the exclusions of its managed rule sets are replaced with the configured ones. " +
+			"Destroying the resource removes the custom rules and exclusions and leaves the firewall enabled.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the website firewall, same as `website_id`",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment where the website is located",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"website_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the website",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"custom_rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Custom rules of the firewall, evaluated by `priority` before the managed rule sets",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Unique name of the rule, made of letters and digits",
+							Required:            true,
+						},
+						"priority": schema.Int64Attribute{
+							MarkdownDescription: "Priority of the rule, rules with a lower value are evaluated first",
+							Required:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"enabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the rule is enabled. Default is `true`",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"rule_type": schema.StringAttribute{
+							MarkdownDescription: "Type of the rule. Valid values are `MatchRule` and `RateLimitRule`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("MatchRule", "RateLimitRule"),
+							},
+						},
+						"action": schema.StringAttribute{
+							MarkdownDescription: "Action taken when the rule matches. Valid values are `Allow`, `Block`, `Log` and `Redirect`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("Allow", "Block", "Log", "Redirect"),
+							},
+						},
+						"rate_limit_duration_in_minutes": schema.Int64Attribute{
+							MarkdownDescription: "Window in minutes in which the requests are counted. Valid values are `1` and `5`. Required for `RateLimitRule` rules",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.OneOf(1, 5),
+							},
+						},
+						"rate_limit_threshold": schema.Int64Attribute{
+							MarkdownDescription: "Number of requests allowed from a client in the window before the action is taken. Required for `RateLimitRule` rules",
+							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+							},
+						},
+						"match_conditions": schema.ListNestedAttribute{
+							MarkdownDescription: "Conditions that must all match for the rule to apply",
+							Required:            true,
+							Validators: []validator.List{
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"match_variable": schema.StringAttribute{
+										MarkdownDescription: "Part of the request to match, for example `RemoteAddr`, `SocketAddr`, `RequestMethod`, `RequestUri`, `QueryString`, `RequestHeader`, `RequestBody`, `PostArgs` or `Cookies`",
+										Required:            true,
+									},
+									"selector": schema.StringAttribute{
+										MarkdownDescription: "Name of the header, cookie or argument to match when `match_variable` is `RequestHeader`, `Cookies`, `QueryString` or `PostArgs`",
+										Optional:            true,
+									},
+									"operator": schema.StringAttribute{
+										MarkdownDescription: "Operator used to compare the request with `match_values`, for example `IPMatch`, `GeoMatch`, `Equal`, `Contains`, `BeginsWith`, `RegEx` or `Any`",
+										Required:            true,
+									},
+									"negate_condition": schema.BoolAttribute{
+										MarkdownDescription: "Whether the result of the condition is negated. Default is `false`",
+										Optional:            true,
+										Computed:            true,
+										Default:             booldefault.StaticBool(false),
+									},
+									"match_values": schema.ListAttribute{
+										MarkdownDescription: "Values to match, for example IP address ranges for `IPMatch` or two letter country codes for `GeoMatch`",
+										ElementType:         types.StringType,
+										Required:            true,
+									},
+									"transforms": schema.ListAttribute{
+										MarkdownDescription: "Transformations applied to the request before matching, for example `Lowercase` or `UrlDecode`",
+										ElementType:         types.StringType,
+										Optional:            true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"managed_rule_exclusions": schema.ListNestedAttribute{
+				MarkdownDescription: "Parts of the requests that are excluded from the evaluation of the managed rule sets, for example a header that legitimately contains values that look like an attack",
+				Optional:            true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"match_variable": schema.StringAttribute{
+							MarkdownDescription: "Part of the request to exclude. Valid values are `RequestHeaderNames`, `RequestCookieNames`, `QueryStringArgNames`, `RequestBodyPostArgNames` and `RequestBodyJsonArgNames`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("RequestHeaderNames", "RequestCookieNames", "QueryStringArgNames", "RequestBodyPostArgNames", "RequestBodyJsonArgNames"),
+							},
+						},
+						"selector_match_operator": schema.StringAttribute{
+							MarkdownDescription: "Operator used to compare the names with `selector`. Valid values are `Equals`, `Contains`, `StartsWith`, `EndsWith` and `EqualsAny`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf("Equals", "Contains", "StartsWith", "EndsWith", "EqualsAny"),
+							},
+						},
+						"selector": schema.StringAttribute{
+							MarkdownDescription: "Name of the header, cookie or argument to exclude",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *WebsiteWafResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.PowerPagesClient = newPowerPagesClient(client.Api)
+	client.Api.AddPreviewApiVersionWarning(&resp.Diagnostics, api.API_SERVICE_POWER_PAGES)
+}
+
+func (r *WebsiteWafResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *WebsiteWafResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.PowerPagesClient.EnableWaf(ctx, plan.EnvironmentId.ValueString(), plan.WebsiteId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	rules, err := r.PowerPagesClient.SetWafRules(ctx, plan.EnvironmentId.ValueString(), plan.WebsiteId.ValueString(), convertToWafCustomRuleDtos(plan.CustomRules), convertToWafRuleExclusionDtos(plan.ManagedRuleExclusions))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertFromWafRulesDto(plan, rules))...)
+}
+
+func (r *WebsiteWafResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *WebsiteWafResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := r.PowerPagesClient.GetWafRules(ctx, state.EnvironmentId.ValueString(), state.WebsiteId.ValueString())
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertFromWafRulesDto(state, rules))...)
+}
+
+func (r *WebsiteWafResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *WebsiteWafResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, err := r.PowerPagesClient.SetWafRules(ctx, plan.EnvironmentId.ValueString(), plan.WebsiteId.ValueString(), convertToWafCustomRuleDtos(plan.CustomRules), convertToWafRuleExclusionDtos(plan.ManagedRuleExclusions))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, convertFromWafRulesDto(plan, rules))...)
+}
+
+func (r *WebsiteWafResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *WebsiteWafResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.PowerPagesClient.SetWafRules(ctx, state.EnvironmentId.ValueString(), state.WebsiteId.ValueString(), nil, nil)
+	if err != nil && customerrors.Code(err) != customerrors.ERROR_OBJECT_NOT_FOUND {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+}
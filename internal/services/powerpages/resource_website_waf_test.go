@@ -0,0 +1,236 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package powerpages_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccWebsiteWafResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_powerpages_website" "website" {
+					environment_id = powerplatform_environment.env.id
+					name           = "` + mocks.TestName() + `"
+					subdomain      = "tfacc-${substr(replace(powerplatform_environment.env.id, "-", ""), 0, 12)}"
+					language_code  = 1033
+				}
+
+				resource "powerplatform_powerpages_website_waf" "waf" {
+					environment_id = powerplatform_environment.env.id
+					website_id     = powerplatform_powerpages_website.website.id
+
+					custom_rules = [
+						{
+							name                           = "RateLimitClients"
+							priority                       = 10
+							rule_type                      = "RateLimitRule"
+							action                         = "Block"
+							rate_limit_duration_in_minutes = 1
+							rate_limit_threshold           = 100
+							match_conditions = [
+								{
+									match_variable = "RequestUri"
+									operator       = "Contains"
+									match_values   = ["/"]
+								}
+							]
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.0.name", "RateLimitClients"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitWebsiteWafResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	wafStatus := "None"
+	wafRules := map[string]any{}
+	_ = json.Unmarshal([]byte(httpmock.File("tests/resource/Validate_Waf/get_waf_rules.json").String()), &wafRules)
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010/getWafStatus?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			response := httpmock.NewStringResponse(http.StatusOK, `{"status":"`+wafStatus+`"}`)
+			if wafStatus == "Creating" {
+				wafStatus = "Created"
+			}
+			return response, nil
+		})
+
+	httpmock.RegisterResponder("POST", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010/enableWaf?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			wafStatus = "Creating"
+			return httpmock.NewStringResponse(http.StatusAccepted, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010/getWafRules?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, wafRules)
+		})
+
+	httpmock.RegisterResponder("PUT", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010/createWafRules?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			if wafStatus != "Created" {
+				return httpmock.NewStringResponse(http.StatusConflict, "web application firewall is not enabled"), nil
+			}
+			body, _ := io.ReadAll(req.Body)
+			rules := map[string]any{}
+			_ = json.Unmarshal(body, &rules)
+
+			// custom rules are created or updated by name, the other ones are kept.
+			customRules, _ := rules["CustomRules"].([]any)
+			for _, existing := range wafRules["CustomRules"].([]any) {
+				if !slices.ContainsFunc(customRules, func(rule any) bool {
+					return rule.(map[string]any)["name"] == existing.(map[string]any)["name"]
+				}) {
+					customRules = append(customRules, existing)
+				}
+			}
+			wafRules["CustomRules"] = customRules
+			wafRules["ManagedRules"] = rules["ManagedRules"]
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `https://api.powerplatform.com/powerpages/environments/00000000-0000-0000-0000-000000000001/websites/00000000-0000-0000-0000-000000000010/deleteWafCustomRules?api-version=2022-03-01-preview`,
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			names := []string{}
+			_ = json.Unmarshal(body, &names)
+
+			customRules := []any{}
+			for _, rule := range wafRules["CustomRules"].([]any) {
+				if !slices.Contains(names, rule.(map[string]any)["name"].(string)) {
+					customRules = append(customRules, rule)
+				}
+			}
+			wafRules["CustomRules"] = customRules
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_powerpages_website_waf" "waf" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					website_id     = "00000000-0000-0000-0000-000000000010"
+
+					custom_rules = [
+						{
+							name                           = "RateLimitClients"
+							priority                       = 10
+							rule_type                      = "RateLimitRule"
+							action                         = "Block"
+							rate_limit_duration_in_minutes = 1
+							rate_limit_threshold           = 100
+							match_conditions = [
+								{
+									match_variable = "RequestUri"
+									operator       = "Contains"
+									match_values   = ["/"]
+								}
+							]
+						},
+						{
+							name      = "AllowOffice"
+							priority  = 1
+							rule_type = "MatchRule"
+							action    = "Allow"
+							match_conditions = [
+								{
+									match_variable = "RemoteAddr"
+									operator       = "IPMatch"
+									match_values   = ["10.0.0.0/8"]
+								}
+							]
+						}
+					]
+
+					managed_rule_exclusions = [
+						{
+							match_variable          = "RequestCookieNames"
+							selector_match_operator = "StartsWith"
+							selector                = "contoso_"
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.#", "2"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.0.name", "RateLimitClients"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.0.enabled", "true"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.0.rate_limit_threshold", "100"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.0.match_conditions.0.negate_condition", "false"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.1.name", "AllowOffice"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.1.match_conditions.0.match_values.0", "10.0.0.0/8"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "managed_rule_exclusions.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "managed_rule_exclusions.0.selector", "contoso_"),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_powerpages_website_waf" "waf" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					website_id     = "00000000-0000-0000-0000-000000000010"
+
+					custom_rules = [
+						{
+							name      = "BlockCountries"
+							priority  = 5
+							enabled   = false
+							rule_type = "MatchRule"
+							action    = "Block"
+							match_conditions = [
+								{
+									match_variable   = "SocketAddr"
+									operator         = "GeoMatch"
+									negate_condition = true
+									match_values     = ["US", "CA"]
+								}
+							]
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.#", "1"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.0.name", "BlockCountries"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.0.enabled", "false"),
+					resource.TestCheckResourceAttr("powerplatform_powerpages_website_waf.waf", "custom_rules.0.match_conditions.0.negate_condition", "true"),
+					resource.TestCheckNoResourceAttr("powerplatform_powerpages_website_waf.waf", "managed_rule_exclusions"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,121 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider_health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment"
+)
+
+const (
+	CHECK_BAPI      = "bapi"
+	CHECK_POWERAPPS = "powerapps"
+	CHECK_DATAVERSE = "dataverse"
+)
+
+func newProviderHealthClient(apiClient *api.Client) client {
+	return client{
+		Api:               apiClient,
+		environmentClient: environment.NewEnvironmentClient(apiClient),
+	}
+}
+
+type client struct {
+	Api               *api.Client
+	environmentClient environment.Client
+}
+
+// GetHealthChecks probes the APIs used by the provider: the tenant in BAPI and, when an environment is given,
+// its apps in the Power Apps API and the caller of its Dataverse Web API.
+func (client *client) GetHealthChecks(ctx context.Context, environmentId string) []healthCheckDto {
+	checks := []healthCheckDto{
+		client.probe(ctx, CHECK_BAPI, client.buildBapiTenantUrl()),
+	}
+	if environmentId == "" {
+		return checks
+	}
+
+	checks = append(checks, client.probe(ctx, CHECK_POWERAPPS, client.buildPowerAppsUrl(environmentId)))
+
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		checks = append(checks, healthCheckDto{
+			Name:  CHECK_DATAVERSE,
+			Error: fmt.Sprintf("could not get the url of the environment: %s", err.Error()),
+		})
+		return checks
+	}
+	return append(checks, client.probe(ctx, CHECK_DATAVERSE, client.buildWhoAmIUrl(environmentHost)))
+}
+
+// probe sends a GET request to the url. Authorization failures are not retried, they are reported with the status code of the response.
+func (client *client) probe(ctx context.Context, name, apiUrl string) healthCheckDto {
+	check := healthCheckDto{
+		Name: name,
+		Url:  apiUrl,
+	}
+
+	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl, nil, nil, []int{http.StatusOK, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound}, nil)
+	if resp != nil && resp.HttpResponse != nil {
+		check.Reachable = true
+		check.StatusCode = resp.HttpResponse.StatusCode
+	}
+
+	var unexpectedStatusCodeError customerrors.UnexpectedHttpStatusCodeError
+	switch {
+	case errors.As(err, &unexpectedStatusCodeError):
+		check.Reachable = true
+		check.StatusCode = unexpectedStatusCodeError.StatusCode
+		check.Error = err.Error()
+	case err != nil:
+		check.Error = err.Error()
+	case check.StatusCode != http.StatusOK:
+		check.Error = fmt.Sprintf("the request was rejected with status %s: %s", resp.HttpResponse.Status, resp.BodyAsBytes)
+	default:
+		check.Authorized = true
+	}
+	return check
+}
+
+func (client *client) buildBapiTenantUrl() string {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.BapiUrl,
+		Path:   "/providers/Microsoft.BusinessAppPlatform/tenant",
+	}
+	values := url.Values{}
+	values.Add("api-version", "2021-04-01")
+	apiUrl.RawQuery = values.Encode()
+	return apiUrl.String()
+}
+
+func (client *client) buildPowerAppsUrl(environmentId string) string {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   client.Api.GetConfig().Urls.PowerAppsUrl,
+		Path:   fmt.Sprintf("/providers/Microsoft.PowerApps/scopes/admin/environments/%s/apps", environmentId),
+	}
+	values := url.Values{}
+	values.Add("api-version", "2023-06-01")
+	values.Add("$top", "1")
+	apiUrl.RawQuery = values.Encode()
+	return apiUrl.String()
+}
+
+func (client *client) buildWhoAmIUrl(environmentHost string) string {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/WhoAmI", client.Api.GetConfig().GetDataverseApiVersion()),
+	}
+	return apiUrl.String()
+}
@@ -0,0 +1,133 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider_health
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var (
+	_ datasource.DataSource              = &DataSource{}
+	_ datasource.DataSourceWithConfigure = &DataSource{}
+)
+
+func NewProviderHealthDataSource() datasource.DataSource {
+	return &DataSource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "provider_health",
+		},
+	}
+}
+
+func (d *DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	d.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = d.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Probes the connectivity and the permissions of the provider credentials to the Power Platform APIs, such as in a smoke test stage of a pipeline before applying a large plan. The tenant is read from the Business Application Platform API and, when `environment_id` is set, the apps of the environment from the Power Apps API and the caller from the [Dataverse Web API](https://learn.microsoft.com/power-apps/developer/data-platform/webapi/reference/whoami). Failed probes are reported in `checks` instead of failing the data source, use a `postcondition` on `healthy` to stop the run.",
+		Attributes: map[string]schema.Attribute{
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the environment to probe the Power Apps API and Dataverse of. Only the Business Application Platform API is probed when it is not set.",
+				Optional:            true,
+			},
+			"healthy": schema.BoolAttribute{
+				MarkdownDescription: "True when every probe was authorized.",
+				Computed:            true,
+			},
+			"checks": schema.ListNestedAttribute{
+				MarkdownDescription: "Result of each probe.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the probed API: `bapi`, `powerapps` or `dataverse`.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Url of the request sent to the API.",
+							Computed:            true,
+						},
+						"reachable": schema.BoolAttribute{
+							MarkdownDescription: "True when the API answered the request, whatever its status code.",
+							Computed:            true,
+						},
+						"authorized": schema.BoolAttribute{
+							MarkdownDescription: "True when the API answered the request successfully.",
+							Computed:            true,
+						},
+						"status_code": schema.Int64Attribute{
+							MarkdownDescription: "HTTP status code of the response, `0` when the API could not be reached.",
+							Computed:            true,
+						},
+						"error": schema.StringAttribute{
+							MarkdownDescription: "Why the probe failed, empty when it was authorized.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	var config DataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checks := d.ProviderHealthClient.GetHealthChecks(ctx, config.EnvironmentId.ValueString())
+	for _, check := range checks {
+		if !check.Authorized {
+			tflog.Warn(ctx, fmt.Sprintf("%s health check failed: %s", check.Name, check.Error))
+		}
+	}
+
+	state := convertFromHealthCheckDtos(config.EnvironmentId, checks)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (d *DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, d.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.ProviderHealthClient = newProviderHealthClient(client.Api)
+}
@@ -0,0 +1,136 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider_health_test
+
+import (
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestAccProviderHealthDataSource_Validate_Read(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				data "powerplatform_provider_health" "health" {
+					environment_id = powerplatform_environment.env.id
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.health", "healthy", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.health", "checks.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func registerProviderHealthMocks() {
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/tenant?api-version=2021-04-01`,
+		func(_ *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_tenant.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.powerapps.com/providers/Microsoft.PowerApps/scopes/admin/environments/00000000-0000-0000-0000-000000000001/apps?%24top=1&api-version=2023-06-01`,
+		func(_ *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_apps.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(_ *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+}
+
+func TestUnitProviderHealthDataSource_Validate_Read(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerProviderHealthMocks()
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/WhoAmI`,
+		func(_ *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/datasource/Validate_Read/get_whoami.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_provider_health" "tenant" {}
+
+				data "powerplatform_provider_health" "environment" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.tenant", "healthy", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.tenant", "checks.#", "1"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.tenant", "checks.0.name", "bapi"),
+
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "healthy", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "checks.#", "3"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "checks.1.name", "powerapps"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "checks.1.status_code", "200"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "checks.2.name", "dataverse"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "checks.2.url", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/WhoAmI"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "checks.2.reachable", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "checks.2.authorized", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "checks.2.error", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitProviderHealthDataSource_Validate_Read_Forbidden(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerProviderHealthMocks()
+
+	httpmock.RegisterResponder("GET", `https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/WhoAmI`,
+		httpmock.NewStringResponder(http.StatusForbidden, `{"error":{"code":"0x80072560","message":"The user is not a member of the organization."}}`))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "powerplatform_provider_health" "environment" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "healthy", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "checks.0.authorized", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "checks.2.reachable", "true"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "checks.2.authorized", "false"),
+					resource.TestCheckResourceAttr("data.powerplatform_provider_health.environment", "checks.2.status_code", "403"),
+					resource.TestMatchResourceAttr("data.powerplatform_provider_health.environment", "checks.2.error", regexp.MustCompile(`not a member of the organization`)),
+				),
+			},
+		},
+	})
+}
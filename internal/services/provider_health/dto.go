@@ -0,0 +1,13 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider_health
+
+type healthCheckDto struct {
+	Name       string
+	Url        string
+	Reachable  bool
+	Authorized bool
+	StatusCode int
+	Error      string
+}
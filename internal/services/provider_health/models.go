@@ -0,0 +1,51 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package provider_health
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+type DataSource struct {
+	helpers.TypeInfo
+	ProviderHealthClient client
+}
+
+type DataSourceModel struct {
+	EnvironmentId types.String       `tfsdk:"environment_id"`
+	Healthy       types.Bool         `tfsdk:"healthy"`
+	Checks        []HealthCheckModel `tfsdk:"checks"`
+}
+
+type HealthCheckModel struct {
+	Name       types.String `tfsdk:"name"`
+	Url        types.String `tfsdk:"url"`
+	Reachable  types.Bool   `tfsdk:"reachable"`
+	Authorized types.Bool   `tfsdk:"authorized"`
+	StatusCode types.Int64  `tfsdk:"status_code"`
+	Error      types.String `tfsdk:"error"`
+}
+
+func convertFromHealthCheckDtos(environmentId types.String, checks []healthCheckDto) DataSourceModel {
+	state := DataSourceModel{
+		EnvironmentId: environmentId,
+		Healthy:       types.BoolValue(true),
+		Checks:        make([]HealthCheckModel, 0, len(checks)),
+	}
+	for _, check := range checks {
+		if !check.Authorized {
+			state.Healthy = types.BoolValue(false)
+		}
+		state.Checks = append(state.Checks, HealthCheckModel{
+			Name:       types.StringValue(check.Name),
+			Url:        types.StringValue(check.Url),
+			Reachable:  types.BoolValue(check.Reachable),
+			Authorized: types.BoolValue(check.Authorized),
+			StatusCode: types.Int64Value(int64(check.StatusCode)),
+			Error:      types.StringValue(check.Error),
+		})
+	}
+	return state
+}
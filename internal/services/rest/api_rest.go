@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/microsoft/terraform-provider-power-platform/internal/api"
 	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 )
 
 func newWebApiClient(apiClient *api.Client) client {
@@ -56,7 +57,7 @@ func (client *client) SendOperation(ctx context.Context, operation *DataverseWeb
 	}
 
 	if res != nil && res.HttpResponse != nil {
-		tflog.Trace(ctx, fmt.Sprintf("SendOperation Response: %v", res.BodyAsBytes))
+		tflog.Trace(ctx, fmt.Sprintf("SendOperation Response: %s", helpers.ScrubSensitiveValues(res.BodyAsBytes)))
 		tflog.Trace(ctx, fmt.Sprintf("SendOperation Response Status: %v", res.HttpResponse.Status))
 	}
 
@@ -4,13 +4,20 @@
 package solution
 
 import (
+	"archive/zip"
+	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/xml"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/microsoft/terraform-provider-power-platform/internal/api"
@@ -18,6 +25,31 @@ import (
 	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
 )
 
+const (
+	IMPORT_STRATEGY_UPDATE  = "Update"
+	IMPORT_STRATEGY_UPGRADE = "Upgrade"
+
+	ASYNC_OPERATION_STATUS_FAILED   = 31
+	ASYNC_OPERATION_STATUS_CANCELED = 32
+
+	ENVIRONMENT_VARIABLE_TYPE_SECRET = "100000005"
+)
+
+// asyncOperationStatusNames maps the statuscode values of a Dataverse async operation to their names.
+var asyncOperationStatusNames = map[int]string{
+	0:                               "WaitingForResources",
+	10:                              "Waiting",
+	20:                              "InProgress",
+	21:                              "Pausing",
+	22:                              "Canceling",
+	30:                              "Succeeded",
+	ASYNC_OPERATION_STATUS_FAILED:   "Failed",
+	ASYNC_OPERATION_STATUS_CANCELED: "Canceled",
+}
+
+// keyVaultSecretReferenceRegex matches the Azure Key Vault secret references that are the values of secret environment variables.
+var keyVaultSecretReferenceRegex = regexp.MustCompile(`(?i)^/subscriptions/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}/resourceGroups/[^/]+/providers/Microsoft\.KeyVault/vaults/[^/]+/secrets/[^/]+$`)
+
 func NewSolutionClient(apiClient *api.Client) Client {
 	return Client{
 		Api: apiClient,
@@ -45,7 +77,7 @@ func (client *Client) GetSolutionUniqueName(ctx context.Context, environmentId,
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.2/solutions",
+		Path:   fmt.Sprintf("/api/data/%s/solutions", client.Api.GetConfig().GetDataverseApiVersion()),
 	}
 	values := url.Values{}
 	values.Add("$expand", "publisherid")
@@ -53,16 +85,10 @@ func (client *Client) GetSolutionUniqueName(ctx context.Context, environmentId,
 	apiUrl.RawQuery = values.Encode()
 
 	solutions := solutionArrayDto{}
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &solutions)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &solutions)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 	if len(solutions.Value) == 0 {
 		return nil, customerrors.WrapIntoProviderError(err, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("solution with unique name '%s' not found", name))
 	}
@@ -81,7 +107,7 @@ func (client *Client) GetSolutionById(ctx context.Context, environmentId, soluti
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.2/solutions",
+		Path:   fmt.Sprintf("/api/data/%s/solutions", client.Api.GetConfig().GetDataverseApiVersion()),
 	}
 	values := url.Values{}
 	values.Add("$expand", "publisherid")
@@ -89,16 +115,10 @@ func (client *Client) GetSolutionById(ctx context.Context, environmentId, soluti
 	apiUrl.RawQuery = values.Encode()
 
 	solutions := solutionArrayDto{}
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &solutions)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &solutions)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 	if len(solutions.Value) == 0 {
 		return nil, customerrors.WrapIntoProviderError(err, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("solution with id '%s' not found", solutionId))
 	}
@@ -117,7 +137,7 @@ func (client *Client) GetSolutions(ctx context.Context, environmentId string) ([
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.2/solutions",
+		Path:   fmt.Sprintf("/api/data/%s/solutions", client.Api.GetConfig().GetDataverseApiVersion()),
 	}
 	values := url.Values{}
 	values.Add("$expand", "publisherid")
@@ -126,16 +146,10 @@ func (client *Client) GetSolutions(ctx context.Context, environmentId string) ([
 	apiUrl.RawQuery = values.Encode()
 
 	solutionArray := solutionArrayDto{}
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &solutionArray)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &solutionArray)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 
 	for inx := range solutionArray.Value {
 		solutionArray.Value[inx].EnvironmentId = environmentId
@@ -147,7 +161,7 @@ func (client *Client) GetSolutions(ctx context.Context, environmentId string) ([
 	return solutions, nil
 }
 
-func (client *Client) CreateSolution(ctx context.Context, environmentId string, content []byte, settings []byte) (*SolutionDto, error) {
+func (client *Client) CreateSolution(ctx context.Context, environmentId string, content []byte, settings []byte, connectionMappings map[string]string, environmentVariableValues map[string]string, importStrategy string) (*SolutionDto, error) {
 	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
 	if err != nil {
 		return nil, err
@@ -165,20 +179,14 @@ func (client *Client) CreateSolution(ctx context.Context, environmentId string,
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.2/StageSolution",
+		Path:   fmt.Sprintf("/api/data/%s/StageSolution", client.Api.GetConfig().GetDataverseApiVersion()),
 	}
 
 	stageSolutionResponse := stageSolutionImportResponseDto{}
-	resp, err := client.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, stageSolutionRequestBody, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &stageSolutionResponse)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "POST", apiUrl.String(), nil, stageSolutionRequestBody, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &stageSolutionResponse)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return nil, err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return nil, err
-	}
 	if stageSolutionResponse.StageSolutionResults.StageSolutionStatus != "Passed" {
 		e := fmt.Errorf("solution failed with status: '%s'", stageSolutionResponse.StageSolutionResults.StageSolutionStatus)
 
@@ -191,14 +199,26 @@ func (client *Client) CreateSolution(ctx context.Context, environmentId string,
 		return nil, e
 	}
 
-	solutionComponents, err := client.createSolutionComponentParameters(settings)
+	solutionComponents, err := client.createSolutionComponentParameters(content, settings, connectionMappings, environmentVariableValues)
 	if err != nil {
 		return nil, err
 	}
 
+	solutionUniqueName := stageSolutionResponse.StageSolutionResults.SolutionDetails.SolutionUniqueName
+	upgrade := false
+	if importStrategy == IMPORT_STRATEGY_UPGRADE && stageSolutionResponse.StageSolutionResults.SolutionDetails.IsManaged {
+		// a solution that is not installed yet can't be upgraded, it is simply imported.
+		_, err := client.GetSolutionUniqueName(ctx, environmentId, solutionUniqueName)
+		if err != nil && customerrors.Code(err) != customerrors.ERROR_OBJECT_NOT_FOUND {
+			return nil, err
+		}
+		upgrade = err == nil
+	}
+
 	importSolutionRequestBody := importSolutionDto{
 		PublishWorkflows:                 true,
 		OverwriteUnmanagedCustomizations: false,
+		HoldingSolution:                  upgrade,
 		ComponentParameters:              solutionComponents,
 		SolutionParameters: importSolutionSolutionParametersDto{
 			StageSolutionUploadId: stageSolutionResponse.StageSolutionResults.StageSolutionUploadId,
@@ -208,74 +228,163 @@ func (client *Client) CreateSolution(ctx context.Context, environmentId string,
 	apiUrl = &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   "/api/data/v9.2/ImportSolutionAsync",
+		Path:   fmt.Sprintf("/api/data/%s/ImportSolutionAsync", client.Api.GetConfig().GetDataverseApiVersion()),
 	}
 	importSolutionResponse := importSolutionResponseDto{}
-	_, err = client.Api.Execute(ctx, nil, "POST", apiUrl.String(), nil, importSolutionRequestBody, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &importSolutionResponse)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "POST", apiUrl.String(), nil, importSolutionRequestBody, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &importSolutionResponse)
 	if err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
+
+	if _, err := client.waitForAsyncOperation(ctx, environmentHost, importSolutionResponse.AsyncOperationId, fmt.Sprintf("Import of solution '%s'", solutionUniqueName)); err != nil {
 		return nil, err
 	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
+	err = client.validateSolutionImportResult(ctx, environmentHost, importSolutionResponse.ImportJobKey)
+	if err != nil {
 		return nil, err
 	}
 
-	// pull for solution import completion.
+	if upgrade {
+		if err := client.deleteAndPromoteSolution(ctx, environmentHost, solutionUniqueName); err != nil {
+			return nil, err
+		}
+	}
+
+	solution, err := client.GetSolutionUniqueName(ctx, environmentId, solutionUniqueName)
+	if err != nil {
+		return nil, err
+	}
+	return solution, nil
+}
+
+// CloneAsPatch creates an unmanaged patch of the solution, which holds the changes to the solution until they are rolled up with CloneAsSolution.
+// The version must have the major and minor numbers of the solution and a higher build or revision number.
+func (client *Client) CloneAsPatch(ctx context.Context, environmentId, parentSolutionName, displayName, version string) (*SolutionDto, error) {
+	return client.cloneSolution(ctx, environmentId, "CloneAsPatch", parentSolutionName, displayName, version)
+}
+
+// CloneAsSolution rolls the patches of the solution up into a new version of the solution and removes the patches.
+// The version must have a higher major or minor number than the solution.
+func (client *Client) CloneAsSolution(ctx context.Context, environmentId, parentSolutionName, displayName, version string) (*SolutionDto, error) {
+	return client.cloneSolution(ctx, environmentId, "CloneAsSolution", parentSolutionName, displayName, version)
+}
+
+func (client *Client) cloneSolution(ctx context.Context, environmentId, action, parentSolutionName, displayName, version string) (*SolutionDto, error) {
+	environmentHost, err := client.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/%s", client.Api.GetConfig().GetDataverseApiVersion(), action),
+	}
+
+	cloneRequestBody := cloneSolutionDto{
+		ParentSolutionUniqueName: parentSolutionName,
+		DisplayName:              displayName,
+		VersionNumber:            version,
+	}
+
+	cloneResponse := cloneSolutionResponseDto{}
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "POST", apiUrl.String(), nil, cloneRequestBody, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &cloneResponse)
+	if err != nil {
+		return nil, err
+	}
+	return client.GetSolutionById(ctx, environmentId, cloneResponse.SolutionId)
+}
+
+// deleteAndPromoteSolution replaces the installed managed solution with the holding solution imported for the upgrade,
+// removing the components that are no longer part of the solution.
+func (client *Client) deleteAndPromoteSolution(ctx context.Context, environmentHost, solutionUniqueName string) error {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/DeleteAndPromoteAsync", client.Api.GetConfig().GetDataverseApiVersion()),
+	}
+
+	deleteAndPromoteResponse := deleteAndPromoteResponseDto{}
+	_, err := client.Api.ExecuteExpecting(ctx, nil, "POST", apiUrl.String(), nil, deleteAndPromoteDto{UniqueName: solutionUniqueName}, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &deleteAndPromoteResponse)
+	if err != nil {
+		return err
+	}
+
+	asyncOperation, err := client.waitForAsyncOperation(ctx, environmentHost, deleteAndPromoteResponse.AsyncOperationId, fmt.Sprintf("Upgrade of solution '%s'", solutionUniqueName))
+	if err != nil {
+		return err
+	}
+	if asyncOperation.StatusCode == ASYNC_OPERATION_STATUS_FAILED || asyncOperation.StatusCode == ASYNC_OPERATION_STATUS_CANCELED {
+		return fmt.Errorf("solution upgrade failed: %s", asyncOperation.Message)
+	}
+	return nil
+}
+
+// waitForAsyncOperation polls the Dataverse async operation until it is completed.
+func (client *Client) waitForAsyncOperation(ctx context.Context, environmentHost, asyncOperationId, operationName string) (*asyncSolutionPullResponseDto, error) {
 	if err := client.Api.SleepWithContext(ctx, api.DefaultRetryAfter()); err != nil {
 		return nil, err
 	}
 
-	apiUrl = &url.URL{
+	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   fmt.Sprintf("/api/data/v9.2/asyncoperations(%s)", importSolutionResponse.AsyncOperationId),
+		Path:   fmt.Sprintf("/api/data/%s/asyncoperations(%s)", client.Api.GetConfig().GetDataverseApiVersion(), asyncOperationId),
 	}
+	progress := client.Api.NewOperationProgress(operationName)
 	for {
 		asyncSolutionPullResponse := asyncSolutionPullResponseDto{}
-		_, err = client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &asyncSolutionPullResponse)
+		_, err := client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &asyncSolutionPullResponse)
 		if err != nil {
 			return nil, err
 		}
-		if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-			return nil, err
-		}
-		if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-			return nil, err
-		}
 		if asyncSolutionPullResponse.CompletedOn != "" {
-			err = client.validateSolutionImportResult(ctx, environmentHost, importSolutionResponse.ImportJobKey)
-			if err != nil {
-				return nil, err
-			}
-			solution, err := client.GetSolutionUniqueName(ctx, environmentId, stageSolutionResponse.StageSolutionResults.SolutionDetails.SolutionUniqueName)
-			if err != nil {
-				return nil, err
-			}
-			return solution, nil
+			return &asyncSolutionPullResponse, nil
 		}
+		progress.Report(ctx, asyncOperationStatus(&asyncSolutionPullResponse), -1)
 		if err := client.Api.SleepWithContext(ctx, api.DefaultRetryAfter()); err != nil {
 			return nil, err
 		}
 	}
 }
 
-func (client *Client) createSolutionComponentParameters(settings []byte) ([]any, error) {
-	if len(settings) == 0 {
+// asyncOperationStatus returns the name of the status code of an async operation, followed by its message when it has one.
+func asyncOperationStatus(asyncOperation *asyncSolutionPullResponseDto) string {
+	status, ok := asyncOperationStatusNames[asyncOperation.StatusCode]
+	if !ok {
+		status = strconv.Itoa(asyncOperation.StatusCode)
+	}
+	if asyncOperation.Message != "" {
+		status = fmt.Sprintf("%s: %s", status, asyncOperation.Message)
+	}
+	return status
+}
+
+func (client *Client) createSolutionComponentParameters(content []byte, settings []byte, connectionMappings map[string]string, environmentVariableValues map[string]string) ([]any, error) {
+	if len(settings) == 0 && len(connectionMappings) == 0 && len(environmentVariableValues) == 0 {
 		return nil, nil
 	}
 
 	solutionSettings := solutionSettingsDto{}
-	if settings != nil {
+	if len(settings) > 0 {
 		err := json.Unmarshal(settings, &solutionSettings)
 		if err != nil {
 			return nil, err
 		}
 	}
 
+	connectionReferences, err := mergeConnectionMappings(content, solutionSettings.ConnectionReferences, connectionMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	environmentVariables, err := mergeEnvironmentVariableValues(content, solutionSettings.EnvironmentVariables, environmentVariableValues)
+	if err != nil {
+		return nil, err
+	}
+
 	solutionComponents := make([]any, 0)
-	for _, connectionReferenceComponent := range solutionSettings.ConnectionReferences {
+	for _, connectionReferenceComponent := range connectionReferences {
 		solutionComponents = append(solutionComponents, importSolutionConnectionReferencesDto{
 			Type:                           "Microsoft.Dynamics.CRM.connectionreference",
 			ConnectionId:                   connectionReferenceComponent.ConnectionId,
@@ -285,7 +394,7 @@ func (client *Client) createSolutionComponentParameters(settings []byte) ([]any,
 			Description:                    "",
 		})
 	}
-	for _, envVariableComponent := range solutionSettings.EnvironmentVariables {
+	for _, envVariableComponent := range environmentVariables {
 		if envVariableComponent.Value != "" {
 			solutionComponents = append(solutionComponents, importSolutionEnvironmentVariablesDto{
 				Type:       "Microsoft.Dynamics.CRM.environmentvariablevalue",
@@ -301,24 +410,179 @@ func (client *Client) createSolutionComponentParameters(settings []byte) ([]any,
 	return solutionComponents, nil
 }
 
+// mergeConnectionMappings applies the connection ids from connection_mappings on top of the connection references of the settings file.
+// Connection references that are only present in the mappings take their connector id from the solution's customizations.xml.
+func mergeConnectionMappings(content []byte, connectionReferences []settingsConnectionReferencesDto, connectionMappings map[string]string) ([]settingsConnectionReferencesDto, error) {
+	if len(connectionMappings) == 0 {
+		return connectionReferences, nil
+	}
+
+	merged := make([]settingsConnectionReferencesDto, 0, len(connectionReferences)+len(connectionMappings))
+	mapped := make(map[string]bool, len(connectionMappings))
+	for _, connectionReference := range connectionReferences {
+		if connectionId, ok := connectionMappings[connectionReference.LogicalName]; ok {
+			connectionReference.ConnectionId = connectionId
+			mapped[connectionReference.LogicalName] = true
+		}
+		merged = append(merged, connectionReference)
+	}
+
+	if len(mapped) == len(connectionMappings) {
+		return merged, nil
+	}
+
+	solutionConnectionReferences, err := getSolutionConnectionReferences(content)
+	if err != nil {
+		return nil, err
+	}
+
+	logicalNames := make([]string, 0, len(connectionMappings))
+	for logicalName := range connectionMappings {
+		logicalNames = append(logicalNames, logicalName)
+	}
+	sort.Strings(logicalNames)
+
+	for _, logicalName := range logicalNames {
+		if mapped[logicalName] {
+			continue
+		}
+		connectorId, ok := solutionConnectionReferences[logicalName]
+		if !ok {
+			return nil, fmt.Errorf("connection reference '%s' from connection_mappings was not found in the solution or the settings file", logicalName)
+		}
+		merged = append(merged, settingsConnectionReferencesDto{
+			LogicalName:  logicalName,
+			ConnectionId: connectionMappings[logicalName],
+			ConnectorId:  connectorId,
+		})
+	}
+	return merged, nil
+}
+
+// getSolutionConnectionReferences reads the connection references of a solution zip and returns their connector ids by logical name.
+func getSolutionConnectionReferences(content []byte) (map[string]string, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read solution file: %w", err)
+	}
+
+	for _, file := range zipReader.File {
+		if !strings.EqualFold(file.Name, "customizations.xml") {
+			continue
+		}
+
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		defer reader.Close()
+
+		customizations := solutionCustomizationsDto{}
+		if err := xml.NewDecoder(reader).Decode(&customizations); err != nil {
+			return nil, fmt.Errorf("unable to parse customizations.xml of the solution file: %w", err)
+		}
+
+		connectionReferences := make(map[string]string, len(customizations.ConnectionReferences))
+		for _, connectionReference := range customizations.ConnectionReferences {
+			connectionReferences[connectionReference.LogicalName] = connectionReference.ConnectorId
+		}
+		return connectionReferences, nil
+	}
+	return nil, errors.New("customizations.xml not found in the solution file")
+}
+
+// mergeEnvironmentVariableValues applies the values from environment_variable_values on top of the environment variables of the settings file,
+// and validates that the values of secret environment variables reference an Azure Key Vault secret.
+func mergeEnvironmentVariableValues(content []byte, environmentVariables []settingsEnvironmentVariableDto, environmentVariableValues map[string]string) ([]settingsEnvironmentVariableDto, error) {
+	if len(environmentVariables) == 0 && len(environmentVariableValues) == 0 {
+		return environmentVariables, nil
+	}
+
+	merged := make([]settingsEnvironmentVariableDto, 0, len(environmentVariables)+len(environmentVariableValues))
+	valued := make(map[string]bool, len(environmentVariableValues))
+	for _, environmentVariable := range environmentVariables {
+		if value, ok := environmentVariableValues[environmentVariable.SchemaName]; ok {
+			environmentVariable.Value = value
+			valued[environmentVariable.SchemaName] = true
+		}
+		merged = append(merged, environmentVariable)
+	}
+
+	schemaNames := make([]string, 0, len(environmentVariableValues))
+	for schemaName := range environmentVariableValues {
+		schemaNames = append(schemaNames, schemaName)
+	}
+	sort.Strings(schemaNames)
+
+	solutionEnvironmentVariables, err := getSolutionEnvironmentVariableTypes(content)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, schemaName := range schemaNames {
+		if valued[schemaName] {
+			continue
+		}
+		if _, ok := solutionEnvironmentVariables[schemaName]; !ok {
+			return nil, fmt.Errorf("environment variable '%s' from environment_variable_values was not found in the solution or the settings file", schemaName)
+		}
+		merged = append(merged, settingsEnvironmentVariableDto{
+			SchemaName: schemaName,
+			Value:      environmentVariableValues[schemaName],
+		})
+	}
+
+	for _, environmentVariable := range merged {
+		if environmentVariable.Value == "" || solutionEnvironmentVariables[environmentVariable.SchemaName] != ENVIRONMENT_VARIABLE_TYPE_SECRET {
+			continue
+		}
+		if !keyVaultSecretReferenceRegex.MatchString(environmentVariable.Value) {
+			return nil, fmt.Errorf("environment variable '%s' is a secret, its value must reference an Azure Key Vault secret using the format '/subscriptions/{subscription id}/resourceGroups/{resource group}/providers/Microsoft.KeyVault/vaults/{key vault}/secrets/{secret}'", environmentVariable.SchemaName)
+		}
+	}
+	return merged, nil
+}
+
+// getSolutionEnvironmentVariableTypes reads the environment variable definitions of a solution zip and returns their types by schema name.
+func getSolutionEnvironmentVariableTypes(content []byte) (map[string]string, error) {
+	zipReader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read solution file: %w", err)
+	}
+
+	environmentVariables := map[string]string{}
+	for _, file := range zipReader.File {
+		if !strings.EqualFold(path.Base(file.Name), "environmentvariabledefinition.xml") {
+			continue
+		}
+
+		reader, err := file.Open()
+		if err != nil {
+			return nil, err
+		}
+		definition := solutionEnvironmentVariableDefinitionDto{}
+		err = xml.NewDecoder(reader).Decode(&definition)
+		reader.Close()
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse %s of the solution file: %w", file.Name, err)
+		}
+		environmentVariables[definition.SchemaName] = definition.Type
+	}
+	return environmentVariables, nil
+}
+
 func (client *Client) validateSolutionImportResult(ctx context.Context, environmentHost, importJobKey string) error {
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   fmt.Sprintf("/api/data/v9.0/RetrieveSolutionImportResult(ImportJobId=%s)", importJobKey),
+		Path:   fmt.Sprintf("/api/data/%s/RetrieveSolutionImportResult(ImportJobId=%s)", client.Api.GetConfig().GetDataverseApiVersion(), importJobKey),
 	}
 
 	validateSolutionImportResponseDto := validateSolutionImportResponseDto{}
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &validateSolutionImportResponseDto)
+	_, err := client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &validateSolutionImportResponseDto)
 	if err != nil {
 		return err
 	}
-	if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return err
-	}
-	if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return err
-	}
 	if validateSolutionImportResponseDto.SolutionOperationResult.Status != "Passed" {
 		return fmt.Errorf("solution import failed: %s", validateSolutionImportResponseDto.SolutionOperationResult.ErrorMessages...)
 	}
@@ -333,15 +597,11 @@ func (client *Client) DeleteSolution(ctx context.Context, environmentId, solutio
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   fmt.Sprintf("/api/data/v9.2/solutions(%s)", solutionId),
+		Path:   fmt.Sprintf("/api/data/%s/solutions(%s)", client.Api.GetConfig().GetDataverseApiVersion(), solutionId),
 	}
-	resp, err := client.Api.Execute(ctx, nil, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusNoContent, http.StatusForbidden, http.StatusNotFound}, nil)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "DELETE", apiUrl.String(), nil, nil, []int{http.StatusNoContent}, api.NotFoundOrForbiddenResponseErrors, nil)
 	if err != nil {
 		return err
-	} else if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return err
-	} else if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return err
 	}
 	return nil
 }
@@ -354,18 +614,14 @@ func (client *Client) GetTableData(ctx context.Context, environmentId, tableName
 	apiUrl := &url.URL{
 		Scheme: constants.HTTPS,
 		Host:   environmentHost,
-		Path:   fmt.Sprintf("/api/data/v9.2/%s", tableName),
+		Path:   fmt.Sprintf("/api/data/%s/%s", client.Api.GetConfig().GetDataverseApiVersion(), tableName),
 	}
 	if odataQuery != "" {
 		apiUrl.RawQuery = odataQuery
 	}
-	resp, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK, http.StatusForbidden, http.StatusNotFound}, &responseObj)
+	_, err = client.Api.ExecuteExpecting(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, api.NotFoundOrForbiddenResponseErrors, &responseObj)
 	if err != nil {
 		return err
-	} else if err := client.Api.HandleForbiddenResponse(resp); err != nil {
-		return err
-	} else if err := client.Api.HandleNotFoundResponse(resp); err != nil {
-		return err
 	}
 	return nil
 }
@@ -401,7 +657,7 @@ func (client *Client) getEnvironment(ctx context.Context, environmentId string)
 	env := environmentIdDto{}
 	_, err := client.Api.Execute(ctx, nil, "GET", apiUrl.String(), nil, nil, []int{http.StatusOK}, &env)
 	if err != nil {
-		var httpError *customerrors.UnexpectedHttpStatusCodeError
+		var httpError customerrors.UnexpectedHttpStatusCodeError
 		if errors.As(err, &httpError) && httpError.StatusCode == http.StatusNotFound {
 			return nil, customerrors.WrapIntoProviderError(err, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("environment %s not found", environmentId))
 		}
@@ -94,6 +94,14 @@ func (d *DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, r
 							MarkdownDescription: "Is managed",
 							Computed:            true,
 						},
+						"publisher_name": schema.StringAttribute{
+							MarkdownDescription: "Unique name of the publisher of the solution",
+							Computed:            true,
+						},
+						"publisher_display_name": schema.StringAttribute{
+							MarkdownDescription: "Display name of the publisher of the solution",
+							Computed:            true,
+						},
 					},
 				},
 			},
@@ -57,6 +57,8 @@ func TestUnitSolutionsDataSource_Validate_Read(t *testing.T) {
 					resource.TestCheckResourceAttr("data.powerplatform_solutions.all", "solutions.0.is_managed", "true"),
 					resource.TestCheckResourceAttr("data.powerplatform_solutions.all", "solutions.0.version", "9.2.1.1020"),
 					resource.TestCheckResourceAttr("data.powerplatform_solutions.all", "solutions.0.id", "70edca66-e4c2-4384-92e0-4300465c1894"),
+					resource.TestCheckResourceAttr("data.powerplatform_solutions.all", "solutions.0.publisher_name", "microsoftdynamics"),
+					resource.TestCheckResourceAttr("data.powerplatform_solutions.all", "solutions.0.publisher_display_name", "microsoftdynamics"),
 				),
 			},
 		},
@@ -19,16 +19,42 @@ type settingsConnectionReferencesDto struct {
 	ConnectorId  string `json:"connectorid"`
 }
 
+// solutionCustomizationsDto is the part of the customizations.xml file inside a solution zip that describes the connection references of the solution.
+type solutionCustomizationsDto struct {
+	ConnectionReferences []solutionCustomizationsConnectionReferenceDto `xml:"connectionreferences>connectionreference"`
+}
+
+// solutionEnvironmentVariableDefinitionDto is the environmentvariabledefinition.xml file inside a solution zip that describes an environment variable of the solution.
+type solutionEnvironmentVariableDefinitionDto struct {
+	SchemaName string `xml:"schemaname,attr"`
+	Type       string `xml:"type"`
+}
+
+type solutionCustomizationsConnectionReferenceDto struct {
+	LogicalName string `xml:"connectionreferencelogicalname,attr"`
+	DisplayName string `xml:"connectionreferencedisplayname"`
+	ConnectorId string `xml:"connectorid"`
+}
+
 type SolutionDto struct {
-	Id            string `json:"solutionid"`
-	EnvironmentId string `json:"environment_id"`
-	Name          string `json:"uniquename"`
-	DisplayName   string `json:"friendlyname"`
-	IsManaged     bool   `json:"ismanaged"`
-	CreatedTime   string `json:"createdon"`
-	Version       string `json:"version"`
-	ModifiedTime  string `json:"modifiedon"`
-	InstallTime   string `json:"installedon"`
+	Id            string       `json:"solutionid"`
+	EnvironmentId string       `json:"environment_id"`
+	Name          string       `json:"uniquename"`
+	DisplayName   string       `json:"friendlyname"`
+	IsManaged     bool         `json:"ismanaged"`
+	CreatedTime   string       `json:"createdon"`
+	Version       string       `json:"version"`
+	ModifiedTime  string       `json:"modifiedon"`
+	InstallTime   string       `json:"installedon"`
+	Publisher     publisherDto `json:"publisherid"`
+	// ParentId is the id of the solution that a patch was cloned from, empty for solutions that are not patches.
+	ParentId string `json:"_parentsolutionid_value"`
+}
+
+type publisherDto struct {
+	Id          string `json:"publisherid"`
+	Name        string `json:"uniquename"`
+	DisplayName string `json:"friendlyname"`
 }
 
 type solutionArrayDto struct {
@@ -84,6 +110,7 @@ type stageSolutionSolutionDetailsDto struct {
 type importSolutionDto struct {
 	PublishWorkflows                 bool                                `json:"PublishWorkflows"`
 	OverwriteUnmanagedCustomizations bool                                `json:"OverwriteUnmanagedCustomizations"`
+	HoldingSolution                  bool                                `json:"HoldingSolution"`
 	ComponentParameters              []any                               `json:"ComponentParameters"`
 	SolutionParameters               importSolutionSolutionParametersDto `json:"SolutionParameters"`
 }
@@ -116,6 +143,26 @@ type asyncSolutionPullResponseDto struct {
 	AsyncOperationId string `json:"AsyncOperationId"`
 	CreatedOn        string `json:"createdon"`
 	CompletedOn      string `json:"completedon"`
+	StatusCode       int    `json:"statuscode"`
+	Message          string `json:"message"`
+}
+
+type cloneSolutionDto struct {
+	ParentSolutionUniqueName string `json:"ParentSolutionUniqueName"`
+	DisplayName              string `json:"DisplayName"`
+	VersionNumber            string `json:"VersionNumber"`
+}
+
+type cloneSolutionResponseDto struct {
+	SolutionId string `json:"SolutionId"`
+}
+
+type deleteAndPromoteDto struct {
+	UniqueName string `json:"UniqueName"`
+}
+
+type deleteAndPromoteResponseDto struct {
+	AsyncOperationId string `json:"AsyncOperationId"`
 }
 
 type validateSolutionImportResponseDto struct {
@@ -21,28 +21,32 @@ type ListDataSourceModel struct {
 }
 
 type DataSourceModel struct {
-	EnvironmentId types.String `tfsdk:"environment_id"`
-	DisplayName   types.String `tfsdk:"display_name"`
-	Name          types.String `tfsdk:"name"`
-	CreatedTime   types.String `tfsdk:"created_time"`
-	Id            types.String `tfsdk:"id"`
-	ModifiedTime  types.String `tfsdk:"modified_time"`
-	InstallTime   types.String `tfsdk:"install_time"`
-	Version       types.String `tfsdk:"version"`
-	IsManaged     types.Bool   `tfsdk:"is_managed"`
+	EnvironmentId        types.String `tfsdk:"environment_id"`
+	DisplayName          types.String `tfsdk:"display_name"`
+	Name                 types.String `tfsdk:"name"`
+	CreatedTime          types.String `tfsdk:"created_time"`
+	Id                   types.String `tfsdk:"id"`
+	ModifiedTime         types.String `tfsdk:"modified_time"`
+	InstallTime          types.String `tfsdk:"install_time"`
+	Version              types.String `tfsdk:"version"`
+	IsManaged            types.Bool   `tfsdk:"is_managed"`
+	PublisherName        types.String `tfsdk:"publisher_name"`
+	PublisherDisplayName types.String `tfsdk:"publisher_display_name"`
 }
 
 func convertFromSolutionDto(solutionDto SolutionDto) DataSourceModel {
 	return DataSourceModel{
-		EnvironmentId: types.StringValue(solutionDto.EnvironmentId),
-		DisplayName:   types.StringValue(solutionDto.DisplayName),
-		Name:          types.StringValue(solutionDto.Name),
-		CreatedTime:   types.StringValue(solutionDto.CreatedTime),
-		Id:            types.StringValue(solutionDto.Id),
-		ModifiedTime:  types.StringValue(solutionDto.ModifiedTime),
-		InstallTime:   types.StringValue(solutionDto.InstallTime),
-		Version:       types.StringValue(solutionDto.Version),
-		IsManaged:     types.BoolValue(solutionDto.IsManaged),
+		EnvironmentId:        types.StringValue(solutionDto.EnvironmentId),
+		DisplayName:          types.StringValue(solutionDto.DisplayName),
+		Name:                 types.StringValue(solutionDto.Name),
+		CreatedTime:          types.StringValue(solutionDto.CreatedTime),
+		Id:                   types.StringValue(solutionDto.Id),
+		ModifiedTime:         types.StringValue(solutionDto.ModifiedTime),
+		InstallTime:          types.StringValue(solutionDto.InstallTime),
+		Version:              types.StringValue(solutionDto.Version),
+		IsManaged:            types.BoolValue(solutionDto.IsManaged),
+		PublisherName:        types.StringValue(solutionDto.Publisher.Name),
+		PublisherDisplayName: types.StringValue(solutionDto.Publisher.DisplayName),
 	}
 }
 
@@ -52,14 +56,56 @@ type Resource struct {
 }
 
 type ResourceModel struct {
-	Timeouts             timeouts.Value `tfsdk:"timeouts"`
-	Id                   types.String   `tfsdk:"id"`
-	SolutionFileChecksum types.String   `tfsdk:"solution_file_checksum"`
-	SettingsFileChecksum types.String   `tfsdk:"settings_file_checksum"`
-	EnvironmentId        types.String   `tfsdk:"environment_id"`
-	SolutionVersion      types.String   `tfsdk:"solution_version"`
-	SolutionFile         types.String   `tfsdk:"solution_file"`
-	SettingsFile         types.String   `tfsdk:"settings_file"`
-	IsManaged            types.Bool     `tfsdk:"is_managed"`
-	DisplayName          types.String   `tfsdk:"display_name"`
+	Timeouts                  timeouts.Value `tfsdk:"timeouts"`
+	Id                        types.String   `tfsdk:"id"`
+	SolutionFileChecksum      types.String   `tfsdk:"solution_file_checksum"`
+	SettingsFileChecksum      types.String   `tfsdk:"settings_file_checksum"`
+	EnvironmentId             types.String   `tfsdk:"environment_id"`
+	SolutionVersion           types.String   `tfsdk:"solution_version"`
+	SolutionFile              types.String   `tfsdk:"solution_file"`
+	SettingsFile              types.String   `tfsdk:"settings_file"`
+	ConnectionMappings        types.Map      `tfsdk:"connection_mappings"`
+	EnvironmentVariableValues types.Map      `tfsdk:"environment_variable_values"`
+	ImportStrategy            types.String   `tfsdk:"import_strategy"`
+	IsManaged                 types.Bool     `tfsdk:"is_managed"`
+	DisplayName               types.String   `tfsdk:"display_name"`
+}
+
+type PatchResource struct {
+	helpers.TypeInfo
+	SolutionClient Client
+}
+
+type PatchResourceModel struct {
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Id                 types.String   `tfsdk:"id"`
+	EnvironmentId      types.String   `tfsdk:"environment_id"`
+	ParentSolutionName types.String   `tfsdk:"parent_solution_name"`
+	DisplayName        types.String   `tfsdk:"display_name"`
+	Version            types.String   `tfsdk:"version"`
+	Name               types.String   `tfsdk:"name"`
+	ParentSolutionId   types.String   `tfsdk:"parent_solution_id"`
+}
+
+func convertFromSolutionDtoToPatchModel(model *PatchResourceModel, solutionDto *SolutionDto) {
+	model.Id = types.StringValue(solutionDto.Id)
+	model.Name = types.StringValue(solutionDto.Name)
+	model.DisplayName = types.StringValue(solutionDto.DisplayName)
+	model.Version = types.StringValue(solutionDto.Version)
+	model.ParentSolutionId = types.StringValue(solutionDto.ParentId)
+}
+
+type CloneResource struct {
+	helpers.TypeInfo
+	SolutionClient Client
+}
+
+type CloneResourceModel struct {
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Id                 types.String   `tfsdk:"id"`
+	EnvironmentId      types.String   `tfsdk:"environment_id"`
+	ParentSolutionName types.String   `tfsdk:"parent_solution_name"`
+	DisplayName        types.String   `tfsdk:"display_name"`
+	Version            types.String   `tfsdk:"version"`
+	Name               types.String   `tfsdk:"name"`
 }
@@ -10,16 +10,18 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/microsoft/terraform-provider-power-platform/internal/api"
-	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 	"github.com/microsoft/terraform-provider-power-platform/internal/modifiers"
 )
@@ -51,7 +53,7 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
 	defer exitContext()
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Resource for importing exporting solutions in Power Platform environments.  This is the equivalent of the [`pac solution import`](https://learn.microsoft.com/power-platform/developer/cli/reference/solution#pac-solution-import) command in the Power Platform CLI.",
+		MarkdownDescription: "Resource for importing exporting solutions in Power Platform environments.  This is the equivalent of the [`pac solution import`](https://learn.microsoft.com/power-platform/developer/cli/reference/solution#pac-solution-import) command in the Power Platform CLI. Exported solution patches, for example created with `powerplatform_solution_patch`, are imported the same way as solutions.",
 		Attributes: map[string]schema.Attribute{
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
@@ -83,6 +85,25 @@ func (r *Resource) Schema(ctx context.Context, req resource.SchemaRequest, resp
 				MarkdownDescription: "Path to the settings file. The settings file uses the same format as pac cli. See https://learn.microsoft.com/power-platform/alm/conn-ref-env-variables-build-tools#deployment-settings-file for more details",
 				Optional:            true,
 			},
+			"connection_mappings": schema.MapAttribute{
+				MarkdownDescription: "Map of connection reference logical names to the ids of the connections they should use after import. Mappings take precedence over the connection ids of the `settings_file`. Connection references that are not listed in the settings file take their connector from the solution file. Changing the mappings imports the solution again",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"environment_variable_values": schema.MapAttribute{
+				MarkdownDescription: "Map of environment variable schema names to the values they should have after import. Values take precedence over the values of the `settings_file`. The value of a secret environment variable must reference an Azure Key Vault secret, using the format `/subscriptions/{subscription id}/resourceGroups/{resource group}/providers/Microsoft.KeyVault/vaults/{key vault}/secrets/{secret}`. The Key Vault must be [configured for use in Power Platform](https://learn.microsoft.com/power-apps/maker/data-platform/environmentvariables-azure-key-vault-secrets). Changing the values imports the solution again",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"import_strategy": schema.StringAttribute{
+				MarkdownDescription: "How a new version of an installed managed solution is imported. `Update` (default) replaces the solution in place and keeps the components that were removed from the new version. `Upgrade` imports the new version as a holding solution and then promotes it, which deletes the components that are no longer part of the solution. Unmanaged solutions and solutions that are not installed yet are always imported as-is. See [Apply an upgrade or update](https://learn.microsoft.com/power-apps/maker/data-platform/update-solutions) for more details",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(IMPORT_STRATEGY_UPDATE),
+				Validators: []validator.String{
+					stringvalidator.OneOf(IMPORT_STRATEGY_UPDATE, IMPORT_STRATEGY_UPGRADE),
+				},
+			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier of the solution",
 				Computed:            true,
@@ -204,8 +225,7 @@ func (r *Resource) Read(ctx context.Context, req resource.ReadRequest, resp *res
 	solutionId := getSolutionId(state.Id.ValueString())
 	solution, err := r.SolutionClient.GetSolutionById(ctx, state.EnvironmentId.ValueString(), solutionId)
 	if err != nil {
-		if customerrors.Code(err) == customerrors.ERROR_OBJECT_NOT_FOUND {
-			resp.State.RemoveResource(ctx)
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
 			return
 		}
 		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
@@ -258,7 +278,30 @@ func (r *Resource) importSolution(ctx context.Context, plan *ResourceModel, diag
 		return nil
 	}
 
-	solution, err := r.SolutionClient.CreateSolution(ctx, plan.EnvironmentId.ValueString(), solutionContent, settingsContent)
+	connectionMappings := map[string]string{}
+	if !plan.ConnectionMappings.IsNull() && !plan.ConnectionMappings.IsUnknown() {
+		diagnostics.Append(plan.ConnectionMappings.ElementsAs(ctx, &connectionMappings, false)...)
+		if diagnostics.HasError() {
+			return nil
+		}
+	}
+
+	environmentVariableValues := map[string]string{}
+	if !plan.EnvironmentVariableValues.IsNull() && !plan.EnvironmentVariableValues.IsUnknown() {
+		diagnostics.Append(plan.EnvironmentVariableValues.ElementsAs(ctx, &environmentVariableValues, false)...)
+		if diagnostics.HasError() {
+			return nil
+		}
+	}
+
+	unlock, err := r.SolutionClient.Api.LockEnvironment(ctx, plan.EnvironmentId.ValueString())
+	if err != nil {
+		diagnostics.AddError(fmt.Sprintf("Client error when importing solution %s", plan.SolutionFile), err.Error())
+		return nil
+	}
+	defer unlock()
+
+	solution, err := r.SolutionClient.CreateSolution(ctx, plan.EnvironmentId.ValueString(), solutionContent, settingsContent, connectionMappings, environmentVariableValues, plan.ImportStrategy.ValueString())
 	if err != nil {
 		diagnostics.AddError(fmt.Sprintf("Client error when importing solution %s", plan.SolutionFile), err.Error())
 	}
@@ -323,8 +366,15 @@ func (r *Resource) Delete(ctx context.Context, req resource.DeleteRequest, resp
 	}
 
 	if !state.EnvironmentId.IsNull() && !state.Id.IsNull() {
+		unlock, err := r.SolutionClient.Api.LockEnvironment(ctx, state.EnvironmentId.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+			return
+		}
+		defer unlock()
+
 		solutionId := getSolutionId(state.Id.ValueString())
-		err := r.SolutionClient.DeleteSolution(ctx, state.EnvironmentId.ValueString(), solutionId)
+		err = r.SolutionClient.DeleteSolution(ctx, state.EnvironmentId.ValueString(), solutionId)
 
 		if err != nil {
 			resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
@@ -0,0 +1,54 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package solution_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestUnitSolutionCloneResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	const dataverseUrl = "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2"
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Patch/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", dataverseUrl+"/CloneAsSolution",
+		httpmock.NewStringResponder(http.StatusOK, `{"SolutionId":"00000000-0000-0000-0000-000000000010"}`))
+
+	httpmock.RegisterResponder("GET", dataverseUrl+"/solutions?%24expand=publisherid&%24filter=solutionid+eq+00000000-0000-0000-0000-000000000010",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Patch/get_solution_clone.json").String()), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_solution_clone" "rollup" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					parent_solution_name = "TerraformTestSolution"
+					display_name         = "Terraform Test Solution"
+					version              = "1.1.0.0"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_solution_clone.rollup", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_solution_clone.rollup", "name", "TerraformTestSolution"),
+					resource.TestCheckResourceAttr("powerplatform_solution_clone.rollup", "version", "1.1.0.0"),
+				),
+			},
+		},
+	})
+}
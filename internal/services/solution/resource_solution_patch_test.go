@@ -0,0 +1,117 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package solution_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+func TestUnitSolutionPatchResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	const dataverseUrl = "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2"
+
+	cloneRequests := []map[string]string{}
+	patchDeleted := false
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Patch/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", dataverseUrl+"/CloneAsPatch",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			cloneRequest := map[string]string{}
+			if err := json.Unmarshal(body, &cloneRequest); err != nil {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			cloneRequests = append(cloneRequests, cloneRequest)
+			return httpmock.NewStringResponse(http.StatusOK, `{"SolutionId":"00000000-0000-0000-0000-000000000020"}`), nil
+		})
+
+	httpmock.RegisterResponder("GET", dataverseUrl+"/solutions?%24expand=publisherid&%24filter=solutionid+eq+00000000-0000-0000-0000-000000000020",
+		func(req *http.Request) (*http.Response, error) {
+			if patchDeleted {
+				return httpmock.NewStringResponse(http.StatusOK, `{"value":[]}`), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Patch/get_solution_patch.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", dataverseUrl+"/solutions%2800000000-0000-0000-0000-000000000020%29",
+		func(req *http.Request) (*http.Response, error) {
+			patchDeleted = true
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if !patchDeleted {
+				return fmt.Errorf("patch was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_solution_patch" "patch" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					parent_solution_name = "TerraformTestSolution"
+					display_name         = "Terraform Test Solution Patch"
+					version              = "1.0.1.0"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "id", "00000000-0000-0000-0000-000000000020"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "name", "TerraformTestSolution_Patch_2a1b4c3d"),
+					resource.TestCheckResourceAttr("powerplatform_solution_patch.patch", "parent_solution_id", "00000000-0000-0000-0000-000000000010"),
+					func(_ *terraform.State) error {
+						if len(cloneRequests) != 1 || cloneRequests[0]["ParentSolutionUniqueName"] != "TerraformTestSolution" || cloneRequests[0]["VersionNumber"] != "1.0.1.0" {
+							return fmt.Errorf("unexpected clone requests %v", cloneRequests)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            "powerplatform_solution_patch.patch",
+				ImportState:             true,
+				ImportStateIdFunc:       func(_ *terraform.State) (string, error) { return "00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000020", nil },
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"parent_solution_name"},
+			},
+		},
+	})
+}
+
+func TestUnitSolutionPatchResource_Validate_Version(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_solution_patch" "patch" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					parent_solution_name = "TerraformTestSolution"
+					display_name         = "Terraform Test Solution Patch"
+					version              = "1.0.1"
+				}`,
+				ExpectError: regexp.MustCompile("version must have the format major.minor.build.revision"),
+			},
+		},
+	})
+}
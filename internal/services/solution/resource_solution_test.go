@@ -4,7 +4,11 @@
 package solution_test
 
 import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -13,6 +17,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/jarcoal/httpmock"
 	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
 	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
@@ -158,7 +163,7 @@ func TestUnitSolutionResource_Validate_Create_With_Settings_File(t *testing.T) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/get_async_operations.json").String()), nil
 		})
 
-	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/RetrieveSolutionImportResult%28ImportJobId=1b1fa80d-aa0f-4291-b60c-b0745304ce24%29",
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/RetrieveSolutionImportResult%28ImportJobId=1b1fa80d-aa0f-4291-b60c-b0745304ce24%29",
 		func(req *http.Request) (*http.Response, error) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/get_solution_import_result.json").String()), nil
 		})
@@ -208,6 +213,242 @@ func TestUnitSolutionResource_Validate_Create_With_Settings_File(t *testing.T) {
 	})
 }
 
+func TestUnitSolutionResource_Validate_Create_With_Connection_Mappings(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mocks.ActivateEnvironmentHttpMocks()
+
+	solutionFileBytes, err := os.ReadFile(SOLUTION_1_RELATIVE_PATH)
+	if err != nil {
+		t.Fatalf("Failed to read solution file: %s", err.Error())
+	}
+
+	err = os.WriteFile(SOLUTION_1_NAME, solutionFileBytes, 0644)
+	if err != nil {
+		t.Fatalf("Failed to write solution file: %s", err.Error())
+	}
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/StageSolution",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/post_stage_solution.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/ImportSolutionAsync",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			importRequest := map[string]any{}
+			_ = json.Unmarshal(body, &importRequest)
+
+			componentParameters, ok := importRequest["ComponentParameters"].([]any)
+			if !ok || len(componentParameters) != 1 {
+				return httpmock.NewStringResponse(http.StatusBadRequest, "expected one component parameter"), nil
+			}
+			connectionReference := componentParameters[0].(map[string]any)
+			if connectionReference["connectionreferencelogicalname"] != "cra6e_ConnectionReferenceSharePoint" ||
+				connectionReference["connectionid"] != "00000000-0000-0000-0000-000000000010" ||
+				connectionReference["connectorid"] != "/providers/Microsoft.PowerApps/apis/shared_sharepointonline" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, "unexpected connection reference"), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/post_import_solution_async.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/asyncoperations%28310799b8-dc6c-ee11-9ae7-000d3aaae21d%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/get_async_operations.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/RetrieveSolutionImportResult%28ImportJobId=1b1fa80d-aa0f-4291-b60c-b0745304ce24%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/get_solution_import_result.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions?%24expand=publisherid&%24filter=uniquename+eq+%27TerraformTestSolution%27",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/get_solution.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions?%24expand=publisherid&%24filter=solutionid+eq+86928ed8-df37-4ce2-add5-47030a833bff",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/get_solution.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions%2886928ed8-df37-4ce2-add5-47030a833bff%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_solution" "solution" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					solution_file  = "` + SOLUTION_1_NAME + `"
+					connection_mappings = {
+						"cra6e_ConnectionReferenceSharePoint" = "00000000-0000-0000-0000-000000000010"
+					}
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "connection_mappings.%", "1"),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "connection_mappings.cra6e_ConnectionReferenceSharePoint", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "solution_version", "1.1.0.0"),
+				),
+			},
+		},
+	})
+}
+
+const SOLUTION_SECRET_VARIABLE_NAME = "TerraformTestSolution_Secret_Variable.zip"
+
+// writeSolutionWithSecretVariable writes the test solution with an additional secret environment variable definition.
+func writeSolutionWithSecretVariable(t *testing.T) {
+	solutionFileBytes, err := os.ReadFile(SOLUTION_1_RELATIVE_PATH)
+	if err != nil {
+		t.Fatalf("Failed to read solution file: %s", err.Error())
+	}
+	zipReader, err := zip.NewReader(bytes.NewReader(solutionFileBytes), int64(len(solutionFileBytes)))
+	if err != nil {
+		t.Fatalf("Failed to read solution file: %s", err.Error())
+	}
+
+	buffer := bytes.Buffer{}
+	zipWriter := zip.NewWriter(&buffer)
+	for _, file := range zipReader.File {
+		if err := zipWriter.Copy(file); err != nil {
+			t.Fatalf("Failed to copy solution file: %s", err.Error())
+		}
+	}
+	definition, _ := zipWriter.Create("environmentvariabledefinitions/cra6e_SolutionVariableSecret/environmentvariabledefinition.xml")
+	_, _ = definition.Write([]byte(`<environmentvariabledefinition schemaname="cra6e_SolutionVariableSecret">
+  <introducedversion>1.0.0.0</introducedversion>
+  <iscustomizable>1</iscustomizable>
+  <isrequired>0</isrequired>
+  <secretstore>0</secretstore>
+  <type>100000005</type>
+</environmentvariabledefinition>`))
+	if err := zipWriter.Close(); err != nil {
+		t.Fatalf("Failed to write solution file: %s", err.Error())
+	}
+
+	if err := os.WriteFile(SOLUTION_SECRET_VARIABLE_NAME, buffer.Bytes(), 0644); err != nil {
+		t.Fatalf("Failed to write solution file: %s", err.Error())
+	}
+	t.Cleanup(func() { _ = os.Remove(SOLUTION_SECRET_VARIABLE_NAME) })
+}
+
+func TestUnitSolutionResource_Validate_Create_With_Environment_Variable_Values(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mocks.ActivateEnvironmentHttpMocks()
+
+	writeSolutionWithSecretVariable(t)
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/StageSolution",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/post_stage_solution.json").String()), nil
+		})
+
+	environmentVariables := map[string]any{}
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/ImportSolutionAsync",
+		func(req *http.Request) (*http.Response, error) {
+			importRequest := map[string]any{}
+			_ = json.NewDecoder(req.Body).Decode(&importRequest)
+
+			componentParameters, _ := importRequest["ComponentParameters"].([]any)
+			for _, componentParameter := range componentParameters {
+				environmentVariable := componentParameter.(map[string]any)
+				if environmentVariable["@odata.type"] == "Microsoft.Dynamics.CRM.environmentvariablevalue" {
+					environmentVariables[environmentVariable["schemaname"].(string)] = environmentVariable["value"]
+				}
+			}
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/post_import_solution_async.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/asyncoperations%28310799b8-dc6c-ee11-9ae7-000d3aaae21d%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/get_async_operations.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/RetrieveSolutionImportResult%28ImportJobId=1b1fa80d-aa0f-4291-b60c-b0745304ce24%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/get_solution_import_result.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions?%24expand=publisherid&%24filter=uniquename+eq+%27TerraformTestSolution%27",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/get_solution.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions?%24expand=publisherid&%24filter=solutionid+eq+86928ed8-df37-4ce2-add5-47030a833bff",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_With_Settings_File/get_solution.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions%2886928ed8-df37-4ce2-add5-47030a833bff%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	const secretReference = "/subscriptions/00000000-0000-0000-0000-000000000002/resourceGroups/contoso-rg/providers/Microsoft.KeyVault/vaults/contoso-kv/secrets/api-key"
+
+	config := func(environmentVariableValues string) string {
+		return `
+		resource "powerplatform_solution" "solution" {
+			environment_id              = "00000000-0000-0000-0000-000000000001"
+			solution_file               = "` + SOLUTION_SECRET_VARIABLE_NAME + `"
+			environment_variable_values = ` + environmentVariableValues + `
+		}`
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      config(`{ "cra6e_SolutionVariableSecret" = "api-key" }`),
+				ExpectError: regexp.MustCompile(`environment variable 'cra6e_SolutionVariableSecret' is a secret, its value\s+must reference an Azure Key Vault secret`),
+			},
+			{
+				Config:      config(`{ "cra6e_SolutionVariableMissing" = "value" }`),
+				ExpectError: regexp.MustCompile(`environment variable 'cra6e_SolutionVariableMissing' from\s+environment_variable_values was not found in the solution`),
+			},
+			{
+				Config: config(`{
+					"cra6e_SolutionVariableText"   = "Production text"
+					"cra6e_SolutionVariableSecret" = "` + secretReference + `"
+				}`),
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "environment_variable_values.%", "2"),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "environment_variable_values.cra6e_SolutionVariableSecret", secretReference),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "solution_version", "1.1.0.0"),
+					func(_ *terraform.State) error {
+						if environmentVariables["cra6e_SolutionVariableText"] != "Production text" || environmentVariables["cra6e_SolutionVariableSecret"] != secretReference {
+							return fmt.Errorf("unexpected environment variable values imported: %v", environmentVariables)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
 func TestAccSolutionResource_Validate_Create_With_Settings_File(t *testing.T) {
 	solutionSettingsFileName := "test_solution_settings.json"
 
@@ -318,7 +559,7 @@ func TestUnitSolutionResource_Validate_Create_No_Settings_File(t *testing.T) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_No_Settings_File/get_async_operations.json").String()), nil
 		})
 
-	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/RetrieveSolutionImportResult%28ImportJobId=1b1fa80d-aa0f-4291-b60c-b0745304ce24%29",
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/RetrieveSolutionImportResult%28ImportJobId=1b1fa80d-aa0f-4291-b60c-b0745304ce24%29",
 		func(req *http.Request) (*http.Response, error) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_No_Settings_File/get_solution_import_result.json").String()), nil
 		})
@@ -403,7 +644,7 @@ func TestUnitSolutionResource_Validate_Create_And_Force_Recreate(t *testing.T) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_And_Force_Recreate/get_async_operations.json").String()), nil
 		})
 
-	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/RetrieveSolutionImportResult%28ImportJobId=1b1fa80d-aa0f-4291-b60c-b0745304ce24%29",
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/RetrieveSolutionImportResult%28ImportJobId=1b1fa80d-aa0f-4291-b60c-b0745304ce24%29",
 		func(req *http.Request) (*http.Response, error) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_And_Force_Recreate/get_solution_import_result.json").String()), nil
 		})
@@ -491,6 +732,130 @@ func TestUnitSolutionResource_Validate_Create_And_Force_Recreate(t *testing.T) {
 	})
 }
 
+func TestUnitSolutionResource_Validate_Create_Upgrade(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	mocks.ActivateEnvironmentHttpMocks()
+
+	createFile("test_solution_upgrade_before.zip", "test_solution_upgrade_before")
+	createFile("test_solution_upgrade_after.zip", "test_solution_upgrade_after")
+
+	holdingSolutionImports := []bool{}
+	deleteAndPromoteCount := 0
+
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy&api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Upgrade/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/StageSolution",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Upgrade/post_stage_solution.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/ImportSolutionAsync",
+		func(req *http.Request) (*http.Response, error) {
+			body := struct {
+				HoldingSolution bool `json:"HoldingSolution"`
+			}{}
+			_ = json.NewDecoder(req.Body).Decode(&body)
+			holdingSolutionImports = append(holdingSolutionImports, body.HoldingSolution)
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Upgrade/post_import_solution_async.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/asyncoperations%28310799b8-dc6c-ee11-9ae7-000d3aaae21d%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Upgrade/get_async_operations.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/RetrieveSolutionImportResult%28ImportJobId=1b1fa80d-aa0f-4291-b60c-b0745304ce24%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Upgrade/get_solution_import_result.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/DeleteAndPromoteAsync",
+		func(req *http.Request) (*http.Response, error) {
+			deleteAndPromoteCount++
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Upgrade/post_delete_and_promote_async.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/asyncoperations%284a1c2d9e-dc6c-ee11-9ae7-000d3aaae21d%29",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Upgrade/get_async_operations_delete_and_promote.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions?%24expand=publisherid&%24filter=uniquename+eq+%27TerraformTestSolution%27",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Upgrade/get_solution.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions?%24expand=publisherid&%24filter=solutionid+eq+86928ed8-df37-4ce2-add5-47030a833bff",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_Upgrade/get_solution.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/solutions%2886928ed8-df37-4ce2-add5-47030a833bff%29",
+		httpmock.NewStringResponder(http.StatusNoContent, ""))
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_solution" "solution" {
+					environment_id = "00000000-0000-0000-0000-000000000001"
+					solution_file  = "test_solution_upgrade_before.zip"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "import_strategy", "Update"),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "is_managed", strconv.FormatBool(true)),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_solution" "solution" {
+					environment_id  = "00000000-0000-0000-0000-000000000001"
+					solution_file   = "test_solution_upgrade_after.zip"
+					import_strategy = "Upgrade"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "import_strategy", "Upgrade"),
+					resource.TestCheckResourceAttr("powerplatform_solution.solution", "is_managed", strconv.FormatBool(true)),
+				),
+			},
+		},
+	})
+
+	if fmt.Sprint(holdingSolutionImports) != "[false true]" {
+		t.Errorf("expected the upgrade to be imported as a holding solution, got %v", holdingSolutionImports)
+	}
+	if deleteAndPromoteCount != 1 {
+		t.Errorf("expected the holding solution to be promoted once, got %d", deleteAndPromoteCount)
+	}
+}
+
+func TestUnitSolutionResource_Validate_Invalid_Import_Strategy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_solution" "solution" {
+					environment_id  = "00000000-0000-0000-0000-000000000001"
+					solution_file   = "test_solution.zip"
+					import_strategy = "Replace"
+				}`,
+				ExpectError: regexp.MustCompile(`Attribute import_strategy value must be one of`),
+			},
+		},
+	})
+}
+
 func TestAccSolutionResource_Validate_Create_No_Dataverse(t *testing.T) {
 	solutionFileBytes, err := os.ReadFile(SOLUTION_1_RELATIVE_PATH)
 	if err != nil {
@@ -551,7 +916,7 @@ func TestUnitSolutionResource_Validate_Create_No_Dataverse(t *testing.T) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_No_Dataverse/get_async_operations.json").String()), nil
 		})
 
-	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.0/RetrieveSolutionImportResult%28ImportJobId=1b1fa80d-aa0f-4291-b60c-b0745304ce24%29",
+	httpmock.RegisterResponder("GET", "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2/RetrieveSolutionImportResult%28ImportJobId=1b1fa80d-aa0f-4291-b60c-b0745304ce24%29",
 		func(req *http.Request) (*http.Response, error) {
 			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_No_Dataverse/get_solution_import_result.json").String()), nil
 		})
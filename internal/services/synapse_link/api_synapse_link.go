@@ -0,0 +1,151 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package synapse_link
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/customerrors"
+	"github.com/microsoft/terraform-provider-power-platform/internal/services/environment"
+)
+
+func newSynapseLinkClient(apiClient *api.Client) client {
+	return client{
+		Api:               apiClient,
+		environmentClient: environment.NewEnvironmentClient(apiClient),
+	}
+}
+
+type client struct {
+	Api               *api.Client
+	environmentClient environment.Client
+}
+
+func (client *client) buildDataverseUrl(environmentHost, path string, values url.Values) string {
+	apiUrl := &url.URL{
+		Scheme: constants.HTTPS,
+		Host:   environmentHost,
+		Path:   fmt.Sprintf("/api/data/%s/%s", client.Api.GetConfig().GetDataverseApiVersion(), path),
+	}
+	if values != nil {
+		apiUrl.RawQuery = values.Encode()
+	}
+	return apiUrl.String()
+}
+
+// CreateSynapseLink creates the Synapse Link profile that exports the tables of the environment to the storage account.
+func (client *client) CreateSynapseLink(ctx context.Context, environmentId string, folder dataLakeFolderDto) (*dataLakeFolderDto, error) {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := http.Header{}
+	headers.Set("Prefer", "return=representation")
+
+	created := dataLakeFolderDto{}
+	_, err = client.Api.Execute(ctx, nil, "POST", client.buildDataverseUrl(environmentHost, "msdyn_datalakefolders", nil), headers, folder, []int{http.StatusCreated}, &created)
+	if err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (client *client) GetSynapseLink(ctx context.Context, environmentId, synapseLinkId string) (*dataLakeFolderDto, error) {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "msdyn_datalakefolderid,msdyn_name,msdyn_storageaccountresourceid,msdyn_synapseworkspaceresourceid")
+
+	folder := dataLakeFolderDto{}
+	resp, err := client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, fmt.Sprintf("msdyn_datalakefolders(%s)", synapseLinkId), values), nil, nil, []int{http.StatusOK, http.StatusNotFound}, &folder)
+	if err != nil {
+		return nil, err
+	}
+	if resp.HttpResponse.StatusCode == http.StatusNotFound {
+		return nil, customerrors.WrapIntoProviderError(nil, customerrors.ERROR_OBJECT_NOT_FOUND, fmt.Sprintf("synapse link '%s' not found", synapseLinkId))
+	}
+	return &folder, nil
+}
+
+// DeleteSynapseLink unlinks the storage account, which stops the export of all the tables of the profile.
+func (client *client) DeleteSynapseLink(ctx context.Context, environmentId, synapseLinkId string) error {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Api.Execute(ctx, nil, "DELETE", client.buildDataverseUrl(environmentHost, fmt.Sprintf("msdyn_datalakefolders(%s)", synapseLinkId), nil), nil, nil, []int{http.StatusNoContent, http.StatusNotFound}, nil)
+	return err
+}
+
+// GetSynapseLinkTables returns the tables exported by the Synapse Link profile.
+func (client *client) GetSynapseLinkTables(ctx context.Context, environmentId, synapseLinkId string) ([]dataLakeFolderEntityDto, error) {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("$select", "msdyn_datalakefolderentityid,msdyn_entityname,msdyn_partitionstrategy,msdyn_appendonlymode")
+	values.Add("$filter", fmt.Sprintf("_msdyn_datalakefolder_value eq %s", synapseLinkId))
+
+	tables := dataLakeFolderEntityArrayDto{}
+	_, err = client.Api.Execute(ctx, nil, "GET", client.buildDataverseUrl(environmentHost, "msdyn_datalakefolderentities", values), nil, nil, []int{http.StatusOK}, &tables)
+	if err != nil {
+		return nil, err
+	}
+	return tables.Value, nil
+}
+
+// AddSynapseLinkTable starts the export of a table by the Synapse Link profile.
+func (client *client) AddSynapseLinkTable(ctx context.Context, environmentId, synapseLinkId string, table dataLakeFolderEntityDto) error {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	entity := map[string]any{
+		"msdyn_entityname":                table.EntityName,
+		"msdyn_partitionstrategy":         table.PartitionStrategy,
+		"msdyn_appendonlymode":            table.AppendOnlyMode,
+		"msdyn_DataLakeFolder@odata.bind": fmt.Sprintf("/msdyn_datalakefolders(%s)", synapseLinkId),
+	}
+	_, err = client.Api.Execute(ctx, nil, "POST", client.buildDataverseUrl(environmentHost, "msdyn_datalakefolderentities", nil), nil, entity, []int{http.StatusNoContent}, nil)
+	return err
+}
+
+// UpdateSynapseLinkTable changes how an exported table is partitioned and written to the storage account.
+func (client *client) UpdateSynapseLinkTable(ctx context.Context, environmentId string, table dataLakeFolderEntityDto) error {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	update := map[string]any{
+		"msdyn_partitionstrategy": table.PartitionStrategy,
+		"msdyn_appendonlymode":    table.AppendOnlyMode,
+	}
+	_, err = client.Api.Execute(ctx, nil, "PATCH", client.buildDataverseUrl(environmentHost, fmt.Sprintf("msdyn_datalakefolderentities(%s)", table.Id), nil), nil, update, []int{http.StatusNoContent}, nil)
+	return err
+}
+
+// RemoveSynapseLinkTable stops the export of a table. The data already exported stays in the storage account.
+func (client *client) RemoveSynapseLinkTable(ctx context.Context, environmentId, tableId string) error {
+	environmentHost, err := client.environmentClient.GetEnvironmentHostById(ctx, environmentId)
+	if err != nil {
+		return err
+	}
+
+	_, err = client.Api.Execute(ctx, nil, "DELETE", client.buildDataverseUrl(environmentHost, fmt.Sprintf("msdyn_datalakefolderentities(%s)", tableId), nil), nil, nil, []int{http.StatusNoContent, http.StatusNotFound}, nil)
+	return err
+}
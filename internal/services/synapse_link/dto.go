@@ -0,0 +1,22 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package synapse_link
+
+type dataLakeFolderDto struct {
+	Id                         string `json:"msdyn_datalakefolderid,omitempty"`
+	Name                       string `json:"msdyn_name"`
+	StorageAccountResourceId   string `json:"msdyn_storageaccountresourceid"`
+	SynapseWorkspaceResourceId string `json:"msdyn_synapseworkspaceresourceid,omitempty"`
+}
+
+type dataLakeFolderEntityDto struct {
+	Id                string `json:"msdyn_datalakefolderentityid,omitempty"`
+	EntityName        string `json:"msdyn_entityname"`
+	PartitionStrategy string `json:"msdyn_partitionstrategy"`
+	AppendOnlyMode    bool   `json:"msdyn_appendonlymode"`
+}
+
+type dataLakeFolderEntityArrayDto struct {
+	Value []dataLakeFolderEntityDto `json:"value"`
+}
@@ -0,0 +1,71 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package synapse_link
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+type SynapseLinkResource struct {
+	helpers.TypeInfo
+	SynapseLinkClient client
+}
+
+type SynapseLinkResourceModel struct {
+	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	Id                 types.String   `tfsdk:"id"`
+	EnvironmentId      types.String   `tfsdk:"environment_id"`
+	Name               types.String   `tfsdk:"name"`
+	StorageAccountId   types.String   `tfsdk:"storage_account_id"`
+	SynapseWorkspaceId types.String   `tfsdk:"synapse_workspace_id"`
+	Tables             types.Set      `tfsdk:"tables"`
+}
+
+type SynapseLinkTableModel struct {
+	Name              types.String `tfsdk:"name"`
+	PartitionStrategy types.String `tfsdk:"partition_strategy"`
+	AppendOnly        types.Bool   `tfsdk:"append_only"`
+}
+
+var synapseLinkTableAttrTypes = map[string]attr.Type{
+	"name":               types.StringType,
+	"partition_strategy": types.StringType,
+	"append_only":        types.BoolType,
+}
+
+func convertToSynapseLinkTableDtos(tables []SynapseLinkTableModel) []dataLakeFolderEntityDto {
+	dtos := make([]dataLakeFolderEntityDto, 0, len(tables))
+	for _, table := range tables {
+		dtos = append(dtos, dataLakeFolderEntityDto{
+			EntityName:        table.Name.ValueString(),
+			PartitionStrategy: table.PartitionStrategy.ValueString(),
+			AppendOnlyMode:    table.AppendOnly.ValueBool(),
+		})
+	}
+	return dtos
+}
+
+func convertFromSynapseLinkDto(model *SynapseLinkResourceModel, folder *dataLakeFolderDto, tables []dataLakeFolderEntityDto) {
+	model.Id = types.StringValue(folder.Id)
+	model.Name = types.StringValue(folder.Name)
+	model.StorageAccountId = types.StringValue(folder.StorageAccountResourceId)
+	if folder.SynapseWorkspaceResourceId != "" {
+		model.SynapseWorkspaceId = types.StringValue(folder.SynapseWorkspaceResourceId)
+	} else {
+		model.SynapseWorkspaceId = types.StringNull()
+	}
+
+	tableObjects := make([]attr.Value, 0, len(tables))
+	for _, table := range tables {
+		tableObjects = append(tableObjects, types.ObjectValueMust(synapseLinkTableAttrTypes, map[string]attr.Value{
+			"name":               types.StringValue(table.EntityName),
+			"partition_strategy": types.StringValue(table.PartitionStrategy),
+			"append_only":        types.BoolValue(table.AppendOnlyMode),
+		}))
+	}
+	model.Tables = types.SetValueMust(types.ObjectType{AttrTypes: synapseLinkTableAttrTypes}, tableObjects)
+}
@@ -0,0 +1,398 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package synapse_link
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+const (
+	PARTITION_STRATEGY_MONTH = "Month"
+	PARTITION_STRATEGY_YEAR  = "Year"
+)
+
+var (
+	storageAccountIdRegex   = regexp.MustCompile(`(?i)^/subscriptions/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}/resourceGroups/[^/]+/providers/Microsoft\.Storage/storageAccounts/[^/]+$`)
+	synapseWorkspaceIdRegex = regexp.MustCompile(`(?i)^/subscriptions/[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}/resourceGroups/[^/]+/providers/Microsoft\.Synapse/workspaces/[^/]+$`)
+)
+
+var _ resource.Resource = &SynapseLinkResource{}
+var _ resource.ResourceWithImportState = &SynapseLinkResource{}
+
+func NewSynapseLinkResource() resource.Resource {
+	return &SynapseLinkResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "synapse_link",
+		},
+	}
+}
+
+func (r *SynapseLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *SynapseLinkResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages an [Azure Synapse Link for Dataverse](https://learn.microsoft.com/power-apps/maker/data-platform/export-to-data-lake) profile, which continuously exports the selected tables of a Dataverse environment to an Azure Data Lake Storage Gen2 account and, optionally, makes them queryable from an Azure Synapse Analytics workspace.\n\nThe storage account must be in the same region as the environment and the identity of the provider must be an owner of the storage account and of the Synapse workspace.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+				Read:   true,
+			}),
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier (guid) of the Synapse Link profile",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"environment_id": schema.StringAttribute{
+				MarkdownDescription: "Id of the Dataverse environment",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Synapse Link profile",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"storage_account_id": schema.StringAttribute{
+				MarkdownDescription: "Azure resource id of the Azure Data Lake Storage Gen2 account the tables are exported to",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(storageAccountIdRegex, "storage_account_id must be the resource id of an Azure storage account"),
+				},
+			},
+			"synapse_workspace_id": schema.StringAttribute{
+				MarkdownDescription: "Azure resource id of the Azure Synapse Analytics workspace the exported tables are made available in",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(synapseWorkspaceIdRegex, "synapse_workspace_id must be the resource id of an Azure Synapse workspace"),
+				},
+			},
+			"tables": schema.SetNestedAttribute{
+				MarkdownDescription: "Tables exported by the Synapse Link profile",
+				Required:            true,
+				PlanModifiers: []planmodifier.Set{
+					tableDefaultsModifier{},
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Logical name of the table, for example `account`",
+							Required:            true,
+							Validators: []validator.String{
+								stringvalidator.RegexMatches(regexp.MustCompile(`^[a-z0-9_]+$`), "name must be the logical name of a table"),
+							},
+						},
+						"partition_strategy": schema.StringAttribute{
+							MarkdownDescription: "How the exported rows of the table are partitioned in the storage account. Can be `Month` or `Year`. Defaults to `Month`",
+							Optional:            true,
+							Computed:            true,
+							Validators: []validator.String{
+								stringvalidator.OneOf(PARTITION_STRATEGY_MONTH, PARTITION_STRATEGY_YEAR),
+							},
+						},
+						"append_only": schema.BoolAttribute{
+							MarkdownDescription: "Whether changes to the rows of the table are appended to the exported files instead of updating them in place. Defaults to `false`",
+							Optional:            true,
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SynapseLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.SynapseLinkClient = newSynapseLinkClient(client.Api)
+}
+
+func (r *SynapseLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *SynapseLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tables []SynapseLinkTableModel
+	resp.Diagnostics.Append(plan.Tables.ElementsAs(ctx, &tables, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.SynapseLinkClient.CreateSynapseLink(ctx, plan.EnvironmentId.ValueString(), dataLakeFolderDto{
+		Name:                       plan.Name.ValueString(),
+		StorageAccountResourceId:   plan.StorageAccountId.ValueString(),
+		SynapseWorkspaceResourceId: plan.SynapseWorkspaceId.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	// save the profile right away so it is not orphaned when adding the tables fails.
+	plan.Id = types.StringValue(folder.Id)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.Id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), plan.EnvironmentId)...)
+
+	err = r.applyTables(ctx, plan, convertToSynapseLinkTableDtos(tables))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	err = r.readSynapseLink(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SynapseLinkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *SynapseLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.readSynapseLink(ctx, state)
+	if err != nil {
+		if helpers.RemoveResourceIfNotFound(ctx, err, &resp.State) {
+			return
+		}
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *SynapseLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var plan *SynapseLinkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tables []SynapseLinkTableModel
+	resp.Diagnostics.Append(plan.Tables.ElementsAs(ctx, &tables, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.applyTables(ctx, plan, convertToSynapseLinkTableDtos(tables))
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	err = r.readSynapseLink(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *SynapseLinkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	var state *SynapseLinkResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.SynapseLinkClient.DeleteSynapseLink(ctx, state.EnvironmentId.ValueString(), state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+}
+
+// ImportState imports a Synapse Link profile by its environment and profile id, separated by a slash: `<environment_id>/<id>`.
+func (r *SynapseLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	environmentId, synapseLinkId, found := strings.Cut(req.ID, "/")
+	if !found || environmentId == "" || synapseLinkId == "" {
+		resp.Diagnostics.AddError("Unexpected Import Identifier", fmt.Sprintf("Expected import identifier with format: <environment_id>/<id>. Got: %q", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_id"), environmentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), synapseLinkId)...)
+}
+
+// applyTables adds the wanted tables that are not exported yet, updates the ones whose settings changed
+// and removes the exported tables that are no longer wanted.
+func (r *SynapseLinkResource) applyTables(ctx context.Context, plan *SynapseLinkResourceModel, wanted []dataLakeFolderEntityDto) error {
+	current, err := r.SynapseLinkClient.GetSynapseLinkTables(ctx, plan.EnvironmentId.ValueString(), plan.Id.ValueString())
+	if err != nil {
+		return err
+	}
+
+	findTable := func(tables []dataLakeFolderEntityDto, name string) *dataLakeFolderEntityDto {
+		for i := range tables {
+			if strings.EqualFold(tables[i].EntityName, name) {
+				return &tables[i]
+			}
+		}
+		return nil
+	}
+
+	for _, table := range current {
+		if findTable(wanted, table.EntityName) == nil {
+			err := r.SynapseLinkClient.RemoveSynapseLinkTable(ctx, plan.EnvironmentId.ValueString(), table.Id)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, table := range wanted {
+		existing := findTable(current, table.EntityName)
+		if existing == nil {
+			err := r.SynapseLinkClient.AddSynapseLinkTable(ctx, plan.EnvironmentId.ValueString(), plan.Id.ValueString(), table)
+			if err != nil {
+				return fmt.Errorf("failed to add table '%s': %w", table.EntityName, err)
+			}
+		} else if existing.PartitionStrategy != table.PartitionStrategy || existing.AppendOnlyMode != table.AppendOnlyMode {
+			table.Id = existing.Id
+			err := r.SynapseLinkClient.UpdateSynapseLinkTable(ctx, plan.EnvironmentId.ValueString(), table)
+			if err != nil {
+				return fmt.Errorf("failed to update table '%s': %w", table.EntityName, err)
+			}
+		}
+	}
+	return nil
+}
+
+// readSynapseLink refreshes the model with the Synapse Link profile and the tables it exports.
+func (r *SynapseLinkResource) readSynapseLink(ctx context.Context, model *SynapseLinkResourceModel) error {
+	folder, err := r.SynapseLinkClient.GetSynapseLink(ctx, model.EnvironmentId.ValueString(), model.Id.ValueString())
+	if err != nil {
+		return err
+	}
+
+	tables, err := r.SynapseLinkClient.GetSynapseLinkTables(ctx, model.EnvironmentId.ValueString(), model.Id.ValueString())
+	if err != nil {
+		return err
+	}
+
+	convertFromSynapseLinkDto(model, folder, tables)
+	return nil
+}
+
+// tableDefaultsModifier plans the default settings of the tables that don't configure them.
+// Attribute defaults are not used because the framework can apply them to the wrong element of a set when its elements change.
+type tableDefaultsModifier struct{}
+
+func (m tableDefaultsModifier) Description(ctx context.Context) string {
+	return fmt.Sprintf("Tables are partitioned by `%s` and not append only unless configured.", PARTITION_STRATEGY_MONTH)
+}
+
+func (m tableDefaultsModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m tableDefaultsModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var tables []SynapseLinkTableModel
+	resp.Diagnostics.Append(req.ConfigValue.ElementsAs(ctx, &tables, true)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tableObjects := make([]attr.Value, 0, len(tables))
+	for _, table := range tables {
+		if table.PartitionStrategy.IsNull() {
+			table.PartitionStrategy = types.StringValue(PARTITION_STRATEGY_MONTH)
+		}
+		if table.AppendOnly.IsNull() {
+			table.AppendOnly = types.BoolValue(false)
+		}
+		tableObject, diags := types.ObjectValueFrom(ctx, synapseLinkTableAttrTypes, table)
+		resp.Diagnostics.Append(diags...)
+		tableObjects = append(tableObjects, tableObject)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planValue, diags := types.SetValue(types.ObjectType{AttrTypes: synapseLinkTableAttrTypes}, tableObjects)
+	resp.Diagnostics.Append(diags...)
+	resp.PlanValue = planValue
+}
@@ -0,0 +1,274 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package synapse_link_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+const (
+	storageAccountId   = "/subscriptions/00000000-0000-0000-0000-000000000005/resourceGroups/analytics/providers/Microsoft.Storage/storageAccounts/dataverseexport"
+	synapseWorkspaceId = "/subscriptions/00000000-0000-0000-0000-000000000005/resourceGroups/analytics/providers/Microsoft.Synapse/workspaces/dataverse"
+)
+
+func TestAccSynapseLinkResource_Validate_Create(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: mocks.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_environment" "env" {
+					display_name     = "` + mocks.TestName() + `"
+					location         = "unitedstates"
+					environment_type = "Sandbox"
+					dataverse = {
+						language_code     = "1033"
+						currency_code     = "USD"
+						security_group_id = "00000000-0000-0000-0000-000000000000"
+					}
+				}
+
+				resource "powerplatform_synapse_link" "link" {
+					environment_id     = powerplatform_environment.env.id
+					name               = "` + mocks.TestName() + `"
+					storage_account_id = "` + storageAccountId + `"
+					tables = [
+						{
+							name = "account"
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("powerplatform_synapse_link.link", "id", regexp.MustCompile(helpers.GuidRegex)),
+					resource.TestCheckResourceAttr("powerplatform_synapse_link.link", "tables.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestUnitSynapseLinkResource_Validate_Create_And_Update(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	const dataverseUrl = "https://00000000-0000-0000-0000-000000000001.crm4.dynamics.com/api/data/v9.2"
+
+	deleted := false
+	nextTableId := 20
+	tables := map[string]map[string]any{}
+
+	httpmock.RegisterResponder("GET", `https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/environments/00000000-0000-0000-0000-000000000001?%24expand=permissions%2Cproperties.capacity%2Cproperties%2FbillingPolicy%2Cproperties%2FcopilotPolicies&api-version=2023-06-01`,
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resource/Validate_Create_And_Update/get_environment_00000000-0000-0000-0000-000000000001.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", dataverseUrl+"/msdyn_datalakefolders",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			folder := map[string]any{}
+			_ = json.Unmarshal(body, &folder)
+			if req.Header.Get("Prefer") != "return=representation" || folder["msdyn_storageaccountresourceid"] != storageAccountId || folder["msdyn_synapseworkspaceresourceid"] != synapseWorkspaceId {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			return httpmock.NewStringResponse(http.StatusCreated, synapseLinkResponse()), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^`+regexp.QuoteMeta(dataverseUrl+"/msdyn_datalakefolders%2800000000-0000-0000-0000-000000000010%29?"),
+		func(req *http.Request) (*http.Response, error) {
+			if deleted {
+				return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+			}
+			return httpmock.NewStringResponse(http.StatusOK, synapseLinkResponse()), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", dataverseUrl+"/msdyn_datalakefolders%2800000000-0000-0000-0000-000000000010%29",
+		func(req *http.Request) (*http.Response, error) {
+			deleted = true
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("GET", `=~^`+regexp.QuoteMeta(dataverseUrl+"/msdyn_datalakefolderentities?")+`.*_msdyn_datalakefolder_value`,
+		func(req *http.Request) (*http.Response, error) {
+			value := []map[string]any{}
+			for _, table := range tables {
+				value = append(value, table)
+			}
+			sort.Slice(value, func(i, j int) bool {
+				return value[i]["msdyn_entityname"].(string) < value[j]["msdyn_entityname"].(string)
+			})
+			return httpmock.NewJsonResponse(http.StatusOK, map[string]any{"value": value})
+		})
+
+	httpmock.RegisterResponder("POST", dataverseUrl+"/msdyn_datalakefolderentities",
+		func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			table := map[string]any{}
+			_ = json.Unmarshal(body, &table)
+			if table["msdyn_DataLakeFolder@odata.bind"] != "/msdyn_datalakefolders(00000000-0000-0000-0000-000000000010)" {
+				return httpmock.NewStringResponse(http.StatusBadRequest, string(body)), nil
+			}
+			delete(table, "msdyn_DataLakeFolder@odata.bind")
+			id := fmt.Sprintf("00000000-0000-0000-0000-0000000000%d", nextTableId)
+			nextTableId++
+			table["msdyn_datalakefolderentityid"] = id
+			tables[id] = table
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("PATCH", `=~^`+regexp.QuoteMeta(dataverseUrl+"/msdyn_datalakefolderentities%28")+`([\d-]+)%29$`,
+		func(req *http.Request) (*http.Response, error) {
+			table, ok := tables[httpmock.MustGetSubmatch(req, 1)]
+			if !ok {
+				return httpmock.NewStringResponse(http.StatusNotFound, ""), nil
+			}
+			_ = json.NewDecoder(req.Body).Decode(&table)
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	httpmock.RegisterResponder("DELETE", `=~^`+regexp.QuoteMeta(dataverseUrl+"/msdyn_datalakefolderentities%28")+`([\d-]+)%29$`,
+		func(req *http.Request) (*http.Response, error) {
+			delete(tables, httpmock.MustGetSubmatch(req, 1))
+			return httpmock.NewStringResponse(http.StatusNoContent, ""), nil
+		})
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		CheckDestroy: func(_ *terraform.State) error {
+			if !deleted {
+				return errors.New("synapse link was not deleted")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_synapse_link" "link" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					name                 = "Analytics"
+					storage_account_id   = "` + storageAccountId + `"
+					synapse_workspace_id = "` + synapseWorkspaceId + `"
+					tables = [
+						{
+							name = "account"
+						},
+						{
+							name               = "contact"
+							partition_strategy = "Year"
+							append_only        = true
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_synapse_link.link", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_synapse_link.link", "name", "Analytics"),
+					resource.TestCheckResourceAttr("powerplatform_synapse_link.link", "storage_account_id", storageAccountId),
+					resource.TestCheckResourceAttr("powerplatform_synapse_link.link", "synapse_workspace_id", synapseWorkspaceId),
+					resource.TestCheckResourceAttr("powerplatform_synapse_link.link", "tables.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_synapse_link.link", "tables.*", map[string]string{
+						"name":               "account",
+						"partition_strategy": "Month",
+						"append_only":        "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_synapse_link.link", "tables.*", map[string]string{
+						"name":               "contact",
+						"partition_strategy": "Year",
+						"append_only":        "true",
+					}),
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_synapse_link" "link" {
+					environment_id       = "00000000-0000-0000-0000-000000000001"
+					name                 = "Analytics"
+					storage_account_id   = "` + storageAccountId + `"
+					synapse_workspace_id = "` + synapseWorkspaceId + `"
+					tables = [
+						{
+							name = "contact"
+						},
+						{
+							name        = "opportunity"
+							append_only = true
+						}
+					]
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_synapse_link.link", "id", "00000000-0000-0000-0000-000000000010"),
+					resource.TestCheckResourceAttr("powerplatform_synapse_link.link", "tables.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_synapse_link.link", "tables.*", map[string]string{
+						"name":               "contact",
+						"partition_strategy": "Month",
+						"append_only":        "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("powerplatform_synapse_link.link", "tables.*", map[string]string{
+						"name":               "opportunity",
+						"partition_strategy": "Month",
+						"append_only":        "true",
+					}),
+					func(_ *terraform.State) error {
+						// the contact table is updated in place instead of being exported again.
+						if _, ok := tables["00000000-0000-0000-0000-000000000021"]; !ok {
+							return fmt.Errorf("unexpected synapse link tables %v", tables)
+						}
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:      "powerplatform_synapse_link.link",
+				ImportState:       true,
+				ImportStateId:     "00000000-0000-0000-0000-000000000001/00000000-0000-0000-0000-000000000010",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestUnitSynapseLinkResource_Validate_Storage_Account_Id(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_synapse_link" "link" {
+					environment_id     = "00000000-0000-0000-0000-000000000001"
+					name               = "Analytics"
+					storage_account_id = "dataverseexport"
+					tables = [
+						{
+							name = "account"
+						}
+					]
+				}`,
+				ExpectError: regexp.MustCompile(strings.ReplaceAll("storage_account_id must be the resource id of an Azure storage account", " ", `\s+`)),
+			},
+		},
+	})
+}
+
+func synapseLinkResponse() string {
+	return fmt.Sprintf(`{
+		"msdyn_datalakefolderid": "00000000-0000-0000-0000-000000000010",
+		"msdyn_name": "Analytics",
+		"msdyn_storageaccountresourceid": "%s",
+		"msdyn_synapseworkspaceresourceid": "%s"
+	}`, storageAccountId, synapseWorkspaceId)
+}
@@ -140,3 +140,10 @@ type TenantSettingsResource struct {
 	helpers.TypeInfo
 	TenantSettingClient client
 }
+
+type TenantSettingsGroupResource struct {
+	helpers.TypeInfo
+	TenantSettingClient client
+	Description         string
+	Settings            []tenantSetting
+}
@@ -0,0 +1,490 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package tenant_settings
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/microsoft/terraform-provider-power-platform/internal/api"
+	"github.com/microsoft/terraform-provider-power-platform/internal/constants"
+	"github.com/microsoft/terraform-provider-power-platform/internal/helpers"
+)
+
+var _ resource.Resource = &TenantSettingsGroupResource{}
+
+type tenantSettingKind int
+
+const (
+	tenantSettingBool tenantSettingKind = iota
+	tenantSettingInt64
+	tenantSettingString
+)
+
+// tenantSetting is an attribute of a tenant settings group, stored at Path in the tenant settings.
+type tenantSetting struct {
+	Name        string
+	Path        string
+	Kind        tenantSettingKind
+	Description string
+}
+
+// tenantSettingsGetter is implemented by the plan and the state.
+type tenantSettingsGetter interface {
+	GetAttribute(ctx context.Context, p path.Path, target any) diag.Diagnostics
+}
+
+func NewTenantAiSettingsResource() resource.Resource {
+	return &TenantSettingsGroupResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "tenant_ai_settings",
+		},
+		Description: "Copilot, generative AI and search",
+		Settings: []tenantSetting{
+			{Name: "disable_copilot", Path: "powerPlatform.intelligence.disableCopilot", Kind: tenantSettingBool, Description: "Disable Copilot in Power Apps"},
+			{Name: "enable_open_ai_bot_publishing", Path: "powerPlatform.intelligence.enableOpenAiBotPublishing", Kind: tenantSettingBool, Description: "Enable publishing of bots with generative AI features"},
+			{Name: "disable_power_automate_copilot", Path: "powerPlatform.powerAutomate.disableCopilot", Kind: tenantSettingBool, Description: "Disable Copilot in Power Automate"},
+			{Name: "enable_model_data_sharing", Path: "powerPlatform.modelExperimentation.enableModelDataSharing", Kind: tenantSettingBool, Description: "Enable sharing data with Microsoft to improve the AI models"},
+			{Name: "disable_data_logging", Path: "powerPlatform.modelExperimentation.disableDataLogging", Kind: tenantSettingBool, Description: "Disable logging of the data sent to the AI models"},
+			{Name: "disable_docs_search", Path: "powerPlatform.search.disableDocsSearch", Kind: tenantSettingBool, Description: "Disable Docs Search"},
+			{Name: "disable_community_search", Path: "powerPlatform.search.disableCommunitySearch", Kind: tenantSettingBool, Description: "Disable Community Search"},
+			{Name: "disable_bing_video_search", Path: "powerPlatform.search.disableBingVideoSearch", Kind: tenantSettingBool, Description: "Disable Bing Video Search"},
+		},
+	}
+}
+
+func NewTenantGovernanceSettingsResource() resource.Resource {
+	return &TenantSettingsGroupResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "tenant_governance_settings",
+		},
+		Description: "governance and environment creation",
+		Settings: []tenantSetting{
+			{Name: "disable_environment_creation_by_non_admin_users", Path: "disableEnvironmentCreationByNonAdminUsers", Kind: tenantSettingBool, Description: "Disable Environment Creation By Non Admin Users. See [Control environment creation](https://learn.microsoft.com/power-platform/admin/control-environment-creation) for more details"},
+			{Name: "disable_trial_environment_creation_by_non_admin_users", Path: "disableTrialEnvironmentCreationByNonAdminUsers", Kind: tenantSettingBool, Description: "Disable Trial Environment Creation By Non Admin Users"},
+			{Name: "disable_portals_creation_by_non_admin_users", Path: "disablePortalsCreationByNonAdminUsers", Kind: tenantSettingBool, Description: "Disable Portals Creation By Non Admin Users"},
+			{Name: "disable_admin_digest", Path: "powerPlatform.governance.disableAdminDigest", Kind: tenantSettingBool, Description: "Disable Admin Digest"},
+			{Name: "disable_developer_environment_creation_by_non_admin_users", Path: "powerPlatform.governance.disableDeveloperEnvironmentCreationByNonAdminUsers", Kind: tenantSettingBool, Description: "Disable Developer Environment Creation By Non Admin Users"},
+			{Name: "enable_default_environment_routing", Path: "powerPlatform.governance.enableDefaultEnvironmentRouting", Kind: tenantSettingBool, Description: "Enable Default Environment Routing"},
+			{Name: "environment_routing_all_makers", Path: "powerPlatform.governance.environmentRoutingAllMakers", Kind: tenantSettingBool, Description: "Route all makers to the target environment group"},
+			{Name: "environment_routing_target_environment_group_id", Path: "powerPlatform.governance.environmentRoutingTargetEnvironmentGroupId", Kind: tenantSettingString, Description: "Id of the environment group makers are routed to, `00000000-0000-0000-0000-000000000000` for none"},
+			{Name: "environment_routing_target_security_group_id", Path: "powerPlatform.governance.environmentRoutingTargetSecurityGroupId", Kind: tenantSettingString, Description: "Id of the security group of the makers that are routed, `00000000-0000-0000-0000-000000000000` for none"},
+			{Name: "enable_desktop_flow_data_policy_management", Path: "powerPlatform.governance.policy.enableDesktopFlowDataPolicyManagement", Kind: tenantSettingBool, Description: "Enable Desktop Flow Data Policy Management"},
+		},
+	}
+}
+
+func NewTenantLicensingSettingsResource() resource.Resource {
+	return &TenantSettingsGroupResource{
+		TypeInfo: helpers.TypeInfo{
+			TypeName: "tenant_licensing_settings",
+		},
+		Description: "licensing and capacity",
+		Settings: []tenantSetting{
+			{Name: "disable_capacity_allocation_by_environment_admins", Path: "disableCapacityAllocationByEnvironmentAdmins", Kind: tenantSettingBool, Description: "Disable Capacity Allocation By Environment Admins"},
+			{Name: "disable_billing_policy_creation_by_non_admin_users", Path: "powerPlatform.licensing.disableBillingPolicyCreationByNonAdminUsers", Kind: tenantSettingBool, Description: "Disable Billing Policy Creation By Non Admin Users"},
+			{Name: "enable_tenant_capacity_report_for_environment_admins", Path: "powerPlatform.licensing.enableTenantCapacityReportForEnvironmentAdmins", Kind: tenantSettingBool, Description: "Enable Tenant Capacity Report For Environment Admins"},
+			{Name: "storage_capacity_consumption_warning_threshold", Path: "powerPlatform.licensing.storageCapacityConsumptionWarningThreshold", Kind: tenantSettingInt64, Description: "Storage Capacity Consumption Warning Threshold"},
+			{Name: "enable_tenant_licensing_report_for_environment_admins", Path: "powerPlatform.licensing.enableTenantLicensingReportForEnvironmentAdmins", Kind: tenantSettingBool, Description: "Enable Tenant Licensing Report For Environment Admins"},
+			{Name: "disable_use_of_unassigned_ai_builder_credits", Path: "powerPlatform.licensing.disableUseOfUnassignedAIBuilderCredits", Kind: tenantSettingBool, Description: "Disable Use Of Unassigned AI Builder Credits"},
+		},
+	}
+}
+
+func (r *TenantSettingsGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	// update our own internal storage of the provider type name.
+	r.ProviderTypeName = req.ProviderTypeName
+
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	// Set the type name for the resource to providername_resourcename.
+	resp.TypeName = r.FullTypeName()
+	tflog.Debug(ctx, fmt.Sprintf("METADATA: %s", resp.TypeName))
+}
+
+func (r *TenantSettingsGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	attributes := map[string]schema.Attribute{
+		"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+			Create: true,
+			Update: true,
+			Read:   true,
+			Delete: true,
+		}),
+		"id": schema.StringAttribute{
+			MarkdownDescription: "Id of the Power Platform Tenant",
+			Computed:            true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"etag": schema.StringAttribute{
+			MarkdownDescription: "Version of the configured settings when they were last read. Applying fails when the settings were changed since then, for example by another workspace",
+			Computed:            true,
+		},
+	}
+	for _, setting := range r.Settings {
+		switch setting.Kind {
+		case tenantSettingBool:
+			attributes[setting.Name] = schema.BoolAttribute{MarkdownDescription: setting.Description, Optional: true}
+		case tenantSettingInt64:
+			attributes[setting.Name] = schema.Int64Attribute{MarkdownDescription: setting.Description, Optional: true}
+		case tenantSettingString:
+			attributes[setting.Name] = schema.StringAttribute{MarkdownDescription: setting.Description, Optional: true}
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Manages the %s [tenant settings](https://learn.microsoft.com/power-platform/admin/tenant-settings) of Power Platform. ", r.Description) +
+			"Only the configured settings are managed, so that each group of settings can be owned by a different configuration. They should not be managed by `powerplatform_tenant_settings` as well. " +
+			"Destroying the resource restores the settings to the values they had before the resource was created.",
+		Attributes: attributes,
+	}
+}
+
+func (r *TenantSettingsGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	if req.ProviderData == nil {
+		// ProviderData will be null when Configure is called from ValidateConfig.  It's ok.
+		return
+	}
+
+	client, ok := req.ProviderData.(*api.ProviderClient)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected ProviderData Type",
+			fmt.Sprintf("Expected *api.ProviderClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.TenantSettingClient = newTenantSettingsClient(client.Api)
+}
+
+func (r *TenantSettingsGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	planned, diags := r.configuredValues(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tenant, err := r.TenantSettingClient.GetTenant(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	originalSettings, err := r.TenantSettingClient.GetTenantSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+	jsonSettings, err := json.Marshal(originalSettings)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "original_settings", jsonSettings)...)
+
+	updated, err := r.updateSettings(ctx, planned)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when creating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), types.StringValue(tenant.TenantId))...)
+	r.setState(ctx, &resp.State, planned, updated, &resp.Diagnostics)
+}
+
+func (r *TenantSettingsGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	configured, diags := r.configuredValues(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.getSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("READ: %s with %d configured settings", r.FullTypeName(), len(configured)))
+
+	r.setState(ctx, &resp.State, configured, current, &resp.Diagnostics)
+}
+
+func (r *TenantSettingsGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	planned, diags := r.configuredValues(ctx, req.Plan)
+	resp.Diagnostics.Append(diags...)
+	previous, diags := r.configuredValues(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	var etag types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("etag"), &etag)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, err := r.getSettings(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when reading %s", r.FullTypeName()), err.Error())
+		return
+	}
+	if currentEtag := r.etag(previous, current); currentEtag != etag.ValueString() {
+		resp.Diagnostics.AddError(
+			fmt.Sprintf("Conflicting change of %s", r.FullTypeName()),
+			"The settings were changed since they were last read, for example by another configuration. Refresh the state and review the plan again before applying it.",
+		)
+		return
+	}
+
+	updated, err := r.updateSettings(ctx, planned)
+	if err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when updating %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	resp.State.Raw = req.Plan.Raw
+	r.setState(ctx, &resp.State, planned, updated, &resp.Diagnostics)
+}
+
+func (r *TenantSettingsGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, exitContext := helpers.EnterRequestContext(ctx, r.TypeInfo, req)
+	defer exitContext()
+
+	configured, diags := r.configuredValues(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previousBytes, diags := req.Private.GetKey(ctx, "original_settings")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if previousBytes == nil {
+		resp.Diagnostics.AddWarning("Tenant settings are not restored", "The settings had no previous values recorded, they are left as they are.")
+		return
+	}
+
+	original := map[string]any{}
+	if err := json.Unmarshal(previousBytes, &original); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+
+	restored := map[string]any{}
+	for _, setting := range r.Settings {
+		if _, ok := configured[setting.Name]; !ok {
+			continue
+		}
+		if value := setting.valueIn(original); value != nil {
+			restored[setting.Name] = value
+		}
+	}
+
+	if _, err := r.updateSettings(ctx, restored); err != nil {
+		resp.Diagnostics.AddError(fmt.Sprintf("Client error when deleting %s", r.FullTypeName()), err.Error())
+		return
+	}
+}
+
+// configuredValues returns the values of the settings that are set in the plan or the state, by attribute name.
+func (r *TenantSettingsGroupResource) configuredValues(ctx context.Context, getter tenantSettingsGetter) (map[string]any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	values := map[string]any{}
+	for _, setting := range r.Settings {
+		switch setting.Kind {
+		case tenantSettingBool:
+			var value types.Bool
+			diags.Append(getter.GetAttribute(ctx, path.Root(setting.Name), &value)...)
+			if !value.IsNull() && !value.IsUnknown() {
+				values[setting.Name] = value.ValueBool()
+			}
+		case tenantSettingInt64:
+			var value types.Int64
+			diags.Append(getter.GetAttribute(ctx, path.Root(setting.Name), &value)...)
+			if !value.IsNull() && !value.IsUnknown() {
+				values[setting.Name] = value.ValueInt64()
+			}
+		case tenantSettingString:
+			var value types.String
+			diags.Append(getter.GetAttribute(ctx, path.Root(setting.Name), &value)...)
+			if !value.IsNull() && !value.IsUnknown() {
+				values[setting.Name] = value.ValueString()
+			}
+		}
+	}
+	return values, diags
+}
+
+// setState sets the configured settings to their values in the tenant settings, and the etag of these values.
+func (r *TenantSettingsGroupResource) setState(ctx context.Context, state *tfsdk.State, configured map[string]any, settings map[string]any, diags *diag.Diagnostics) {
+	for _, setting := range r.Settings {
+		configuredValue, ok := configured[setting.Name]
+		if !ok {
+			continue
+		}
+		value := setting.valueIn(settings)
+		// a zeroed id is returned as no value.
+		if value == nil && configuredValue == constants.ZERO_UUID {
+			value = configuredValue
+		}
+
+		var attribute attr.Value
+		switch setting.Kind {
+		case tenantSettingBool:
+			attribute = types.BoolNull()
+			if v, ok := value.(bool); ok {
+				attribute = types.BoolValue(v)
+			}
+		case tenantSettingInt64:
+			attribute = types.Int64Null()
+			if v, ok := value.(int64); ok {
+				attribute = types.Int64Value(v)
+			}
+		case tenantSettingString:
+			attribute = types.StringNull()
+			if v, ok := value.(string); ok {
+				attribute = types.StringValue(v)
+			}
+		}
+		diags.Append(state.SetAttribute(ctx, path.Root(setting.Name), attribute)...)
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("etag"), types.StringValue(r.etag(configured, settings)))...)
+}
+
+// etag is a hash of the values, in the tenant settings, of the configured settings.
+func (r *TenantSettingsGroupResource) etag(configured map[string]any, settings map[string]any) string {
+	values := map[string]any{}
+	for _, setting := range r.Settings {
+		if _, ok := configured[setting.Name]; ok {
+			values[setting.Name] = setting.valueIn(settings)
+		}
+	}
+	// maps are marshalled with sorted keys, so the hash only depends on the values.
+	content, _ := json.Marshal(values)
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+func (r *TenantSettingsGroupResource) getSettings(ctx context.Context) (map[string]any, error) {
+	settings, err := r.TenantSettingClient.GetTenantSettings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return convertTenantSettingsToMap(*settings)
+}
+
+// updateSettings sends only the given settings, the other tenant settings are left unchanged.
+func (r *TenantSettingsGroupResource) updateSettings(ctx context.Context, values map[string]any) (map[string]any, error) {
+	body := map[string]any{}
+	for _, setting := range r.Settings {
+		if value, ok := values[setting.Name]; ok {
+			setting.setIn(body, value)
+		}
+	}
+
+	content, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	var settings tenantSettingsDto
+	if err := json.Unmarshal(content, &settings); err != nil {
+		return nil, err
+	}
+
+	updated, err := r.TenantSettingClient.UpdateTenantSettings(ctx, settings)
+	if err != nil {
+		return nil, err
+	}
+	return convertTenantSettingsToMap(*updated)
+}
+
+func convertTenantSettingsToMap(settings tenantSettingsDto) (map[string]any, error) {
+	content, err := json.Marshal(settings)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]any{}
+	if err := json.Unmarshal(content, &values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// valueIn returns the value of the setting in the tenant settings, or nil when it has none.
+func (setting tenantSetting) valueIn(settings map[string]any) any {
+	var value any = settings
+	for _, name := range strings.Split(setting.Path, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return nil
+		}
+		value = object[name]
+	}
+
+	switch setting.Kind {
+	case tenantSettingBool:
+		if v, ok := value.(bool); ok {
+			return v
+		}
+	case tenantSettingInt64:
+		switch v := value.(type) {
+		case float64:
+			return int64(v)
+		case int64:
+			return v
+		}
+	case tenantSettingString:
+		if v, ok := value.(string); ok {
+			return v
+		}
+	}
+	return nil
+}
+
+func (setting tenantSetting) setIn(settings map[string]any, value any) {
+	names := strings.Split(setting.Path, ".")
+	object := settings
+	for _, name := range names[:len(names)-1] {
+		child, ok := object[name].(map[string]any)
+		if !ok {
+			child = map[string]any{}
+			object[name] = child
+		}
+		object = child
+	}
+	object[names[len(names)-1]] = value
+}
@@ -0,0 +1,207 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package tenant_settings_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/jarcoal/httpmock"
+	"github.com/microsoft/terraform-provider-power-platform/internal/mocks"
+)
+
+// registerTenantSettingsMocks stores the tenant settings and merges the updates into them, like the updateTenantSettings API.
+func registerTenantSettingsMocks(settings map[string]any) {
+	httpmock.RegisterResponder("GET", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/tenant?api-version=2020-08-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, httpmock.File("tests/resources/Validate_Create/get_tenant.json").String()), nil
+		})
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/listTenantSettings?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewJsonResponse(http.StatusOK, settings)
+		})
+
+	var merge func(target, update map[string]any)
+	merge = func(target, update map[string]any) {
+		for name, value := range update {
+			if object, ok := value.(map[string]any); ok {
+				if _, ok := target[name].(map[string]any); !ok {
+					target[name] = map[string]any{}
+				}
+				merge(target[name].(map[string]any), object)
+			} else {
+				target[name] = value
+			}
+		}
+	}
+
+	httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/scopes/admin/updateTenantSettings?api-version=2023-06-01",
+		func(req *http.Request) (*http.Response, error) {
+			update := map[string]any{}
+			_ = json.NewDecoder(req.Body).Decode(&update)
+			merge(settings, update)
+			return httpmock.NewJsonResponse(http.StatusOK, settings)
+		})
+}
+
+func newTenantSettings() map[string]any {
+	return map[string]any{
+		"disableCapacityAllocationByEnvironmentAdmins": false,
+		"powerPlatform": map[string]any{
+			"intelligence": map[string]any{
+				"disableCopilot":            false,
+				"enableOpenAiBotPublishing": true,
+			},
+			"search": map[string]any{
+				"disableDocsSearch":      false,
+				"disableBingVideoSearch": false,
+			},
+			"licensing": map[string]any{
+				"storageCapacityConsumptionWarningThreshold": 80,
+			},
+		},
+	}
+}
+
+func TestUnitTenantAiSettingsResource_Validate_Create_Update_And_Delete(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	settings := newTenantSettings()
+	registerTenantSettingsMocks(settings)
+
+	intelligence := func() map[string]any {
+		return settings["powerPlatform"].(map[string]any)["intelligence"].(map[string]any)
+	}
+	search := func() map[string]any {
+		return settings["powerPlatform"].(map[string]any)["search"].(map[string]any)
+	}
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_tenant_ai_settings" "ai" {
+					disable_copilot           = true
+					disable_bing_video_search = true
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_tenant_ai_settings.ai", "id", "00000000-0000-0000-0000-000000000001"),
+					resource.TestCheckResourceAttr("powerplatform_tenant_ai_settings.ai", "disable_copilot", "true"),
+					resource.TestCheckResourceAttr("powerplatform_tenant_ai_settings.ai", "disable_bing_video_search", "true"),
+					resource.TestCheckNoResourceAttr("powerplatform_tenant_ai_settings.ai", "enable_open_ai_bot_publishing"),
+					resource.TestMatchResourceAttr("powerplatform_tenant_ai_settings.ai", "etag", regexp.MustCompile(`^[0-9a-f]{64}$`)),
+					func(s *terraform.State) error {
+						if intelligence()["disableCopilot"] != true || intelligence()["enableOpenAiBotPublishing"] != true {
+							return fmt.Errorf("unexpected intelligence settings %v", intelligence())
+						}
+						return nil
+					},
+				),
+			},
+			{
+				Config: `
+				resource "powerplatform_tenant_ai_settings" "ai" {
+					disable_copilot           = true
+					disable_bing_video_search = false
+					disable_docs_search       = true
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_tenant_ai_settings.ai", "disable_bing_video_search", "false"),
+					resource.TestCheckResourceAttr("powerplatform_tenant_ai_settings.ai", "disable_docs_search", "true"),
+				),
+			},
+		},
+	})
+
+	// the settings are restored to their values before the resource was created.
+	if intelligence()["disableCopilot"] != false || search()["disableDocsSearch"] != false || search()["disableBingVideoSearch"] != false {
+		t.Errorf("expected the settings to be restored, got %v", settings)
+	}
+}
+
+func TestUnitTenantAiSettingsResource_Validate_Conflicting_Change(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	settings := newTenantSettings()
+	registerTenantSettingsMocks(settings)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_tenant_ai_settings" "ai" {
+					disable_copilot = true
+				}`,
+			},
+			{
+				// another configuration changes the setting between the plan and the apply.
+				PreConfig: func() {
+					calls := 0
+					httpmock.RegisterResponder("POST", "https://api.bap.microsoft.com/providers/Microsoft.BusinessAppPlatform/listTenantSettings?api-version=2023-06-01",
+						func(req *http.Request) (*http.Response, error) {
+							calls++
+							if calls > 1 {
+								settings["powerPlatform"].(map[string]any)["intelligence"].(map[string]any)["disableCopilot"] = false
+							}
+							return httpmock.NewJsonResponse(http.StatusOK, settings)
+						})
+				},
+				Config: `
+				resource "powerplatform_tenant_ai_settings" "ai" {
+					disable_copilot     = true
+					disable_docs_search = true
+				}`,
+				ExpectError: regexp.MustCompile(`The settings were changed since they were last read`),
+			},
+		},
+	})
+}
+
+func TestUnitTenantLicensingSettingsResource_Validate_Create(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	settings := newTenantSettings()
+	registerTenantSettingsMocks(settings)
+
+	resource.Test(t, resource.TestCase{
+		IsUnitTest:               true,
+		ProtoV6ProviderFactories: mocks.TestUnitTestProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "powerplatform_tenant_licensing_settings" "licensing" {
+					disable_capacity_allocation_by_environment_admins = true
+					storage_capacity_consumption_warning_threshold    = 90
+				}
+
+				resource "powerplatform_tenant_governance_settings" "governance" {
+					disable_admin_digest                         = true
+					environment_routing_target_security_group_id = "00000000-0000-0000-0000-000000000000"
+				}`,
+
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("powerplatform_tenant_licensing_settings.licensing", "disable_capacity_allocation_by_environment_admins", "true"),
+					resource.TestCheckResourceAttr("powerplatform_tenant_licensing_settings.licensing", "storage_capacity_consumption_warning_threshold", "90"),
+					resource.TestCheckResourceAttr("powerplatform_tenant_governance_settings.governance", "disable_admin_digest", "true"),
+					resource.TestCheckResourceAttr("powerplatform_tenant_governance_settings.governance", "environment_routing_target_security_group_id", "00000000-0000-0000-0000-000000000000"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,18 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package validators
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// DurationAtLeast is a validator that ensures that a string is a Go duration, such as `15m` or `1h30m`, that is not shorter than minimum.
+func DurationAtLeast(minimum time.Duration, errorMessage string) validator.String {
+	return &DurationAtLeastValidator{
+		Minimum:      minimum,
+		ErrorMessage: errorMessage,
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) Microsoft Corporation.
+// Licensed under the MIT license.
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var (
+	_ validator.String = DurationAtLeastValidator{}
+)
+
+type DurationAtLeastValidator struct {
+	Minimum      time.Duration
+	ErrorMessage string
+}
+
+func (av DurationAtLeastValidator) Description(ctx context.Context) string {
+	return av.MarkdownDescription(ctx)
+}
+
+func (av DurationAtLeastValidator) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("value must be a duration, such as `15m`, of at least `%s`", av.Minimum)
+}
+
+func (av DurationAtLeastValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < av.Minimum {
+		resp.Diagnostics.AddAttributeError(req.Path, av.ErrorMessage, fmt.Sprintf("The value '%s' is not a valid duration. Expected a duration such as `15m` of at least `%s`.", value, av.Minimum))
+	}
+}